@@ -24,6 +24,22 @@ type ServiceBindingParameters struct {
 	// +kubebuilder:validation:Optional
 	ParameterSecretRefs []xpv1.SecretKeySelector `json:"parameterSecretRefs,omitempty"`
 
+	// The interval at which a new binding is created and published to the connection secret.
+	// The previous binding is kept until its ttl passes. Bindings are not rotated if not set.
+	// Rotation requires the Native service manager backend.
+	// +kubebuilder:validation:Optional
+	RotationInterval *metav1.Duration `json:"rotationInterval,omitempty"`
+
+	// The time to live of a rotated binding, it will be deleted afterwards. Should be greater than the rotation interval.
+	// The margin between the two values allows consumers to pick up the new credentials.
+	// Defaults to twice the rotation interval.
+	// +kubebuilder:validation:Optional
+	BindingTTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// Layout of the credentials in the connection secret, defaults to publishing every credential as its own key
+	// +kubebuilder:validation:Optional
+	SecretFormat *SecretFormat `json:"secretFormat,omitempty"`
+
 	// (String) The ID of the subaccount.
 	// The ID of the subaccount.
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.Subaccount
@@ -58,9 +74,54 @@ type ServiceBindingParameters struct {
 	ServiceInstanceSelector *v1.Selector `json:"serviceInstanceSelector,omitempty" tf:"-"`
 }
 
+const (
+	SecretFormatFlat            = "Flat"
+	SecretFormatJSON            = "JSON"
+	SecretFormatServiceOperator = "ServiceOperator"
+	SecretFormatVCAP            = "VCAP"
+	SecretFormatTemplate        = "Template"
+)
+
+// SecretFormat defines the layout of the connection secret of a ServiceBinding
+type SecretFormat struct {
+	// Type of the layout.
+	// Flat publishes every credential as its own key, nested values as JSON.
+	// JSON publishes all credentials as a single JSON document in the key "credentials".
+	// ServiceOperator publishes the keys like the SAP BTP service operator does, including instance metadata and the ".metadata" key.
+	// VCAP publishes a VCAP_SERVICES like document in the key "VCAP_SERVICES".
+	// Template renders the configured templates.
+	// Instance metadata is resolved via serviceInstanceRef.
+	// +kubebuilder:validation:Enum=Flat;JSON;ServiceOperator;VCAP;Template
+	// +kubebuilder:default=Flat
+	Type string `json:"type,omitempty"`
+
+	// Go templates by secret key, only used by type Template.
+	// Templates can access the credentials via .credentials and the binding via .binding (bindingName, bindingId, instanceName, instanceId, offering, plan),
+	// the function toJson renders a value as JSON, e.g. {{ .credentials.uaa | toJson }}
+	// +kubebuilder:validation:Optional
+	Templates map[string]string `json:"templates,omitempty"`
+}
+
 // ServiceBindingObservation are the observable fields of a ServiceBinding.
 type ServiceBindingObservation struct {
 	ID string `json:"id,omitempty"`
+
+	// Bindings created by rotation, the active one is published to the connection secret
+	Bindings []RotatedBinding `json:"bindings,omitempty"`
+
+	// Parameters of the binding that differ from the spec, values are left out since they may contain secrets.
+	// Bindings can't be updated, the drift is only reported. Requires the Native service manager backend,
+	// which is reflected by the ParameterDrift condition.
+	ParameterDrift []string `json:"parameterDrift,omitempty"`
+}
+
+// RotatedBinding is a binding created for a ServiceBinding with rotation enabled
+type RotatedBinding struct {
+	ID        string      `json:"id"`
+	Name      string      `json:"name"`
+	IsActive  bool        `json:"isActive"`
+	CreatedAt metav1.Time `json:"createdAt"`
+	ExpiresAt metav1.Time `json:"expiresAt"`
 }
 
 // A ServiceBindingSpec defines the desired state of a ServiceBinding.
@@ -0,0 +1,148 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ServiceCatalogParameters are the configurable fields of a ServiceCatalog.
+type ServiceCatalogParameters struct {
+	// Minimum time between two refreshes of the catalog
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="1h"
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	ServiceManagerSelector *xpv1.Selector `json:"serviceManagerSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	ServiceManagerRef *xpv1.Reference `json:"serviceManagerRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"ServiceManager" reference-apiversion:"v1beta1"`
+
+	// secret with the credentials of the service manager whose catalog is read, resolved from serviceManagerRef on every reconcile if that is set
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManager
+	// +crossplane:generate:reference:refFieldName=ServiceManagerRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceManagerSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManagerSecret()
+	ServiceManagerSecret string `json:"serviceManagerSecret,omitempty"`
+	// namespace of the service manager secret, resolved alongside it
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManager
+	// +crossplane:generate:reference:refFieldName=ServiceManagerRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceManagerSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManagerSecretNamespace()
+	ServiceManagerSecretNamespace string `json:"serviceManagerSecretNamespace,omitempty"`
+}
+
+// CatalogOffering is a service offering available in the subaccount
+type CatalogOffering struct {
+	// Catalog name of the offering, as used in the offeringName of a ServiceInstance
+	Name string `json:"name"`
+	ID   string `json:"id"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+	// Whether instances of the offering can be bound
+	// +kubebuilder:validation:Optional
+	Bindable bool `json:"bindable,omitempty"`
+	// Whether instances of the offering can change their plan
+	// +kubebuilder:validation:Optional
+	PlanUpdateable bool `json:"planUpdateable,omitempty"`
+	// +kubebuilder:validation:Optional
+	Plans []CatalogPlan `json:"plans,omitempty"`
+}
+
+// CatalogPlan is a plan of a service offering
+type CatalogPlan struct {
+	// Catalog name of the plan, as used in the planName of a ServiceInstance
+	Name string `json:"name"`
+	ID   string `json:"id"`
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+	// +kubebuilder:validation:Optional
+	Free bool `json:"free,omitempty"`
+}
+
+// ServiceCatalogObservation are the observable fields of a ServiceCatalog.
+type ServiceCatalogObservation struct {
+	// Offerings of the subaccount including their plans, sorted by name
+	Offerings []CatalogOffering `json:"offerings,omitempty"`
+	// Time of the last successful refresh
+	LastRefreshTime *metav1.Time `json:"lastRefreshTime,omitempty"`
+}
+
+// Offering returns the offering with the given catalog name, nil if there is none
+func (o *ServiceCatalogObservation) Offering(name string) *CatalogOffering {
+	for i := range o.Offerings {
+		if o.Offerings[i].Name == name {
+			return &o.Offerings[i]
+		}
+	}
+	return nil
+}
+
+// Plan returns the plan with the given catalog name, nil if there is none
+func (o *CatalogOffering) Plan(name string) *CatalogPlan {
+	for i := range o.Plans {
+		if o.Plans[i].Name == name {
+			return &o.Plans[i]
+		}
+	}
+	return nil
+}
+
+// A ServiceCatalogSpec defines the desired state of a ServiceCatalog.
+type ServiceCatalogSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceCatalogParameters `json:"forProvider"`
+}
+
+// A ServiceCatalogStatus represents the observed state of a ServiceCatalog.
+type ServiceCatalogStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceCatalogObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceCatalog is a read-only view of the service offerings and plans available in a subaccount, as seen by its service manager.
+// The catalog is cached in the status and refreshed periodically, ServiceInstances can reference it to validate offering and plan before creation.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REFRESHED",type="date",JSONPath=".status.atProvider.lastRefreshTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type ServiceCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceCatalogSpec   `json:"spec"`
+	Status ServiceCatalogStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceCatalogList contains a list of ServiceCatalog
+type ServiceCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceCatalog `json:"items"`
+}
+
+// ServiceCatalog type metadata.
+var (
+	ServiceCatalogKind             = reflect.TypeOf(ServiceCatalog{}).Name()
+	ServiceCatalogGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceCatalogKind}.String()
+	ServiceCatalogKindAPIVersion   = ServiceCatalogKind + "." + CRDGroupVersion.String()
+	ServiceCatalogGroupVersionKind = CRDGroupVersion.WithKind(ServiceCatalogKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceCatalog{}, &ServiceCatalogList{})
+}
+
+// CredentialsReference returns the reference the service manager credentials secret is resolved from
+func (mg *ServiceCatalog) CredentialsReference() *xpv1.Reference {
+	return mg.Spec.ForProvider.ServiceManagerRef
+}
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -19,7 +20,8 @@ type ServiceInstanceParameters struct {
 	// Name of the service offering
 	OfferingName string `json:"offeringName,omitempty"`
 
-	// Name of the service plan of that offering
+	// Name of the service plan of that offering, can be changed if the offering supports plan updates.
+	// Not required for instances referencing a shared instance.
 	PlanName string `json:"planName,omitempty"`
 
 	// Parameters in JSON or YAML format, will be merged with yaml parameters and secret parameters, will overwrite duplicated keys from secrets
@@ -30,16 +32,45 @@ type ServiceInstanceParameters struct {
 	// +kubebuilder:validation:Optional
 	ParameterSecretRefs []xpv1.SecretKeySelector `json:"parameterSecretRefs,omitempty"`
 
+	// Shares the instance, so it can be referenced by instances in other environments or subaccounts.
+	// Requires a plan that supports instance sharing.
+	// +kubebuilder:validation:Optional
+	Shared *bool `json:"shared,omitempty"`
+
+	// The ID of a shared instance, creates a reference instance pointing to it instead of a new instance.
+	// The plan is set to reference-instance, the offering needs to match the one of the shared instance.
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceInstance
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceInstanceUuid()
+	// +crossplane:generate:reference:refFieldName=ReferencedInstanceRef
+	// +crossplane:generate:reference:selectorFieldName=ReferencedInstanceSelector
+	// +kubebuilder:validation:Optional
+	ReferencedInstanceID *string `json:"referencedInstanceId,omitempty"`
+
+	// Reference to a shared ServiceInstance to populate referencedInstanceId.
+	// The shared instance can't be deleted as long as it is referenced.
+	// +kubebuilder:validation:Optional
+	ReferencedInstanceRef *xpv1.Reference `json:"referencedInstanceRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"ServiceInstance" reference-apiversion:"v1alpha1"`
+
+	// Selector for a shared ServiceInstance to populate referencedInstanceId.
+	// +kubebuilder:validation:Optional
+	ReferencedInstanceSelector *xpv1.Selector `json:"referencedInstanceSelector,omitempty"`
+
+	// Reference to a ServiceCatalog of the subaccount, offering and plan are validated against it before the instance is created
+	// +kubebuilder:validation:Optional
+	ServiceCatalogRef *xpv1.Reference `json:"serviceCatalogRef,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	ServiceManagerSelector *xpv1.Selector `json:"serviceManagerSelector,omitempty"`
 	// +kubebuilder:validation:Optional
 	ServiceManagerRef *xpv1.Reference `json:"serviceManagerRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"ServiceManager" reference-apiversion:"v1beta1"`
 
+	// secret with the service manager credentials the instance is created with, follows the connection secret of serviceManagerRef as long as it is set
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManager
 	// +crossplane:generate:reference:refFieldName=ServiceManagerRef
 	// +crossplane:generate:reference:selectorFieldName=ServiceManagerSelector
 	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManagerSecret()
 	ServiceManagerSecret string `json:"serviceManagerSecret,omitempty"`
+	// namespace of serviceManagerSecret, resolved together with it
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManager
 	// +crossplane:generate:reference:refFieldName=ServiceManagerRef
 	// +crossplane:generate:reference:selectorFieldName=ServiceManagerSelector
@@ -63,12 +94,35 @@ type ServiceInstanceParameters struct {
 	SubaccountSelector *v1.Selector `json:"subaccountSelector,omitempty" tf:"-"`
 }
 
+// ReferenceInstancePlanName is the plan of instances referencing a shared instance
+const ReferenceInstancePlanName = "reference-instance"
+
+// ResolvedPlanName returns the name of the plan the instance is created with, which is reference-instance for instances referencing a shared one
+func (p *ServiceInstanceParameters) ResolvedPlanName() string {
+	if p.ReferencedInstanceID != nil && *p.ReferencedInstanceID != "" {
+		return ReferenceInstancePlanName
+	}
+	return p.PlanName
+}
+
 // ServiceInstanceObservation are the observable fields of a ServiceInstance.
 type ServiceInstanceObservation struct {
 	ID string `json:"id,omitempty"`
 
 	// The ID of the service plan as resolved by the ServiceManager
 	ServiceplanID string `json:"serviceplanId,omitempty"`
+
+	// The offering the service plan ID has been resolved for
+	OfferingName string `json:"offeringName,omitempty"`
+
+	// The plan the service plan ID has been resolved for
+	PlanName string `json:"planName,omitempty"`
+
+	// Parameters of the instance that differ from the spec, values are left out since they may contain secrets.
+	// Parameters not set in the spec are ignored, differing ones are updated to the spec.
+	// Requires the Native service manager backend, the terraform backend doesn't observe parameters,
+	// which is reflected by the ParameterDrift condition.
+	ParameterDrift []string `json:"parameterDrift,omitempty"`
 }
 
 // A ServiceInstanceSpec defines the desired state of a ServiceInstance.
@@ -120,3 +174,57 @@ var (
 func init() {
 	SchemeBuilder.Register(&ServiceInstance{}, &ServiceInstanceList{})
 }
+
+// CredentialsReference returns the reference the service manager credentials secret is resolved from
+func (mg *ServiceInstance) CredentialsReference() *xpv1.Reference {
+	return mg.Spec.ForProvider.ServiceManagerRef
+}
+
+const PlanUpdateCondition xpv1.ConditionType = "PlanUpdate"
+const PlanNotUpdateable xpv1.ConditionReason = "PlanNotUpdateable"
+const PlanUpToDate xpv1.ConditionReason = "PlanUpToDate"
+
+// PlanUpdateRejected indicates that the plan in the spec can't be applied, since the offering doesn't support plan updates
+func PlanUpdateRejected(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               PlanUpdateCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             PlanNotUpdateable,
+		Message:            msg,
+	}
+}
+
+// PlanUpdated indicates that the plan in the spec has been resolved and is applied to the instance
+func PlanUpdated() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               PlanUpdateCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             PlanUpToDate,
+	}
+}
+
+const ParameterDriftCondition xpv1.ConditionType = "ParameterDrift"
+const ParametersObserved xpv1.ConditionReason = "ParametersObserved"
+const ParametersNotObserved xpv1.ConditionReason = "ParametersNotObserved"
+
+// ParameterDriftObservation indicates whether the parameters of the resource are observed and differences are reported as parameterDrift,
+// which depends on the service manager backend
+func ParameterDriftObservation(observed bool) xpv1.Condition {
+	if observed {
+		return xpv1.Condition{
+			Type:               ParameterDriftCondition,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ParametersObserved,
+		}
+	}
+	return xpv1.Condition{
+		Type:               ParameterDriftCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ParametersNotObserved,
+		Message:            "the terraform service manager backend doesn't observe parameters, use the Native backend to have parameter drift reported",
+	}
+}
@@ -0,0 +1,129 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+const (
+	PlatformTypeKubernetes   = "kubernetes"
+	PlatformTypeCloudFoundry = "cloudfoundry"
+
+	// connection details published for a registered platform
+	PlatformCredentialsUsername = "username"
+	PlatformCredentialsPassword = "password"
+	PlatformCredentialsID       = "platformId"
+	PlatformCredentialsSmUrl    = "sm_url"
+)
+
+// ServiceManagerPlatformParameters are the configurable fields of a ServiceManagerPlatform.
+type ServiceManagerPlatformParameters struct {
+	// CLI-friendly name of the platform, may only contain alphanumeric characters, periods, and hyphens
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+$`
+	Name string `json:"name"`
+
+	// Type of the platform, kubernetes for Kyma or Gardener clusters and cloudfoundry for CF environments
+	// +kubebuilder:validation:Enum=kubernetes;cloudfoundry
+	// +kubebuilder:default:=kubernetes
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type can't be updated once set"
+	Type string `json:"type,omitempty"`
+
+	// Description of the platform for customer-facing UIs
+	// +kubebuilder:validation:Optional
+	Description *string `json:"description,omitempty"`
+
+	// Labels attached to the platform
+	// +kubebuilder:validation:Optional
+	Labels map[string][]string `json:"labels,omitempty"`
+
+	// Reference to the ServiceManager the platform is registered with, its plan needs to be subaccount-admin
+	// +kubebuilder:validation:Optional
+	ServiceManagerRef *xpv1.Reference `json:"serviceManagerRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"ServiceManager" reference-apiversion:"v1beta1"`
+	// +kubebuilder:validation:Optional
+	ServiceManagerSelector *xpv1.Selector `json:"serviceManagerSelector,omitempty"`
+
+	// secret with the service manager credentials used to register the platform, tracks the connection secret of serviceManagerRef if that is set
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManager
+	// +crossplane:generate:reference:refFieldName=ServiceManagerRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceManagerSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManagerSecret()
+	ServiceManagerSecret string `json:"serviceManagerSecret,omitempty"`
+	// namespace the platform registration credentials are read from, tracks the one of serviceManagerRef if that is set
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManager
+	// +crossplane:generate:reference:refFieldName=ServiceManagerRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceManagerSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManagerSecretNamespace()
+	ServiceManagerSecretNamespace string `json:"serviceManagerSecretNamespace,omitempty"`
+}
+
+// ServiceManagerPlatformObservation are the observable fields of a ServiceManagerPlatform.
+type ServiceManagerPlatformObservation struct {
+	// The ID of the platform
+	ID string `json:"id,omitempty"`
+	// Whether the platform is ready for consumption
+	Ready *bool `json:"ready,omitempty"`
+	// The time the platform was registered
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// A ServiceManagerPlatformSpec defines the desired state of a ServiceManagerPlatform.
+type ServiceManagerPlatformSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceManagerPlatformParameters `json:"forProvider"`
+}
+
+// A ServiceManagerPlatformStatus represents the observed state of a ServiceManagerPlatform.
+type ServiceManagerPlatformStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceManagerPlatformObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceManagerPlatform registers a Kubernetes cluster or Cloud Foundry environment as platform with the service manager of a subaccount,
+// e.g. for the SAP BTP service operator. The platform credentials are only returned on registration and published as connection secret.
+// A platform that is already registered with the same name is adopted and gets new credentials, invalidating the previous ones.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type ServiceManagerPlatform struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceManagerPlatformSpec   `json:"spec"`
+	Status ServiceManagerPlatformStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceManagerPlatformList contains a list of ServiceManagerPlatform
+type ServiceManagerPlatformList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceManagerPlatform `json:"items"`
+}
+
+// ServiceManagerPlatform type metadata.
+var (
+	ServiceManagerPlatformKind             = reflect.TypeOf(ServiceManagerPlatform{}).Name()
+	ServiceManagerPlatformGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceManagerPlatformKind}.String()
+	ServiceManagerPlatformKindAPIVersion   = ServiceManagerPlatformKind + "." + CRDGroupVersion.String()
+	ServiceManagerPlatformGroupVersionKind = CRDGroupVersion.WithKind(ServiceManagerPlatformKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ServiceManagerPlatform{}, &ServiceManagerPlatformList{})
+}
+
+// CredentialsReference returns the reference the service manager credentials secret is resolved from
+func (mg *ServiceManagerPlatform) CredentialsReference() *xpv1.Reference {
+	return mg.Spec.ForProvider.ServiceManagerRef
+}
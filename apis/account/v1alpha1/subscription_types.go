@@ -38,6 +38,24 @@ type SubscriptionObservation struct {
 	// State as received from the API instance
 	// +optional
 	State *string `json:"state,omitempty"`
+	// AppId of the subscribed application
+	// +optional
+	AppId *string `json:"appId,omitempty"`
+	// SubscriptionUrl is the application URL of the subscribed tenant, also published as connection detail "url"
+	// +optional
+	SubscriptionUrl *string `json:"subscriptionUrl,omitempty"`
+	// SubscribedTenantId is the ID of the tenant subscribed to the application
+	// +optional
+	SubscribedTenantId *string `json:"subscribedTenantId,omitempty"`
+	// SubscriptionGUID is the unique ID of the subscription
+	// +optional
+	SubscriptionGUID *string `json:"subscriptionGUID,omitempty"`
+	// FailureReason describes why the last subscription operation failed, empty if it succeeded
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty"`
+	// Dependencies lists the applications the subscribed application depends on, as published in its metadata
+	// +optional
+	Dependencies []string `json:"dependencies,omitempty"`
 }
 
 // A SubscriptionSpec defines the desired state of a Subscription.
@@ -51,11 +69,13 @@ type SubscriptionSpec struct {
 	// +kubebuilder:validation:Optional
 	CloudManagementRef *xpv1.Reference `json:"cloudManagementRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"CloudManagement" reference-apiversion:"v1alpha1"`
 
+	// secret with the cloud management credentials used to subscribe, overwritten by the connection secret of cloudManagementRef while that is set
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
 	// +crossplane:generate:reference:refFieldName=CloudManagementRef
 	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
 	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagementSecret()
 	CloudManagementSecret string `json:"cloudManagementSecret,omitempty"`
+	// namespace of the cloud management secret, overwritten from cloudManagementRef the same way
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
 	// +crossplane:generate:reference:refFieldName=CloudManagementRef
 	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
@@ -84,6 +104,8 @@ type SubscriptionStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="URL",type="string",JSONPath=".status.atProvider.subscriptionUrl",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
@@ -115,3 +137,8 @@ var (
 func init() {
 	SchemeBuilder.Register(&Subscription{}, &SubscriptionList{})
 }
+
+// CredentialsReference returns the reference the cloud management credentials secret is resolved from
+func (mg *Subscription) CredentialsReference() *xpv1.Reference {
+	return mg.Spec.CloudManagementRef
+}
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -143,6 +144,41 @@ func (in *Binding) DeepCopy() *Binding {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogOffering) DeepCopyInto(out *CatalogOffering) {
+	*out = *in
+	if in.Plans != nil {
+		in, out := &in.Plans, &out.Plans
+		*out = make([]CatalogPlan, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogOffering.
+func (in *CatalogOffering) DeepCopy() *CatalogOffering {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogOffering)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CatalogPlan) DeepCopyInto(out *CatalogPlan) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CatalogPlan.
+func (in *CatalogPlan) DeepCopy() *CatalogPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(CatalogPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudManagement) DeepCopyInto(out *CloudManagement) {
 	*out = *in
@@ -1280,6 +1316,45 @@ func (in *Resource) DeepCopy() *Resource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RotatedBinding) DeepCopyInto(out *RotatedBinding) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotatedBinding.
+func (in *RotatedBinding) DeepCopy() *RotatedBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(RotatedBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretFormat) DeepCopyInto(out *SecretFormat) {
+	*out = *in
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretFormat.
+func (in *SecretFormat) DeepCopy() *SecretFormat {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretFormat)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBinding) DeepCopyInto(out *ServiceBinding) {
 	*out = *in
@@ -1342,6 +1417,18 @@ func (in *ServiceBindingList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceBindingObservation) DeepCopyInto(out *ServiceBindingObservation) {
 	*out = *in
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]RotatedBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ParameterDrift != nil {
+		in, out := &in.ParameterDrift, &out.ParameterDrift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingObservation.
@@ -1363,6 +1450,21 @@ func (in *ServiceBindingParameters) DeepCopyInto(out *ServiceBindingParameters)
 		*out = make([]v1.SecretKeySelector, len(*in))
 		copy(*out, *in)
 	}
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BindingTTL != nil {
+		in, out := &in.BindingTTL, &out.BindingTTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SecretFormat != nil {
+		in, out := &in.SecretFormat, &out.SecretFormat
+		*out = new(SecretFormat)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SubaccountID != nil {
 		in, out := &in.SubaccountID, &out.SubaccountID
 		*out = new(string)
@@ -1426,7 +1528,7 @@ func (in *ServiceBindingSpec) DeepCopy() *ServiceBindingSpec {
 func (in *ServiceBindingStatus) DeepCopyInto(out *ServiceBindingStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceBindingStatus.
@@ -1439,6 +1541,155 @@ func (in *ServiceBindingStatus) DeepCopy() *ServiceBindingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceCatalog) DeepCopyInto(out *ServiceCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCatalog.
+func (in *ServiceCatalog) DeepCopy() *ServiceCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceCatalogList) DeepCopyInto(out *ServiceCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCatalogList.
+func (in *ServiceCatalogList) DeepCopy() *ServiceCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceCatalogObservation) DeepCopyInto(out *ServiceCatalogObservation) {
+	*out = *in
+	if in.Offerings != nil {
+		in, out := &in.Offerings, &out.Offerings
+		*out = make([]CatalogOffering, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastRefreshTime != nil {
+		in, out := &in.LastRefreshTime, &out.LastRefreshTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCatalogObservation.
+func (in *ServiceCatalogObservation) DeepCopy() *ServiceCatalogObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceCatalogObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceCatalogParameters) DeepCopyInto(out *ServiceCatalogParameters) {
+	*out = *in
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ServiceManagerSelector != nil {
+		in, out := &in.ServiceManagerSelector, &out.ServiceManagerSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceManagerRef != nil {
+		in, out := &in.ServiceManagerRef, &out.ServiceManagerRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCatalogParameters.
+func (in *ServiceCatalogParameters) DeepCopy() *ServiceCatalogParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceCatalogParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceCatalogSpec) DeepCopyInto(out *ServiceCatalogSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCatalogSpec.
+func (in *ServiceCatalogSpec) DeepCopy() *ServiceCatalogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceCatalogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceCatalogStatus) DeepCopyInto(out *ServiceCatalogStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceCatalogStatus.
+func (in *ServiceCatalogStatus) DeepCopy() *ServiceCatalogStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceCatalogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceInstance) DeepCopyInto(out *ServiceInstance) {
 	*out = *in
@@ -1501,6 +1752,11 @@ func (in *ServiceInstanceList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceInstanceObservation) DeepCopyInto(out *ServiceInstanceObservation) {
 	*out = *in
+	if in.ParameterDrift != nil {
+		in, out := &in.ParameterDrift, &out.ParameterDrift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceObservation.
@@ -1522,6 +1778,31 @@ func (in *ServiceInstanceParameters) DeepCopyInto(out *ServiceInstanceParameters
 		*out = make([]v1.SecretKeySelector, len(*in))
 		copy(*out, *in)
 	}
+	if in.Shared != nil {
+		in, out := &in.Shared, &out.Shared
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReferencedInstanceID != nil {
+		in, out := &in.ReferencedInstanceID, &out.ReferencedInstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReferencedInstanceRef != nil {
+		in, out := &in.ReferencedInstanceRef, &out.ReferencedInstanceRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReferencedInstanceSelector != nil {
+		in, out := &in.ReferencedInstanceSelector, &out.ReferencedInstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceCatalogRef != nil {
+		in, out := &in.ServiceCatalogRef, &out.ServiceCatalogRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ServiceManagerSelector != nil {
 		in, out := &in.ServiceManagerSelector, &out.ServiceManagerSelector
 		*out = new(v1.Selector)
@@ -1580,7 +1861,7 @@ func (in *ServiceInstanceSpec) DeepCopy() *ServiceInstanceSpec {
 func (in *ServiceInstanceStatus) DeepCopyInto(out *ServiceInstanceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceInstanceStatus.
@@ -1692,6 +1973,169 @@ func (in *ServiceManagerParameters) DeepCopy() *ServiceManagerParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceManagerPlatform) DeepCopyInto(out *ServiceManagerPlatform) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceManagerPlatform.
+func (in *ServiceManagerPlatform) DeepCopy() *ServiceManagerPlatform {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceManagerPlatform)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceManagerPlatform) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceManagerPlatformList) DeepCopyInto(out *ServiceManagerPlatformList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceManagerPlatform, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceManagerPlatformList.
+func (in *ServiceManagerPlatformList) DeepCopy() *ServiceManagerPlatformList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceManagerPlatformList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceManagerPlatformList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceManagerPlatformObservation) DeepCopyInto(out *ServiceManagerPlatformObservation) {
+	*out = *in
+	if in.Ready != nil {
+		in, out := &in.Ready, &out.Ready
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceManagerPlatformObservation.
+func (in *ServiceManagerPlatformObservation) DeepCopy() *ServiceManagerPlatformObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceManagerPlatformObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceManagerPlatformParameters) DeepCopyInto(out *ServiceManagerPlatformParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ServiceManagerRef != nil {
+		in, out := &in.ServiceManagerRef, &out.ServiceManagerRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceManagerSelector != nil {
+		in, out := &in.ServiceManagerSelector, &out.ServiceManagerSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceManagerPlatformParameters.
+func (in *ServiceManagerPlatformParameters) DeepCopy() *ServiceManagerPlatformParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceManagerPlatformParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceManagerPlatformSpec) DeepCopyInto(out *ServiceManagerPlatformSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceManagerPlatformSpec.
+func (in *ServiceManagerPlatformSpec) DeepCopy() *ServiceManagerPlatformSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceManagerPlatformSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceManagerPlatformStatus) DeepCopyInto(out *ServiceManagerPlatformStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceManagerPlatformStatus.
+func (in *ServiceManagerPlatformStatus) DeepCopy() *ServiceManagerPlatformStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceManagerPlatformStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceManagerSpec) DeepCopyInto(out *ServiceManagerSpec) {
 	*out = *in
@@ -2937,6 +3381,36 @@ func (in *SubscriptionObservation) DeepCopyInto(out *SubscriptionObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AppId != nil {
+		in, out := &in.AppId, &out.AppId
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubscriptionUrl != nil {
+		in, out := &in.SubscriptionUrl, &out.SubscriptionUrl
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubscribedTenantId != nil {
+		in, out := &in.SubscribedTenantId, &out.SubscribedTenantId
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubscriptionGUID != nil {
+		in, out := &in.SubscriptionGUID, &out.SubscriptionGUID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FailureReason != nil {
+		in, out := &in.FailureReason, &out.FailureReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubscriptionObservation.
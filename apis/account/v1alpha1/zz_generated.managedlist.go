@@ -74,6 +74,15 @@ func (l *ServiceBindingList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ServiceCatalogList.
+func (l *ServiceCatalogList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this ServiceInstanceList.
 func (l *ServiceInstanceList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -92,6 +101,15 @@ func (l *ServiceManagerList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this ServiceManagerPlatformList.
+func (l *ServiceManagerPlatformList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this SubaccountList.
 func (l *SubaccountList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
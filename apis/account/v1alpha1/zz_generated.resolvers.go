@@ -219,6 +219,48 @@ func (mg *ServiceBinding) ResolveReferences(ctx context.Context, c client.Reader
 	return nil
 }
 
+// ResolveReferences of this ServiceCatalog.
+func (mg *ServiceCatalog) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ServiceManagerSecret,
+		Extract:      ServiceManagerSecret(),
+		Reference:    mg.Spec.ForProvider.ServiceManagerRef,
+		Selector:     mg.Spec.ForProvider.ServiceManagerSelector,
+		To: reference.To{
+			List:    &ServiceManagerList{},
+			Managed: &ServiceManager{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ServiceManagerSecret")
+	}
+	mg.Spec.ForProvider.ServiceManagerSecret = rsp.ResolvedValue
+	mg.Spec.ForProvider.ServiceManagerRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ServiceManagerSecretNamespace,
+		Extract:      ServiceManagerSecretNamespace(),
+		Reference:    mg.Spec.ForProvider.ServiceManagerRef,
+		Selector:     mg.Spec.ForProvider.ServiceManagerSelector,
+		To: reference.To{
+			List:    &ServiceManagerList{},
+			Managed: &ServiceManager{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ServiceManagerSecretNamespace")
+	}
+	mg.Spec.ForProvider.ServiceManagerSecretNamespace = rsp.ResolvedValue
+	mg.Spec.ForProvider.ServiceManagerRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this ServiceInstance.
 func (mg *ServiceInstance) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -226,6 +268,22 @@ func (mg *ServiceInstance) ResolveReferences(ctx context.Context, c client.Reade
 	var rsp reference.ResolutionResponse
 	var err error
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ReferencedInstanceID),
+		Extract:      ServiceInstanceUuid(),
+		Reference:    mg.Spec.ForProvider.ReferencedInstanceRef,
+		Selector:     mg.Spec.ForProvider.ReferencedInstanceSelector,
+		To: reference.To{
+			List:    &ServiceInstanceList{},
+			Managed: &ServiceInstance{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ReferencedInstanceID")
+	}
+	mg.Spec.ForProvider.ReferencedInstanceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ReferencedInstanceRef = rsp.ResolvedReference
+
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: mg.Spec.ForProvider.ServiceManagerSecret,
 		Extract:      ServiceManagerSecret(),
@@ -303,6 +361,48 @@ func (mg *ServiceManager) ResolveReferences(ctx context.Context, c client.Reader
 	return nil
 }
 
+// ResolveReferences of this ServiceManagerPlatform.
+func (mg *ServiceManagerPlatform) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ServiceManagerSecret,
+		Extract:      ServiceManagerSecret(),
+		Reference:    mg.Spec.ForProvider.ServiceManagerRef,
+		Selector:     mg.Spec.ForProvider.ServiceManagerSelector,
+		To: reference.To{
+			List:    &ServiceManagerList{},
+			Managed: &ServiceManager{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ServiceManagerSecret")
+	}
+	mg.Spec.ForProvider.ServiceManagerSecret = rsp.ResolvedValue
+	mg.Spec.ForProvider.ServiceManagerRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.ServiceManagerSecretNamespace,
+		Extract:      ServiceManagerSecretNamespace(),
+		Reference:    mg.Spec.ForProvider.ServiceManagerRef,
+		Selector:     mg.Spec.ForProvider.ServiceManagerSelector,
+		To: reference.To{
+			List:    &ServiceManagerList{},
+			Managed: &ServiceManager{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ServiceManagerSecretNamespace")
+	}
+	mg.Spec.ForProvider.ServiceManagerSecretNamespace = rsp.ResolvedValue
+	mg.Spec.ForProvider.ServiceManagerRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this Subaccount.
 func (mg *Subaccount) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
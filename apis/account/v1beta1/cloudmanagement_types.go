@@ -34,11 +34,13 @@ type CloudManagementParameters struct {
 	// +kubebuilder:validation:Optional
 	ServiceManagerRef *xpv1.Reference `json:"serviceManagerRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"ServiceManager" reference-apiversion:"v1alpha1"`
 
+	// secret of the service manager the cloud management instance is created in, derived from serviceManagerRef while it is set
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManager
 	// +crossplane:generate:reference:refFieldName=ServiceManagerRef
 	// +crossplane:generate:reference:selectorFieldName=ServiceManagerSelector
 	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManagerSecret()
 	ServiceManagerSecret string `json:"serviceManagerSecret,omitempty"`
+	// namespace of that service manager secret, derived from serviceManagerRef while it is set
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.ServiceManager
 	// +crossplane:generate:reference:refFieldName=ServiceManagerRef
 	// +crossplane:generate:reference:selectorFieldName=ServiceManagerSelector
@@ -172,3 +174,8 @@ var (
 func init() {
 	SchemeBuilder.Register(&CloudManagement{}, &CloudManagementList{})
 }
+
+// CredentialsReference returns the reference the service manager credentials secret is resolved from
+func (mg *CloudManagement) CredentialsReference() *xpv1.Reference {
+	return mg.Spec.ForProvider.ServiceManagerRef
+}
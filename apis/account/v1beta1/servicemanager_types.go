@@ -40,11 +40,11 @@ type ServiceManagerParameters struct {
 	// +kubebuilder:validation:Optional
 	SubaccountRef *xpv1.Reference `json:"subaccountRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"Subaccount" reference-apiversion:"v1alpha1"`
 
-	// Planname for service manager instance
+	// Planname for service manager instance, changing it migrates to a new instance and binding of that plan,
+	// the old ones are deleted after the overlap period
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Enum=subaccount-admin;service-operator-access;container;subaccount-audit
 	// +kubebuilder:default:=service-operator-access
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="planName can't be updated once set"
 	PlanName string `json:"planName,omitempty"`
 
 	// Name of created service instance, Defaults to "managed-service-manager"
@@ -52,10 +52,49 @@ type ServiceManagerParameters struct {
 	// Name of created service binding, Defaults to "managed-service-manager-binding"
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="serviceBindingName can't be updated once set"
 	ServiceBindingName string `json:"serviceBindingName,omitempty"`
+
+	// Interval after which the binding is replaced by a new one, rotation is disabled if not set
+	// +kubebuilder:validation:Optional
+	RotationInterval *metav1.Duration `json:"rotationInterval,omitempty"`
+	// Time the old binding and instance are kept after a rotation or plan migration, so that consumers can pick up the new credentials
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="1h"
+	OverlapPeriod *metav1.Duration `json:"overlapPeriod,omitempty"`
 }
 
 type DataSourceLookup struct {
 	ServiceManagerPlanID string `json:"serviceManagerPlanID,omitempty"`
+	// name of the plan the id has been looked up for
+	ServiceManagerPlanName string `json:"serviceManagerPlanName,omitempty"`
+}
+
+// RetiredResources are a replaced instance and/or binding, kept until the overlap period has passed
+type RetiredResources struct {
+	// instance of the retired binding
+	ServiceInstanceID string `json:"serviceInstanceID,omitempty"`
+	// only set if the instance has been replaced as well and is deleted together with the binding
+	ServiceInstanceName string `json:"serviceInstanceName,omitempty"`
+	ServiceBindingID    string `json:"serviceBindingID,omitempty"`
+	ServiceBindingName  string `json:"serviceBindingName,omitempty"`
+	// the resources are deleted once this time has passed
+	DeleteAfter metav1.Time `json:"deleteAfter"`
+}
+
+// PendingResources are an instance and/or binding being created to replace the current ones,
+// recorded before their creation so that an interrupted switch can be resumed or cleaned up
+type PendingResources struct {
+	// instance of the new binding, only created if the plan is migrated
+	ServiceInstanceID string `json:"serviceInstanceID,omitempty"`
+	// only set if a new instance is created along with the binding
+	ServiceInstanceName   string `json:"serviceInstanceName,omitempty"`
+	ServiceInstancePlanID string `json:"serviceInstancePlanID,omitempty"`
+	// set once the binding has been created
+	ServiceBindingID   string `json:"serviceBindingID,omitempty"`
+	ServiceBindingName string `json:"serviceBindingName,omitempty"`
+	// start of the creation, used as creation time of the binding once switched to it
+	CreatedAt metav1.Time `json:"createdAt"`
+	// current resources, retired once switched to the new ones
+	Retired RetiredResources `json:"retired,omitempty"`
 }
 
 // ServiceManagerObservation are the observable fields of a ServiceManager.
@@ -66,6 +105,18 @@ type ServiceManagerObservation struct {
 	ServiceInstanceID string `json:"serviceInstanceID,omitempty"`
 	// currently bound service binding id
 	ServiceBindingID string `json:"serviceBindingID,omitempty"`
+	// plan id of the currently bound service instance, differs from the looked up plan id until a plan migration is done
+	ServiceInstancePlanID string `json:"serviceInstancePlanID,omitempty"`
+	// name of the currently bound service instance, only set if it differs from the one in the spec after a plan migration
+	ServiceInstanceName string `json:"serviceInstanceName,omitempty"`
+	// name of the currently bound service binding, only set if it differs from the one in the spec after a rotation
+	ServiceBindingName string `json:"serviceBindingName,omitempty"`
+	// creation time of the currently bound service binding, used to schedule rotations
+	BindingCreatedAt *metav1.Time `json:"bindingCreatedAt,omitempty"`
+	// replaced instances and bindings waiting for deletion
+	Retired []RetiredResources `json:"retired,omitempty"`
+	// resources of a rotation or plan migration that hasn't been switched to yet
+	Pending *PendingResources `json:"pending,omitempty"`
 
 	DataSourceLookup *DataSourceLookup `json:"dataSourceLookup,omitempty"`
 }
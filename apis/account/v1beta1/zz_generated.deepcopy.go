@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -412,6 +413,39 @@ func (in *Instance) DeepCopy() *Instance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingResources) DeepCopyInto(out *PendingResources) {
+	*out = *in
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	in.Retired.DeepCopyInto(&out.Retired)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingResources.
+func (in *PendingResources) DeepCopy() *PendingResources {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetiredResources) DeepCopyInto(out *RetiredResources) {
+	*out = *in
+	in.DeleteAfter.DeepCopyInto(&out.DeleteAfter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetiredResources.
+func (in *RetiredResources) DeepCopy() *RetiredResources {
+	if in == nil {
+		return nil
+	}
+	out := new(RetiredResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceManager) DeepCopyInto(out *ServiceManager) {
 	*out = *in
@@ -474,6 +508,22 @@ func (in *ServiceManagerList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceManagerObservation) DeepCopyInto(out *ServiceManagerObservation) {
 	*out = *in
+	if in.BindingCreatedAt != nil {
+		in, out := &in.BindingCreatedAt, &out.BindingCreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Retired != nil {
+		in, out := &in.Retired, &out.Retired
+		*out = make([]RetiredResources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = new(PendingResources)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DataSourceLookup != nil {
 		in, out := &in.DataSourceLookup, &out.DataSourceLookup
 		*out = new(DataSourceLookup)
@@ -504,6 +554,16 @@ func (in *ServiceManagerParameters) DeepCopyInto(out *ServiceManagerParameters)
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.OverlapPeriod != nil {
+		in, out := &in.OverlapPeriod, &out.OverlapPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceManagerParameters.
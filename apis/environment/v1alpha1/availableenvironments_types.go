@@ -0,0 +1,151 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+const EnvironmentAvailabilityCondition xpv1.ConditionType = "EnvironmentAvailability"
+const PlanNotAvailable xpv1.ConditionReason = "PlanNotAvailable"
+const PlanAvailable xpv1.ConditionReason = "PlanAvailable"
+
+// EnvironmentPlanUnavailable indicates that the environment type or plan isn't offered to the subaccount
+func EnvironmentPlanUnavailable(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               EnvironmentAvailabilityCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             PlanNotAvailable,
+		Message:            msg,
+	}
+}
+
+// EnvironmentPlanAvailable indicates that the subaccount is entitled to create the environment
+func EnvironmentPlanAvailable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               EnvironmentAvailabilityCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             PlanAvailable,
+	}
+}
+
+// AvailableEnvironmentsParameters are the configurable fields of an AvailableEnvironments.
+type AvailableEnvironmentsParameters struct {
+	// Only list environments of this type, e.g. kyma
+	// +optional
+	EnvironmentType string `json:"environmentType,omitempty"`
+}
+
+// AvailableEnvironment is an environment type and plan the subaccount is entitled to
+type AvailableEnvironment struct {
+	// Type of the environment, e.g. cloudfoundry or kyma
+	EnvironmentType string `json:"environmentType"`
+	// Name of the service offering the environment
+	ServiceName string `json:"serviceName,omitempty"`
+	// Name of the service plan, to be used as planName of the environment
+	PlanName string `json:"planName"`
+	// Landscape the environment can be created on
+	LandscapeLabel string `json:"landscapeLabel,omitempty"`
+	// Availability level of the plan, e.g. GA or BETA
+	AvailabilityLevel string `json:"availabilityLevel,omitempty"`
+	// Whether another instance of the environment can be created in the subaccount
+	AllowAdditionalEnvironmentInstance *bool `json:"allowAdditionalEnvironmentInstance,omitempty"`
+	// Whether the plan of an existing environment can be changed to this plan
+	PlanUpdatable *bool `json:"planUpdatable,omitempty"`
+}
+
+// AvailableEnvironmentsObservation are the observable fields of an AvailableEnvironments.
+type AvailableEnvironmentsObservation struct {
+	// Environments lists the environment types and plans the subaccount is entitled to
+	Environments []AvailableEnvironment `json:"environments,omitempty"`
+}
+
+// An AvailableEnvironmentsSpec defines the desired state of an AvailableEnvironments.
+type AvailableEnvironmentsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	// +optional
+	ForProvider AvailableEnvironmentsParameters `json:"forProvider,omitempty"`
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.Subaccount
+	// +crossplane:generate:reference:refFieldName=SubaccountRef
+	// +crossplane:generate:reference:selectorFieldName=SubaccountSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.SubaccountUuid()
+	SubaccountGuid string `json:"subaccountGuid,omitempty"`
+	// +kubebuilder:validation:Optional
+	SubaccountSelector *xpv1.Selector `json:"subaccountSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	SubaccountRef *xpv1.Reference `json:"subaccountRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"Subaccount" reference-apiversion:"v1alpha1"`
+
+	// +kubebuilder:validation:Optional
+	CloudManagementSelector *xpv1.Selector `json:"cloudManagementSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	CloudManagementRef *xpv1.Reference `json:"cloudManagementRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"CloudManagement" reference-apiversion:"v1alpha1"`
+
+	// secret with the cloud management credentials the available environments are listed with, taken from cloudManagementRef whenever that is set
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
+	// +crossplane:generate:reference:refFieldName=CloudManagementRef
+	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagementSecret()
+	CloudManagementSecret string `json:"cloudManagementSecret,omitempty"`
+	// namespace of cloudManagementSecret, taken from cloudManagementRef whenever that is set
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
+	// +crossplane:generate:reference:refFieldName=CloudManagementRef
+	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagementSecretSecretNamespace()
+	CloudManagementSecretNamespace string `json:"cloudManagementSecretNamespace,omitempty"`
+}
+
+// An AvailableEnvironmentsStatus represents the observed state of an AvailableEnvironments.
+type AvailableEnvironmentsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AvailableEnvironmentsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AvailableEnvironments is a read-only managed resource listing the environment types and plans, which can be
+// created in a subaccount. The parameter schemas of the plans are left out to keep the status small, environments
+// look them up when they need them. Deleting it doesn't affect the subaccount.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type AvailableEnvironments struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AvailableEnvironmentsSpec   `json:"spec"`
+	Status AvailableEnvironmentsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AvailableEnvironmentsList contains a list of AvailableEnvironments
+type AvailableEnvironmentsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AvailableEnvironments `json:"items"`
+}
+
+// AvailableEnvironments type metadata.
+var (
+	AvailableEnvironmentsKind             = reflect.TypeOf(AvailableEnvironments{}).Name()
+	AvailableEnvironmentsGroupKind        = schema.GroupKind{Group: Group, Kind: AvailableEnvironmentsKind}.String()
+	AvailableEnvironmentsKindAPIVersion   = AvailableEnvironmentsKind + "." + SchemeGroupVersion.String()
+	AvailableEnvironmentsGroupVersionKind = SchemeGroupVersion.WithKind(AvailableEnvironmentsKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AvailableEnvironments{}, &AvailableEnvironmentsList{})
+}
+
+// CredentialsReference returns the reference the cloud management credentials secret is resolved from
+func (mg *AvailableEnvironments) CredentialsReference() *xpv1.Reference {
+	return mg.Spec.CloudManagementRef
+}
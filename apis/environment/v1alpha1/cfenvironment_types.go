@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -14,6 +15,15 @@ const (
 	InstanceStateCreating = "CREATING"
 	InstanceStateDeleting = "DELETING"
 	InstanceStateUpdating = "UPDATING"
+
+	InstanceStateCreationFailed = "CREATION_FAILED"
+	InstanceStateUpdateFailed   = "UPDATE_FAILED"
+	InstanceStateDeletionFailed = "DELETION_FAILED"
+)
+
+const (
+	OrgManagersPolicyInitial       = "Initial"
+	OrgManagersPolicyAuthoritative = "Authoritative"
 )
 
 const (
@@ -49,19 +59,66 @@ type CfEnvironmentParameters struct {
 	// +optional
 	Managers []string `json:"initialOrgManagers,omitempty"`
 
+	// Initial only assigns initialOrgManagers at creation and leaves the org managers alone afterwards.
+	// Authoritative keeps the org managers in sync with orgManagers, managers missing in the org are added and
+	// managers not listed are removed, except for the technical user of the provider.
+	// +kubebuilder:validation:Enum=Initial;Authoritative
+	// +kubebuilder:default=Initial
+	// +optional
+	OrgManagersPolicy string `json:"orgManagersPolicy,omitempty"`
+
+	// Users to hold the Org Manager role, only used with orgManagersPolicy Authoritative
+	// +optional
+	OrgManagers []User `json:"orgManagers,omitempty"`
+
 	// Landscape, region of the cloud foundry org, e.g. cf-eu12
-	// must be set, when cloud foundry name is set
+	// must be set, when cloud foundry name is set. Can't be changed after creation.
 	// +kubebuilder:validation:MinLength=1
 	// +optional
 	Landscape string `json:"landscape,omitempty"`
 
-	// Org name of the Cloud Foundry environment
+	// Org name of the Cloud Foundry environment, changing it renames the org
 	// +optional
 	OrgName string `json:"orgName,omitempty"`
 
-	// CF environment instance name
+	// CF environment instance name, can't be changed after creation
 	// +optional
 	EnvironmentName string `json:"environmentName,omitempty"`
+
+	// Plan of the Cloud Foundry environment, e.g. standard or free. Defaults to standard on creation,
+	// changing it updates the environment.
+	// +optional
+	PlanName string `json:"planName,omitempty"`
+
+	// Custom labels of the environment instance, they replace all user-defined labels of the environment.
+	// Labels are not managed if unset, an empty map removes all labels.
+	// +optional
+	Labels *map[string][]string `json:"labels,omitempty"`
+}
+
+const EnvironmentUpdateCondition xpv1.ConditionType = "EnvironmentUpdate"
+const ImmutableFieldChanged xpv1.ConditionReason = "ImmutableFieldChanged"
+const EnvironmentUpdatable xpv1.ConditionReason = "EnvironmentUpdatable"
+
+// EnvironmentUpdateRejected indicates that fields were changed in the spec, which can't be applied to an existing environment
+func EnvironmentUpdateRejected(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               EnvironmentUpdateCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ImmutableFieldChanged,
+		Message:            msg,
+	}
+}
+
+// EnvironmentUpdateAccepted indicates that all changes of the spec can be applied to the environment
+func EnvironmentUpdateAccepted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               EnvironmentUpdateCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             EnvironmentUpdatable,
+	}
 }
 
 // CfEnvironmentObservation  are the observable fields of a CloudFoundryEnvironment.
@@ -90,11 +147,13 @@ type CfEnvironmentSpec struct {
 	// +kubebuilder:validation:Optional
 	CloudManagementRef *xpv1.Reference `json:"cloudManagementRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"CloudManagement" reference-apiversion:"v1alpha1"`
 
+	// secret with the cloud management credentials the Cloud Foundry environment is provisioned with, re-resolved from cloudManagementRef on every reconcile if set
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
 	// +crossplane:generate:reference:refFieldName=CloudManagementRef
 	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
 	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagementSecret()
 	CloudManagementSecret string `json:"cloudManagemxentSecret,omitempty"`
+	// namespace of cloudManagementSecret, re-resolved together with it
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
 	// +crossplane:generate:reference:refFieldName=CloudManagementRef
 	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
@@ -150,3 +209,8 @@ var (
 func init() {
 	SchemeBuilder.Register(&CloudFoundryEnvironment{}, &CloudFoundryEnvironmentList{})
 }
+
+// CredentialsReference returns the reference the cloud management credentials secret is resolved from
+func (mg *CloudFoundryEnvironment) CredentialsReference() *xpv1.Reference {
+	return mg.Spec.CloudManagementRef
+}
@@ -0,0 +1,50 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCfEnvironmentLabelsRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels *map[string][]string
+		json   string
+	}{
+		{
+			name: "unset labels are omitted",
+			json: `{}`,
+		},
+		{
+			name:   "empty labels are kept to remove all labels",
+			labels: &map[string][]string{},
+			json:   `{"labels":{}}`,
+		},
+		{
+			name:   "labels are kept",
+			labels: &map[string][]string{"team": {"a"}},
+			json:   `{"labels":{"team":["a"]}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw, err := json.Marshal(CfEnvironmentParameters{Labels: tt.labels})
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(raw) != tt.json {
+				t.Errorf("json.Marshal() = %s, want %s", raw, tt.json)
+			}
+
+			var got CfEnvironmentParameters
+			if err := json.Unmarshal(raw, &got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.labels, got.Labels); diff != "" {
+				t.Errorf("json.Unmarshal() -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
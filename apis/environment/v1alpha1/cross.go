@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"encoding/json"
+
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
@@ -113,3 +115,86 @@ func KymaInstanceId() reference.ExtractValueFn {
 		return *sg.Status.AtProvider.ID
 	}
 }
+
+// QuotaLimits are the limits of an org or space quota, a limit which is not set is unlimited
+type QuotaLimits struct {
+	// Memory of all app processes and tasks together in MB
+	// +optional
+	TotalMemoryInMB *int `json:"totalMemoryInMB,omitempty"`
+	// Memory of a single app process or task in MB
+	// +optional
+	PerProcessMemoryInMB *int `json:"perProcessMemoryInMB,omitempty"`
+	// Number of app instances
+	// +optional
+	TotalInstances *int `json:"totalInstances,omitempty"`
+	// Number of routes
+	// +optional
+	TotalRoutes *int `json:"totalRoutes,omitempty"`
+	// Number of ports that can be reserved by routes
+	// +optional
+	TotalReservedPorts *int `json:"totalReservedPorts,omitempty"`
+	// Number of service instances
+	// +optional
+	TotalServiceInstances *int `json:"totalServiceInstances,omitempty"`
+	// Number of service keys
+	// +optional
+	TotalServiceKeys *int `json:"totalServiceKeys,omitempty"`
+	// Whether instances of paid service plans can be created
+	// +kubebuilder:default=true
+	// +optional
+	PaidServicesAllowed *bool `json:"paidServicesAllowed,omitempty"`
+}
+
+// cfOrgLabels are the broker labels of a CloudFoundryEnvironment identifying its org
+type cfOrgLabels struct {
+	OrgId       string `json:"Org Id"`
+	ApiEndpoint string `json:"API Endpoint"`
+}
+
+func orgLabelsOf(mg resource.Managed) cfOrgLabels {
+	env, ok := mg.(*CloudFoundryEnvironment)
+	if !ok || env.Status.AtProvider.Labels == nil {
+		return cfOrgLabels{}
+	}
+	var labels cfOrgLabels
+	if err := json.Unmarshal([]byte(*env.Status.AtProvider.Labels), &labels); err != nil {
+		return cfOrgLabels{}
+	}
+	return labels
+}
+
+// CfOrgGuid extracts the GUID of the Cloud Foundry org from a CloudFoundryEnvironment
+func CfOrgGuid() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		return orgLabelsOf(mg).OrgId
+	}
+}
+
+// CfApiEndpoint extracts the Cloud Foundry API endpoint of the org from a CloudFoundryEnvironment
+func CfApiEndpoint() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		return orgLabelsOf(mg).ApiEndpoint
+	}
+}
+
+// SpaceGuid extracts the GUID of a Space
+func SpaceGuid() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		space, ok := mg.(*Space)
+		if !ok || space.Status.AtProvider.ID == nil {
+			return ""
+		}
+		return *space.Status.AtProvider.ID
+	}
+}
+
+// SpaceApiEndpoint extracts the Cloud Foundry API endpoint a Space has been created with
+func SpaceApiEndpoint() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		space, ok := mg.(*Space)
+		if !ok {
+			return ""
+		}
+		return space.Spec.ApiEndpoint
+	}
+}
@@ -0,0 +1,145 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// EnvironmentInstanceParameters are the configurable fields of an EnvironmentInstance.
+type EnvironmentInstanceParameters struct {
+	// Type of the environment as listed by the available environments of the subaccount, e.g. abap or kyma
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="environmentType can't be updated once set"
+	EnvironmentType string `json:"environmentType"`
+
+	// Name of the service offering the environment, e.g. abap
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="serviceName can't be updated once set"
+	ServiceName string `json:"serviceName"`
+
+	// Name of the service plan, e.g. standard
+	// +kubebuilder:validation:MinLength=1
+	PlanName string `json:"planName"`
+
+	// Name of the landscape the environment is created on, required by some environment types only
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="landscape can't be updated once set"
+	// +optional
+	Landscape string `json:"landscape,omitempty"`
+
+	// Name of the environment instance, defaults to the name of the managed resource
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="instanceName can't be updated once set"
+	// +optional
+	InstanceName string `json:"instanceName,omitempty"`
+
+	// Provisioning parameters of the environment, their schema depends on environment type and plan.
+	//
+	// The Parameters field is NOT secret or secured in any way and should
+	// NEVER be used to hold sensitive information.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Parameters runtime.RawExtension `json:"parameters,omitempty"`
+
+	// Custom BTP labels of the environment instance. If set, labels missing here are removed from the instance.
+	// +optional
+	Labels map[string][]string `json:"labels,omitempty"`
+}
+
+// EnvironmentInstanceObservation are the observable fields of an EnvironmentInstance.
+type EnvironmentInstanceObservation struct {
+	EnvironmentObservation `json:",inline"`
+}
+
+// An EnvironmentInstanceSpec defines the desired state of an EnvironmentInstance.
+type EnvironmentInstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EnvironmentInstanceParameters `json:"forProvider"`
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.Subaccount
+	// +crossplane:generate:reference:refFieldName=SubaccountRef
+	// +crossplane:generate:reference:selectorFieldName=SubaccountSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.SubaccountUuid()
+	SubaccountGuid string `json:"subaccountGuid,omitempty"`
+	// +kubebuilder:validation:Optional
+	SubaccountSelector *xpv1.Selector `json:"subaccountSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	SubaccountRef *xpv1.Reference `json:"subaccountRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"Subaccount" reference-apiversion:"v1alpha1"`
+
+	// +kubebuilder:validation:Optional
+	CloudManagementSelector *xpv1.Selector `json:"cloudManagementSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	CloudManagementRef *xpv1.Reference `json:"cloudManagementRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"CloudManagement" reference-apiversion:"v1alpha1"`
+
+	// secret with the cloud management credentials used to manage the environment instance, kept in sync with cloudManagementRef if one is set
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
+	// +crossplane:generate:reference:refFieldName=CloudManagementRef
+	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagementSecret()
+	CloudManagementSecret string `json:"cloudManagementSecret,omitempty"`
+	// namespace of cloudManagementSecret, kept in sync with cloudManagementRef as well
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
+	// +crossplane:generate:reference:refFieldName=CloudManagementRef
+	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagementSecretSecretNamespace()
+	CloudManagementSecretNamespace string `json:"cloudManagementSecretNamespace,omitempty"`
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
+	// +crossplane:generate:reference:refFieldName=CloudManagementRef
+	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagementSubaccountUuid()
+	CloudManagementSubaccountGuid string `json:"cloudManagementSubaccountGuid,omitempty"`
+}
+
+// An EnvironmentInstanceStatus represents the observed state of an EnvironmentInstance.
+type EnvironmentInstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EnvironmentInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EnvironmentInstance is a managed resource for any environment type offered by the provisioning service of a
+// subaccount, e.g. ABAP or environments of custom brokers. The broker labels of the instance are published as
+// connection details.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.environmentType"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type EnvironmentInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EnvironmentInstanceSpec   `json:"spec"`
+	Status EnvironmentInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EnvironmentInstanceList contains a list of EnvironmentInstance
+type EnvironmentInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EnvironmentInstance `json:"items"`
+}
+
+// EnvironmentInstance type metadata.
+var (
+	EnvironmentInstanceKind             = reflect.TypeOf(EnvironmentInstance{}).Name()
+	EnvironmentInstanceGroupKind        = schema.GroupKind{Group: Group, Kind: EnvironmentInstanceKind}.String()
+	EnvironmentInstanceKindAPIVersion   = EnvironmentInstanceKind + "." + SchemeGroupVersion.String()
+	EnvironmentInstanceGroupVersionKind = SchemeGroupVersion.WithKind(EnvironmentInstanceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EnvironmentInstance{}, &EnvironmentInstanceList{})
+}
+
+// CredentialsReference returns the reference the cloud management credentials secret is resolved from
+func (mg *EnvironmentInstance) CredentialsReference() *xpv1.Reference {
+	return mg.Spec.CloudManagementRef
+}
@@ -3,6 +3,7 @@ package v1alpha1
 import (
 	"reflect"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -17,6 +18,101 @@ const (
 	IgnoreCircuitBreaker = Group + "/ignore-circuit-breaker"
 )
 
+const ParameterValidationCondition xpv1.ConditionType = "ParameterValidation"
+const InvalidParameters xpv1.ConditionReason = "InvalidParameters"
+const ParametersValid xpv1.ConditionReason = "ParametersValid"
+
+// ParametersRejected indicates that the parameters violate the schema of the plan or change fields which can't be updated
+func ParametersRejected(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               ParameterValidationCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             InvalidParameters,
+		Message:            msg,
+	}
+}
+
+// ParametersAccepted indicates that the parameters can be applied to the environment
+func ParametersAccepted() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               ParameterValidationCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ParametersValid,
+	}
+}
+
+const (
+	CleanupPolicyNone   = "None"
+	CleanupPolicyWait   = "Wait"
+	CleanupPolicyDelete = "Delete"
+)
+
+const CleanupCondition xpv1.ConditionType = "Cleanup"
+const CleanupPending xpv1.ConditionReason = "DependentsRemaining"
+const CleanupCompleted xpv1.ConditionReason = "CleanupCompleted"
+const ClusterCleanupSkipped xpv1.ConditionReason = "ClusterCleanupSkipped"
+
+// CleanupInProgress indicates that deprovisioning waits for resources within the environment to be removed
+func CleanupInProgress(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               CleanupCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             CleanupPending,
+		Message:            msg,
+	}
+}
+
+// CleanupDone indicates that no dependent resources are left and the environment is deprovisioned
+func CleanupDone() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               CleanupCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             CleanupCompleted,
+	}
+}
+
+// CleanupSkipped indicates that the environment is deprovisioned without cleaning up its cluster, as the cluster
+// can't be accessed
+func CleanupSkipped(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               CleanupCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ClusterCleanupSkipped,
+		Message:            msg,
+	}
+}
+
+const KubeconfigCondition xpv1.ConditionType = "KubeconfigAvailable"
+const KubeconfigDownloadFailed xpv1.ConditionReason = "KubeconfigDownloadFailed"
+const KubeconfigDownloaded xpv1.ConditionReason = "KubeconfigDownloaded"
+
+// KubeconfigUnavailable indicates that the kubeconfig of the environment couldn't be downloaded, the connection secret
+// keeps its previous content
+func KubeconfigUnavailable(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               KubeconfigCondition,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             KubeconfigDownloadFailed,
+		Message:            msg,
+	}
+}
+
+// KubeconfigAvailable indicates that the connection secret holds a freshly downloaded kubeconfig
+func KubeconfigAvailable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               KubeconfigCondition,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             KubeconfigDownloaded,
+	}
+}
+
 // KymaEnvironmentParameters are the configurable fields of a KymaEnvironment.
 type KymaEnvironmentParameters struct {
 	PlanName string `json:"planName"`
@@ -29,11 +125,39 @@ type KymaEnvironmentParameters struct {
 	// in a Secret and use the ParametersFrom field.
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Parameters runtime.RawExtension `json:"parameters,omitempty"`
+
+	// User-defined BTP labels of the environment instance, e.g. for cost allocation.
+	// Labels missing here are removed from the environment, an empty map removes all labels.
+	// If the field is unset the labels are left untouched.
+	// +optional
+	Labels *map[string][]string `json:"labels,omitempty"`
+
+	// CleanupPolicy defines how resources depending on the environment are handled on deletion.
+	// None deprovisions the environment right away. Wait deprovisions it only once no KymaEnvironmentBindings of the
+	// environment and no ServiceInstances and ServiceBindings of the BTP service operator are left in the cluster,
+	// Delete deletes them beforehand. Wait and Delete revoke all bindings of the environment before deprovisioning and
+	// access the cluster with a short-lived binding of their own, as the kubeconfig of the connection secret
+	// authenticates with the kubectl oidc-login plugin, which can't run within the provider. The cluster is left as is
+	// if the environment is not in state OK or the cluster can't be reached, the Cleanup condition reports it as skipped.
+	// Switching back to None deprovisions an environment whose cleanup is stuck.
+	// +kubebuilder:validation:Enum=None;Wait;Delete
+	// +kubebuilder:default=None
+	// +optional
+	CleanupPolicy string `json:"cleanupPolicy,omitempty"`
 }
 
 // KymaEnvironmentObservation are the observable fields of a KymaEnvironment.
 type KymaEnvironmentObservation struct {
 	EnvironmentObservation `json:",inline"`
+
+	// Expiry of the credentials embedded into the kubeconfig of the connection secret, unset if they don't expire.
+	// The kubeconfig is downloaded again shortly before.
+	// +optional
+	KubeconfigExpiresAt *metav1.Time `json:"kubeconfigExpiresAt,omitempty"`
+
+	// Time of the last download of the kubeconfig, it limits how often expiring credentials are downloaded again.
+	// +optional
+	KubeconfigDownloadedAt *metav1.Time `json:"kubeconfigDownloadedAt,omitempty"`
 }
 
 // A KymaEnvironmentSpec defines the desired state of a KymaEnvironment.
@@ -55,11 +179,13 @@ type KymaEnvironmentSpec struct {
 	// +kubebuilder:validation:Optional
 	CloudManagementRef *xpv1.Reference `json:"cloudManagementRef,omitempty" reference-group:"account.btp.sap.crossplane.io" reference-kind:"CloudManagement" reference-apiversion:"v1alpha1"`
 
+	// secret with the cloud management credentials the Kyma environment is provisioned with, follows the connection secret of cloudManagementRef while that is set
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
 	// +crossplane:generate:reference:refFieldName=CloudManagementRef
 	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
 	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagementSecret()
 	CloudManagementSecret string `json:"cloudManagementSecret,omitempty"`
+	// namespace of cloudManagementSecret, follows cloudManagementRef the same way
 	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/account/v1alpha1.CloudManagement
 	// +crossplane:generate:reference:refFieldName=CloudManagementRef
 	// +crossplane:generate:reference:selectorFieldName=CloudManagementSelector
@@ -137,3 +263,8 @@ var (
 func init() {
 	SchemeBuilder.Register(&KymaEnvironment{}, &KymaEnvironmentList{})
 }
+
+// CredentialsReference returns the reference the cloud management credentials secret is resolved from
+func (mg *KymaEnvironment) CredentialsReference() *xpv1.Reference {
+	return mg.Spec.CloudManagementRef
+}
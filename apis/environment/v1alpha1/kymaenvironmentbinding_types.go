@@ -115,3 +115,8 @@ var (
 func init() {
 	SchemeBuilder.Register(&KymaEnvironmentBinding{}, &KymaEnvironmentBindingList{})
 }
+
+// CredentialsReference returns the reference the cloud management credentials secret is resolved from
+func (mg *KymaEnvironmentBinding) CredentialsReference() *xpv1.Reference {
+	return mg.Spec.CloudManagementRef
+}
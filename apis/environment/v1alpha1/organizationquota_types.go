@@ -0,0 +1,102 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OrganizationQuotaParameters are the configurable fields of an OrganizationQuota.
+type OrganizationQuotaParameters struct {
+	// Name of the quota, unique within the landscape
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	QuotaLimits `json:",inline"`
+}
+
+// OrganizationQuotaObservation are the observable fields of an OrganizationQuota.
+type OrganizationQuotaObservation struct {
+	// GUID of the quota
+	ID *string `json:"id,omitempty"`
+	// Current name of the quota
+	Name *string `json:"name,omitempty"`
+
+	QuotaLimits `json:",inline"`
+
+	// GUIDs of the orgs the quota is applied to
+	OrganizationGuids []string `json:"organizationGuids,omitempty"`
+}
+
+// An OrganizationQuotaSpec defines the desired state of an OrganizationQuota.
+type OrganizationQuotaSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationQuotaParameters `json:"forProvider"`
+
+	// Cloud Foundry API endpoint of the org
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CloudFoundryEnvironment
+	// +crossplane:generate:reference:refFieldName=CloudFoundryEnvironmentRef
+	// +crossplane:generate:reference:selectorFieldName=CloudFoundryEnvironmentSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CfApiEndpoint()
+	ApiEndpoint string `json:"apiEndpoint,omitempty"`
+	// GUID of the org the quota is applied to
+	// +kubebuilder:validation:XValidation:rule="oldSelf == '' || self == oldSelf",message="orgGuid can't be updated once set"
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CloudFoundryEnvironment
+	// +crossplane:generate:reference:refFieldName=CloudFoundryEnvironmentRef
+	// +crossplane:generate:reference:selectorFieldName=CloudFoundryEnvironmentSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CfOrgGuid()
+	OrgGuid string `json:"orgGuid,omitempty"`
+	// +kubebuilder:validation:Optional
+	CloudFoundryEnvironmentSelector *xpv1.Selector `json:"cloudFoundryEnvironmentSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	CloudFoundryEnvironmentRef *xpv1.Reference `json:"cloudFoundryEnvironmentRef,omitempty" reference-group:"environment.btp.sap.crossplane.io" reference-kind:"CloudFoundryEnvironment" reference-apiversion:"v1alpha1"`
+}
+
+// An OrganizationQuotaStatus represents the observed state of an OrganizationQuota.
+type OrganizationQuotaStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationQuotaObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationQuota is a Cloud Foundry quota definition applied to an org.
+// Managing org quotas requires admin permissions in the landscape, on deletion the org falls back to the "default" quota.
+// An existing quota with the same name is adopted and only observed, it is neither updated nor deleted.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MEMORY",type="integer",JSONPath=".spec.forProvider.totalMemoryInMB"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type OrganizationQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationQuotaSpec   `json:"spec"`
+	Status OrganizationQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationQuotaList contains a list of OrganizationQuota
+type OrganizationQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationQuota `json:"items"`
+}
+
+// OrganizationQuota type metadata.
+var (
+	OrganizationQuotaKind             = reflect.TypeOf(OrganizationQuota{}).Name()
+	OrganizationQuotaGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationQuotaKind}.String()
+	OrganizationQuotaKindAPIVersion   = OrganizationQuotaKind + "." + SchemeGroupVersion.String()
+	OrganizationQuotaGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationQuotaKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationQuota{}, &OrganizationQuotaList{})
+}
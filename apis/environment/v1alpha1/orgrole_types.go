@@ -0,0 +1,102 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+const (
+	OrgRoleUser           = "User"
+	OrgRoleManager        = "Manager"
+	OrgRoleBillingManager = "BillingManager"
+	OrgRoleAuditor        = "Auditor"
+)
+
+// OrgRoleParameters are the configurable fields of an OrgRole.
+type OrgRoleParameters struct {
+	// Role to assign within the org
+	// +kubebuilder:validation:Enum=User;Manager;BillingManager;Auditor
+	Type string `json:"type"`
+	// Username at the identity provider
+	// +kubebuilder:validation:MinLength=1
+	Username string `json:"username"`
+	// Origin picks the IDP
+	// +kubebuilder:default=sap.ids
+	// +optional
+	Origin string `json:"origin,omitempty"`
+}
+
+// An OrgRoleSpec defines the desired state of an OrgRole.
+type OrgRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="role assignments can't be updated, create a new one instead"
+	ForProvider OrgRoleParameters `json:"forProvider"`
+
+	// Cloud Foundry API endpoint of the org
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CloudFoundryEnvironment
+	// +crossplane:generate:reference:refFieldName=CloudFoundryEnvironmentRef
+	// +crossplane:generate:reference:selectorFieldName=CloudFoundryEnvironmentSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CfApiEndpoint()
+	ApiEndpoint string `json:"apiEndpoint,omitempty"`
+	// GUID of the org
+	// +kubebuilder:validation:XValidation:rule="oldSelf == '' || self == oldSelf",message="orgGuid can't be updated once set"
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CloudFoundryEnvironment
+	// +crossplane:generate:reference:refFieldName=CloudFoundryEnvironmentRef
+	// +crossplane:generate:reference:selectorFieldName=CloudFoundryEnvironmentSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CfOrgGuid()
+	OrgGuid string `json:"orgGuid,omitempty"`
+	// +kubebuilder:validation:Optional
+	CloudFoundryEnvironmentSelector *xpv1.Selector `json:"cloudFoundryEnvironmentSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	CloudFoundryEnvironmentRef *xpv1.Reference `json:"cloudFoundryEnvironmentRef,omitempty" reference-group:"environment.btp.sap.crossplane.io" reference-kind:"CloudFoundryEnvironment" reference-apiversion:"v1alpha1"`
+}
+
+// An OrgRoleStatus represents the observed state of an OrgRole.
+type OrgRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrgRole assigns an org role to a Cloud Foundry user
+// A role the user already holds is adopted, it is only revoked on deletion if it has been assigned by the provider
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.username"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type OrgRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrgRoleSpec   `json:"spec"`
+	Status OrgRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrgRoleList contains a list of OrgRole
+type OrgRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrgRole `json:"items"`
+}
+
+// OrgRole type metadata.
+var (
+	OrgRoleKind             = reflect.TypeOf(OrgRole{}).Name()
+	OrgRoleGroupKind        = schema.GroupKind{Group: Group, Kind: OrgRoleKind}.String()
+	OrgRoleKindAPIVersion   = OrgRoleKind + "." + SchemeGroupVersion.String()
+	OrgRoleGroupVersionKind = SchemeGroupVersion.WithKind(OrgRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrgRole{}, &OrgRoleList{})
+}
@@ -0,0 +1,94 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SpaceParameters are the configurable fields of a Space.
+type SpaceParameters struct {
+	// Name of the space, unique within the org. Changing it renames the space.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// SpaceObservation are the observable fields of a Space.
+type SpaceObservation struct {
+	// GUID of the space
+	ID *string `json:"id,omitempty"`
+	// Current name of the space
+	Name *string `json:"name,omitempty"`
+}
+
+// A SpaceSpec defines the desired state of a Space.
+type SpaceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SpaceParameters `json:"forProvider"`
+
+	// Cloud Foundry API endpoint of the org
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CloudFoundryEnvironment
+	// +crossplane:generate:reference:refFieldName=CloudFoundryEnvironmentRef
+	// +crossplane:generate:reference:selectorFieldName=CloudFoundryEnvironmentSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CfApiEndpoint()
+	ApiEndpoint string `json:"apiEndpoint,omitempty"`
+	// GUID of the org the space belongs to
+	// +kubebuilder:validation:XValidation:rule="oldSelf == '' || self == oldSelf",message="orgGuid can't be updated once set"
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CloudFoundryEnvironment
+	// +crossplane:generate:reference:refFieldName=CloudFoundryEnvironmentRef
+	// +crossplane:generate:reference:selectorFieldName=CloudFoundryEnvironmentSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CfOrgGuid()
+	OrgGuid string `json:"orgGuid,omitempty"`
+	// +kubebuilder:validation:Optional
+	CloudFoundryEnvironmentSelector *xpv1.Selector `json:"cloudFoundryEnvironmentSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	CloudFoundryEnvironmentRef *xpv1.Reference `json:"cloudFoundryEnvironmentRef,omitempty" reference-group:"environment.btp.sap.crossplane.io" reference-kind:"CloudFoundryEnvironment" reference-apiversion:"v1alpha1"`
+}
+
+// A SpaceStatus represents the observed state of a Space.
+type SpaceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SpaceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Space is a managed resource that represents a space within a Cloud Foundry org
+// An existing space with the same name is adopted, deleting the resource only deletes the space if it has been created by the provider
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type Space struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpaceSpec   `json:"spec"`
+	Status SpaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpaceList contains a list of Space
+type SpaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Space `json:"items"`
+}
+
+// Space type metadata.
+var (
+	SpaceKind             = reflect.TypeOf(Space{}).Name()
+	SpaceGroupKind        = schema.GroupKind{Group: Group, Kind: SpaceKind}.String()
+	SpaceKindAPIVersion   = SpaceKind + "." + SchemeGroupVersion.String()
+	SpaceGroupVersionKind = SchemeGroupVersion.WithKind(SpaceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Space{}, &SpaceList{})
+}
@@ -0,0 +1,113 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SpaceQuotaParameters are the configurable fields of a SpaceQuota.
+type SpaceQuotaParameters struct {
+	// Name of the quota, unique within the org
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	QuotaLimits `json:",inline"`
+}
+
+// SpaceQuotaObservation are the observable fields of a SpaceQuota.
+type SpaceQuotaObservation struct {
+	// GUID of the quota
+	ID *string `json:"id,omitempty"`
+	// Current name of the quota
+	Name *string `json:"name,omitempty"`
+
+	QuotaLimits `json:",inline"`
+
+	// GUIDs of the spaces the quota is applied to
+	SpaceGuids []string `json:"spaceGuids,omitempty"`
+}
+
+// A SpaceQuotaSpec defines the desired state of a SpaceQuota.
+type SpaceQuotaSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SpaceQuotaParameters `json:"forProvider"`
+
+	// Cloud Foundry API endpoint of the org
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CloudFoundryEnvironment
+	// +crossplane:generate:reference:refFieldName=CloudFoundryEnvironmentRef
+	// +crossplane:generate:reference:selectorFieldName=CloudFoundryEnvironmentSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CfApiEndpoint()
+	ApiEndpoint string `json:"apiEndpoint,omitempty"`
+	// GUID of the org the quota is defined in
+	// +kubebuilder:validation:XValidation:rule="oldSelf == '' || self == oldSelf",message="orgGuid can't be updated once set"
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CloudFoundryEnvironment
+	// +crossplane:generate:reference:refFieldName=CloudFoundryEnvironmentRef
+	// +crossplane:generate:reference:selectorFieldName=CloudFoundryEnvironmentSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.CfOrgGuid()
+	OrgGuid string `json:"orgGuid,omitempty"`
+	// +kubebuilder:validation:Optional
+	CloudFoundryEnvironmentSelector *xpv1.Selector `json:"cloudFoundryEnvironmentSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	CloudFoundryEnvironmentRef *xpv1.Reference `json:"cloudFoundryEnvironmentRef,omitempty" reference-group:"environment.btp.sap.crossplane.io" reference-kind:"CloudFoundryEnvironment" reference-apiversion:"v1alpha1"`
+
+	// GUIDs of the spaces to apply the quota to, spaces not listed are removed from the quota
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.Space
+	// +crossplane:generate:reference:refFieldName=SpaceRefs
+	// +crossplane:generate:reference:selectorFieldName=SpaceSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.SpaceGuid()
+	// +optional
+	SpaceGuids []string `json:"spaceGuids,omitempty"`
+	// +kubebuilder:validation:Optional
+	SpaceSelector *xpv1.Selector `json:"spaceSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	SpaceRefs []xpv1.Reference `json:"spaceRefs,omitempty" reference-group:"environment.btp.sap.crossplane.io" reference-kind:"Space" reference-apiversion:"v1alpha1"`
+}
+
+// A SpaceQuotaStatus represents the observed state of a SpaceQuota.
+type SpaceQuotaStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SpaceQuotaObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SpaceQuota is a Cloud Foundry quota definition within an org, applied to spaces of the org.
+// An existing quota with the same name is adopted and only observed, it is neither updated nor deleted.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="MEMORY",type="integer",JSONPath=".spec.forProvider.totalMemoryInMB"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type SpaceQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpaceQuotaSpec   `json:"spec"`
+	Status SpaceQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpaceQuotaList contains a list of SpaceQuota
+type SpaceQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpaceQuota `json:"items"`
+}
+
+// SpaceQuota type metadata.
+var (
+	SpaceQuotaKind             = reflect.TypeOf(SpaceQuota{}).Name()
+	SpaceQuotaGroupKind        = schema.GroupKind{Group: Group, Kind: SpaceQuotaKind}.String()
+	SpaceQuotaKindAPIVersion   = SpaceQuotaKind + "." + SchemeGroupVersion.String()
+	SpaceQuotaGroupVersionKind = SchemeGroupVersion.WithKind(SpaceQuotaKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SpaceQuota{}, &SpaceQuotaList{})
+}
@@ -0,0 +1,110 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+const (
+	SpaceRoleDeveloper = "Developer"
+	SpaceRoleManager   = "Manager"
+	SpaceRoleAuditor   = "Auditor"
+	SpaceRoleSupporter = "Supporter"
+)
+
+// SpaceRoleParameters are the configurable fields of a SpaceRole.
+type SpaceRoleParameters struct {
+	// Role to assign within the space
+	// +kubebuilder:validation:Enum=Developer;Manager;Auditor;Supporter
+	Type string `json:"type"`
+	// Username at the identity provider
+	// +kubebuilder:validation:MinLength=1
+	Username string `json:"username"`
+	// Origin picks the IDP
+	// +kubebuilder:default=sap.ids
+	// +optional
+	Origin string `json:"origin,omitempty"`
+}
+
+// RoleObservation are the observable fields of a SpaceRole or OrgRole.
+type RoleObservation struct {
+	// GUID of the role assignment
+	ID *string `json:"id,omitempty"`
+	// GUID of the assigned user
+	UserID *string `json:"userId,omitempty"`
+}
+
+// A SpaceRoleSpec defines the desired state of a SpaceRole.
+type SpaceRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="role assignments can't be updated, create a new one instead"
+	ForProvider SpaceRoleParameters `json:"forProvider"`
+
+	// Cloud Foundry API endpoint of the org the space belongs to
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.Space
+	// +crossplane:generate:reference:refFieldName=SpaceRef
+	// +crossplane:generate:reference:selectorFieldName=SpaceSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.SpaceApiEndpoint()
+	ApiEndpoint string `json:"apiEndpoint,omitempty"`
+	// GUID of the space
+	// +kubebuilder:validation:XValidation:rule="oldSelf == '' || self == oldSelf",message="spaceGuid can't be updated once set"
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.Space
+	// +crossplane:generate:reference:refFieldName=SpaceRef
+	// +crossplane:generate:reference:selectorFieldName=SpaceSelector
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1.SpaceGuid()
+	SpaceGuid string `json:"spaceGuid,omitempty"`
+	// +kubebuilder:validation:Optional
+	SpaceSelector *xpv1.Selector `json:"spaceSelector,omitempty"`
+	// +kubebuilder:validation:Optional
+	SpaceRef *xpv1.Reference `json:"spaceRef,omitempty" reference-group:"environment.btp.sap.crossplane.io" reference-kind:"Space" reference-apiversion:"v1alpha1"`
+}
+
+// A SpaceRoleStatus represents the observed state of a SpaceRole.
+type SpaceRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SpaceRole assigns a space role to a Cloud Foundry user
+// A role the user already holds is adopted, it is only revoked on deletion if it has been assigned by the provider
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="USERNAME",type="string",JSONPath=".spec.forProvider.username"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type SpaceRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SpaceRoleSpec   `json:"spec"`
+	Status SpaceRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SpaceRoleList contains a list of SpaceRole
+type SpaceRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SpaceRole `json:"items"`
+}
+
+// SpaceRole type metadata.
+var (
+	SpaceRoleKind             = reflect.TypeOf(SpaceRole{}).Name()
+	SpaceRoleGroupKind        = schema.GroupKind{Group: Group, Kind: SpaceRoleKind}.String()
+	SpaceRoleKindAPIVersion   = SpaceRoleKind + "." + SchemeGroupVersion.String()
+	SpaceRoleGroupVersionKind = SchemeGroupVersion.WithKind(SpaceRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SpaceRole{}, &SpaceRoleList{})
+}
@@ -25,6 +25,181 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailableEnvironment) DeepCopyInto(out *AvailableEnvironment) {
+	*out = *in
+	if in.AllowAdditionalEnvironmentInstance != nil {
+		in, out := &in.AllowAdditionalEnvironmentInstance, &out.AllowAdditionalEnvironmentInstance
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PlanUpdatable != nil {
+		in, out := &in.PlanUpdatable, &out.PlanUpdatable
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableEnvironment.
+func (in *AvailableEnvironment) DeepCopy() *AvailableEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailableEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailableEnvironments) DeepCopyInto(out *AvailableEnvironments) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableEnvironments.
+func (in *AvailableEnvironments) DeepCopy() *AvailableEnvironments {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailableEnvironments)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AvailableEnvironments) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailableEnvironmentsList) DeepCopyInto(out *AvailableEnvironmentsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AvailableEnvironments, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableEnvironmentsList.
+func (in *AvailableEnvironmentsList) DeepCopy() *AvailableEnvironmentsList {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailableEnvironmentsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AvailableEnvironmentsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailableEnvironmentsObservation) DeepCopyInto(out *AvailableEnvironmentsObservation) {
+	*out = *in
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]AvailableEnvironment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableEnvironmentsObservation.
+func (in *AvailableEnvironmentsObservation) DeepCopy() *AvailableEnvironmentsObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailableEnvironmentsObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailableEnvironmentsParameters) DeepCopyInto(out *AvailableEnvironmentsParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableEnvironmentsParameters.
+func (in *AvailableEnvironmentsParameters) DeepCopy() *AvailableEnvironmentsParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailableEnvironmentsParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailableEnvironmentsSpec) DeepCopyInto(out *AvailableEnvironmentsSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+	if in.SubaccountSelector != nil {
+		in, out := &in.SubaccountSelector, &out.SubaccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubaccountRef != nil {
+		in, out := &in.SubaccountRef, &out.SubaccountRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudManagementSelector != nil {
+		in, out := &in.CloudManagementSelector, &out.CloudManagementSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudManagementRef != nil {
+		in, out := &in.CloudManagementRef, &out.CloudManagementRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableEnvironmentsSpec.
+func (in *AvailableEnvironmentsSpec) DeepCopy() *AvailableEnvironmentsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailableEnvironmentsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AvailableEnvironmentsStatus) DeepCopyInto(out *AvailableEnvironmentsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AvailableEnvironmentsStatus.
+func (in *AvailableEnvironmentsStatus) DeepCopy() *AvailableEnvironmentsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AvailableEnvironmentsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Binding) DeepCopyInto(out *Binding) {
 	*out = *in
@@ -71,6 +246,31 @@ func (in *CfEnvironmentParameters) DeepCopyInto(out *CfEnvironmentParameters) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.OrgManagers != nil {
+		in, out := &in.OrgManagers, &out.OrgManagers
+		*out = make([]User, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = new(map[string][]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string][]string, len(*in))
+			for key, val := range *in {
+				var outVal []string
+				if val == nil {
+					(*out)[key] = nil
+				} else {
+					inVal := (*in)[key]
+					in, out := &inVal, &outVal
+					*out = make([]string, len(*in))
+					copy(*out, *in)
+				}
+				(*out)[key] = outVal
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CfEnvironmentParameters.
@@ -180,65 +380,226 @@ func (in *CloudFoundryEnvironmentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentObservation) DeepCopyInto(out *EnvironmentObservation) {
+func (in *EnvironmentInstance) DeepCopyInto(out *EnvironmentInstance) {
 	*out = *in
-	if in.BrokerID != nil {
-		in, out := &in.BrokerID, &out.BrokerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.CommercialType != nil {
-		in, out := &in.CommercialType, &out.CommercialType
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentInstance.
+func (in *EnvironmentInstance) DeepCopy() *EnvironmentInstance {
+	if in == nil {
+		return nil
 	}
-	if in.CreatedDate != nil {
-		in, out := &in.CreatedDate, &out.CreatedDate
-		*out = new(string)
-		**out = **in
+	out := new(EnvironmentInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EnvironmentInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.CustomLabels != nil {
-		in, out := &in.CustomLabels, &out.CustomLabels
-		*out = new(map[string][]string)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make(map[string][]string, len(*in))
-			for key, val := range *in {
-				var outVal []string
-				if val == nil {
-					(*out)[key] = nil
-				} else {
-					inVal := (*in)[key]
-					in, out := &inVal, &outVal
-					*out = make([]string, len(*in))
-					copy(*out, *in)
-				}
-				(*out)[key] = outVal
-			}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentInstanceList) DeepCopyInto(out *EnvironmentInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EnvironmentInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.DashboardURL != nil {
-		in, out := &in.DashboardURL, &out.DashboardURL
-		*out = new(string)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentInstanceList.
+func (in *EnvironmentInstanceList) DeepCopy() *EnvironmentInstanceList {
+	if in == nil {
+		return nil
 	}
-	if in.EnvironmentType != nil {
-		in, out := &in.EnvironmentType, &out.EnvironmentType
-		*out = new(string)
-		**out = **in
+	out := new(EnvironmentInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EnvironmentInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.GlobalAccountGUID != nil {
-		in, out := &in.GlobalAccountGUID, &out.GlobalAccountGUID
-		*out = new(string)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentInstanceObservation) DeepCopyInto(out *EnvironmentInstanceObservation) {
+	*out = *in
+	in.EnvironmentObservation.DeepCopyInto(&out.EnvironmentObservation)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentInstanceObservation.
+func (in *EnvironmentInstanceObservation) DeepCopy() *EnvironmentInstanceObservation {
+	if in == nil {
+		return nil
 	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
+	out := new(EnvironmentInstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentInstanceParameters) DeepCopyInto(out *EnvironmentInstanceParameters) {
+	*out = *in
+	in.Parameters.DeepCopyInto(&out.Parameters)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentInstanceParameters.
+func (in *EnvironmentInstanceParameters) DeepCopy() *EnvironmentInstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentInstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentInstanceSpec) DeepCopyInto(out *EnvironmentInstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.SubaccountSelector != nil {
+		in, out := &in.SubaccountSelector, &out.SubaccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubaccountRef != nil {
+		in, out := &in.SubaccountRef, &out.SubaccountRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudManagementSelector != nil {
+		in, out := &in.CloudManagementSelector, &out.CloudManagementSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudManagementRef != nil {
+		in, out := &in.CloudManagementRef, &out.CloudManagementRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentInstanceSpec.
+func (in *EnvironmentInstanceSpec) DeepCopy() *EnvironmentInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentInstanceStatus) DeepCopyInto(out *EnvironmentInstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentInstanceStatus.
+func (in *EnvironmentInstanceStatus) DeepCopy() *EnvironmentInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentObservation) DeepCopyInto(out *EnvironmentObservation) {
+	*out = *in
+	if in.BrokerID != nil {
+		in, out := &in.BrokerID, &out.BrokerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CommercialType != nil {
+		in, out := &in.CommercialType, &out.CommercialType
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreatedDate != nil {
+		in, out := &in.CreatedDate, &out.CreatedDate
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomLabels != nil {
+		in, out := &in.CustomLabels, &out.CustomLabels
+		*out = new(map[string][]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string][]string, len(*in))
+			for key, val := range *in {
+				var outVal []string
+				if val == nil {
+					(*out)[key] = nil
+				} else {
+					inVal := (*in)[key]
+					in, out := &inVal, &outVal
+					*out = make([]string, len(*in))
+					copy(*out, *in)
+				}
+				(*out)[key] = outVal
+			}
+		}
+	}
+	if in.DashboardURL != nil {
+		in, out := &in.DashboardURL, &out.DashboardURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnvironmentType != nil {
+		in, out := &in.EnvironmentType, &out.EnvironmentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalAccountGUID != nil {
+		in, out := &in.GlobalAccountGUID, &out.GlobalAccountGUID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
@@ -307,71 +668,796 @@ func (in *EnvironmentObservation) DeepCopyInto(out *EnvironmentObservation) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubaccountGUID != nil {
-		in, out := &in.SubaccountGUID, &out.SubaccountGUID
-		*out = new(string)
-		**out = **in
+	if in.SubaccountGUID != nil {
+		in, out := &in.SubaccountGUID, &out.SubaccountGUID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TenantID != nil {
+		in, out := &in.TenantID, &out.TenantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentObservation.
+func (in *EnvironmentObservation) DeepCopy() *EnvironmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentStatus) DeepCopyInto(out *EnvironmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentStatus.
+func (in *EnvironmentStatus) DeepCopy() *EnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironment) DeepCopyInto(out *KymaEnvironment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironment.
+func (in *KymaEnvironment) DeepCopy() *KymaEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KymaEnvironment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentBinding) DeepCopyInto(out *KymaEnvironmentBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBinding.
+func (in *KymaEnvironmentBinding) DeepCopy() *KymaEnvironmentBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KymaEnvironmentBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentBindingList) DeepCopyInto(out *KymaEnvironmentBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KymaEnvironmentBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBindingList.
+func (in *KymaEnvironmentBindingList) DeepCopy() *KymaEnvironmentBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KymaEnvironmentBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentBindingObservation) DeepCopyInto(out *KymaEnvironmentBindingObservation) {
+	*out = *in
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]Binding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBindingObservation.
+func (in *KymaEnvironmentBindingObservation) DeepCopy() *KymaEnvironmentBindingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentBindingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentBindingParameters) DeepCopyInto(out *KymaEnvironmentBindingParameters) {
+	*out = *in
+	out.RotationInterval = in.RotationInterval
+	out.BindingTTl = in.BindingTTl
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBindingParameters.
+func (in *KymaEnvironmentBindingParameters) DeepCopy() *KymaEnvironmentBindingParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentBindingParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentBindingSpec) DeepCopyInto(out *KymaEnvironmentBindingSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+	if in.KymaEnvironmentSelector != nil {
+		in, out := &in.KymaEnvironmentSelector, &out.KymaEnvironmentSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KymaEnvironmentRef != nil {
+		in, out := &in.KymaEnvironmentRef, &out.KymaEnvironmentRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudManagementSelector != nil {
+		in, out := &in.CloudManagementSelector, &out.CloudManagementSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudManagementRef != nil {
+		in, out := &in.CloudManagementRef, &out.CloudManagementRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBindingSpec.
+func (in *KymaEnvironmentBindingSpec) DeepCopy() *KymaEnvironmentBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentBindingStatus) DeepCopyInto(out *KymaEnvironmentBindingStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBindingStatus.
+func (in *KymaEnvironmentBindingStatus) DeepCopy() *KymaEnvironmentBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentList) DeepCopyInto(out *KymaEnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KymaEnvironment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentList.
+func (in *KymaEnvironmentList) DeepCopy() *KymaEnvironmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KymaEnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentObservation) DeepCopyInto(out *KymaEnvironmentObservation) {
+	*out = *in
+	in.EnvironmentObservation.DeepCopyInto(&out.EnvironmentObservation)
+	if in.KubeconfigExpiresAt != nil {
+		in, out := &in.KubeconfigExpiresAt, &out.KubeconfigExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.KubeconfigDownloadedAt != nil {
+		in, out := &in.KubeconfigDownloadedAt, &out.KubeconfigDownloadedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentObservation.
+func (in *KymaEnvironmentObservation) DeepCopy() *KymaEnvironmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentParameters) DeepCopyInto(out *KymaEnvironmentParameters) {
+	*out = *in
+	in.Parameters.DeepCopyInto(&out.Parameters)
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = new(map[string][]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string][]string, len(*in))
+			for key, val := range *in {
+				var outVal []string
+				if val == nil {
+					(*out)[key] = nil
+				} else {
+					inVal := (*in)[key]
+					in, out := &inVal, &outVal
+					*out = make([]string, len(*in))
+					copy(*out, *in)
+				}
+				(*out)[key] = outVal
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentParameters.
+func (in *KymaEnvironmentParameters) DeepCopy() *KymaEnvironmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentSpec) DeepCopyInto(out *KymaEnvironmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.SubaccountSelector != nil {
+		in, out := &in.SubaccountSelector, &out.SubaccountSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubaccountRef != nil {
+		in, out := &in.SubaccountRef, &out.SubaccountRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudManagementSelector != nil {
+		in, out := &in.CloudManagementSelector, &out.CloudManagementSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudManagementRef != nil {
+		in, out := &in.CloudManagementRef, &out.CloudManagementRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentSpec.
+func (in *KymaEnvironmentSpec) DeepCopy() *KymaEnvironmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KymaEnvironmentStatus) DeepCopyInto(out *KymaEnvironmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	if in.RetryStatus != nil {
+		in, out := &in.RetryStatus, &out.RetryStatus
+		*out = new(RetryStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentStatus.
+func (in *KymaEnvironmentStatus) DeepCopy() *KymaEnvironmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KymaEnvironmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRole) DeepCopyInto(out *OrgRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRole.
+func (in *OrgRole) DeepCopy() *OrgRole {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrgRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRoleList) DeepCopyInto(out *OrgRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrgRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleList.
+func (in *OrgRoleList) DeepCopy() *OrgRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrgRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRoleParameters) DeepCopyInto(out *OrgRoleParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleParameters.
+func (in *OrgRoleParameters) DeepCopy() *OrgRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRoleSpec) DeepCopyInto(out *OrgRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+	if in.CloudFoundryEnvironmentSelector != nil {
+		in, out := &in.CloudFoundryEnvironmentSelector, &out.CloudFoundryEnvironmentSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudFoundryEnvironmentRef != nil {
+		in, out := &in.CloudFoundryEnvironmentRef, &out.CloudFoundryEnvironmentRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleSpec.
+func (in *OrgRoleSpec) DeepCopy() *OrgRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgRoleStatus) DeepCopyInto(out *OrgRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgRoleStatus.
+func (in *OrgRoleStatus) DeepCopy() *OrgRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationQuota) DeepCopyInto(out *OrganizationQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationQuota.
+func (in *OrganizationQuota) DeepCopy() *OrganizationQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationQuotaList) DeepCopyInto(out *OrganizationQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationQuotaList.
+func (in *OrganizationQuotaList) DeepCopy() *OrganizationQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationQuotaObservation) DeepCopyInto(out *OrganizationQuotaObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	in.QuotaLimits.DeepCopyInto(&out.QuotaLimits)
+	if in.OrganizationGuids != nil {
+		in, out := &in.OrganizationGuids, &out.OrganizationGuids
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationQuotaObservation.
+func (in *OrganizationQuotaObservation) DeepCopy() *OrganizationQuotaObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationQuotaObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationQuotaParameters) DeepCopyInto(out *OrganizationQuotaParameters) {
+	*out = *in
+	in.QuotaLimits.DeepCopyInto(&out.QuotaLimits)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationQuotaParameters.
+func (in *OrganizationQuotaParameters) DeepCopy() *OrganizationQuotaParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationQuotaParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationQuotaSpec) DeepCopyInto(out *OrganizationQuotaSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.CloudFoundryEnvironmentSelector != nil {
+		in, out := &in.CloudFoundryEnvironmentSelector, &out.CloudFoundryEnvironmentSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudFoundryEnvironmentRef != nil {
+		in, out := &in.CloudFoundryEnvironmentRef, &out.CloudFoundryEnvironmentRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationQuotaSpec.
+func (in *OrganizationQuotaSpec) DeepCopy() *OrganizationQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationQuotaStatus) DeepCopyInto(out *OrganizationQuotaStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationQuotaStatus.
+func (in *OrganizationQuotaStatus) DeepCopy() *OrganizationQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaLimits) DeepCopyInto(out *QuotaLimits) {
+	*out = *in
+	if in.TotalMemoryInMB != nil {
+		in, out := &in.TotalMemoryInMB, &out.TotalMemoryInMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.PerProcessMemoryInMB != nil {
+		in, out := &in.PerProcessMemoryInMB, &out.PerProcessMemoryInMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.TotalInstances != nil {
+		in, out := &in.TotalInstances, &out.TotalInstances
+		*out = new(int)
+		**out = **in
+	}
+	if in.TotalRoutes != nil {
+		in, out := &in.TotalRoutes, &out.TotalRoutes
+		*out = new(int)
+		**out = **in
+	}
+	if in.TotalReservedPorts != nil {
+		in, out := &in.TotalReservedPorts, &out.TotalReservedPorts
+		*out = new(int)
+		**out = **in
+	}
+	if in.TotalServiceInstances != nil {
+		in, out := &in.TotalServiceInstances, &out.TotalServiceInstances
+		*out = new(int)
+		**out = **in
+	}
+	if in.TotalServiceKeys != nil {
+		in, out := &in.TotalServiceKeys, &out.TotalServiceKeys
+		*out = new(int)
+		**out = **in
+	}
+	if in.PaidServicesAllowed != nil {
+		in, out := &in.PaidServicesAllowed, &out.PaidServicesAllowed
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaLimits.
+func (in *QuotaLimits) DeepCopy() *QuotaLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryStatus) DeepCopyInto(out *RetryStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStatus.
+func (in *RetryStatus) DeepCopy() *RetryStatus {
+	if in == nil {
+		return nil
 	}
-	if in.TenantID != nil {
-		in, out := &in.TenantID, &out.TenantID
+	out := new(RetryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleObservation) DeepCopyInto(out *RoleObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
+	if in.UserID != nil {
+		in, out := &in.UserID, &out.UserID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentObservation.
-func (in *EnvironmentObservation) DeepCopy() *EnvironmentObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleObservation.
+func (in *RoleObservation) DeepCopy() *RoleObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentObservation)
+	out := new(RoleObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentStatus) DeepCopyInto(out *EnvironmentStatus) {
+func (in *Space) DeepCopyInto(out *Space) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentStatus.
-func (in *EnvironmentStatus) DeepCopy() *EnvironmentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Space.
+func (in *Space) DeepCopy() *Space {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentStatus)
+	out := new(Space)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Space) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironment) DeepCopyInto(out *KymaEnvironment) {
+func (in *SpaceList) DeepCopyInto(out *SpaceList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Space, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironment.
-func (in *KymaEnvironment) DeepCopy() *KymaEnvironment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceList.
+func (in *SpaceList) DeepCopy() *SpaceList {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironment)
+	out := new(SpaceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KymaEnvironment) DeepCopyObject() runtime.Object {
+func (in *SpaceList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -379,7 +1465,47 @@ func (in *KymaEnvironment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentBinding) DeepCopyInto(out *KymaEnvironmentBinding) {
+func (in *SpaceObservation) DeepCopyInto(out *SpaceObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceObservation.
+func (in *SpaceObservation) DeepCopy() *SpaceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceParameters) DeepCopyInto(out *SpaceParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceParameters.
+func (in *SpaceParameters) DeepCopy() *SpaceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SpaceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceQuota) DeepCopyInto(out *SpaceQuota) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -387,18 +1513,18 @@ func (in *KymaEnvironmentBinding) DeepCopyInto(out *KymaEnvironmentBinding) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBinding.
-func (in *KymaEnvironmentBinding) DeepCopy() *KymaEnvironmentBinding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceQuota.
+func (in *SpaceQuota) DeepCopy() *SpaceQuota {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentBinding)
+	out := new(SpaceQuota)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KymaEnvironmentBinding) DeepCopyObject() runtime.Object {
+func (in *SpaceQuota) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -406,31 +1532,31 @@ func (in *KymaEnvironmentBinding) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentBindingList) DeepCopyInto(out *KymaEnvironmentBindingList) {
+func (in *SpaceQuotaList) DeepCopyInto(out *SpaceQuotaList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]KymaEnvironmentBinding, len(*in))
+		*out = make([]SpaceQuota, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBindingList.
-func (in *KymaEnvironmentBindingList) DeepCopy() *KymaEnvironmentBindingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceQuotaList.
+func (in *SpaceQuotaList) DeepCopy() *SpaceQuotaList {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentBindingList)
+	out := new(SpaceQuotaList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KymaEnvironmentBindingList) DeepCopyObject() runtime.Object {
+func (in *SpaceQuotaList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -438,124 +1564,134 @@ func (in *KymaEnvironmentBindingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentBindingObservation) DeepCopyInto(out *KymaEnvironmentBindingObservation) {
+func (in *SpaceQuotaObservation) DeepCopyInto(out *SpaceQuotaObservation) {
 	*out = *in
-	if in.Bindings != nil {
-		in, out := &in.Bindings, &out.Bindings
-		*out = make([]Binding, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	in.QuotaLimits.DeepCopyInto(&out.QuotaLimits)
+	if in.SpaceGuids != nil {
+		in, out := &in.SpaceGuids, &out.SpaceGuids
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBindingObservation.
-func (in *KymaEnvironmentBindingObservation) DeepCopy() *KymaEnvironmentBindingObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceQuotaObservation.
+func (in *SpaceQuotaObservation) DeepCopy() *SpaceQuotaObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentBindingObservation)
+	out := new(SpaceQuotaObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentBindingParameters) DeepCopyInto(out *KymaEnvironmentBindingParameters) {
+func (in *SpaceQuotaParameters) DeepCopyInto(out *SpaceQuotaParameters) {
 	*out = *in
-	out.RotationInterval = in.RotationInterval
-	out.BindingTTl = in.BindingTTl
+	in.QuotaLimits.DeepCopyInto(&out.QuotaLimits)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBindingParameters.
-func (in *KymaEnvironmentBindingParameters) DeepCopy() *KymaEnvironmentBindingParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceQuotaParameters.
+func (in *SpaceQuotaParameters) DeepCopy() *SpaceQuotaParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentBindingParameters)
+	out := new(SpaceQuotaParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentBindingSpec) DeepCopyInto(out *KymaEnvironmentBindingSpec) {
+func (in *SpaceQuotaSpec) DeepCopyInto(out *SpaceQuotaSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.ForProvider = in.ForProvider
-	if in.KymaEnvironmentSelector != nil {
-		in, out := &in.KymaEnvironmentSelector, &out.KymaEnvironmentSelector
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.CloudFoundryEnvironmentSelector != nil {
+		in, out := &in.CloudFoundryEnvironmentSelector, &out.CloudFoundryEnvironmentSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.KymaEnvironmentRef != nil {
-		in, out := &in.KymaEnvironmentRef, &out.KymaEnvironmentRef
+	if in.CloudFoundryEnvironmentRef != nil {
+		in, out := &in.CloudFoundryEnvironmentRef, &out.CloudFoundryEnvironmentRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CloudManagementSelector != nil {
-		in, out := &in.CloudManagementSelector, &out.CloudManagementSelector
+	if in.SpaceGuids != nil {
+		in, out := &in.SpaceGuids, &out.SpaceGuids
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SpaceSelector != nil {
+		in, out := &in.SpaceSelector, &out.SpaceSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CloudManagementRef != nil {
-		in, out := &in.CloudManagementRef, &out.CloudManagementRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
+	if in.SpaceRefs != nil {
+		in, out := &in.SpaceRefs, &out.SpaceRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBindingSpec.
-func (in *KymaEnvironmentBindingSpec) DeepCopy() *KymaEnvironmentBindingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceQuotaSpec.
+func (in *SpaceQuotaSpec) DeepCopy() *SpaceQuotaSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentBindingSpec)
+	out := new(SpaceQuotaSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentBindingStatus) DeepCopyInto(out *KymaEnvironmentBindingStatus) {
+func (in *SpaceQuotaStatus) DeepCopyInto(out *SpaceQuotaStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentBindingStatus.
-func (in *KymaEnvironmentBindingStatus) DeepCopy() *KymaEnvironmentBindingStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceQuotaStatus.
+func (in *SpaceQuotaStatus) DeepCopy() *SpaceQuotaStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentBindingStatus)
+	out := new(SpaceQuotaStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentList) DeepCopyInto(out *KymaEnvironmentList) {
+func (in *SpaceRole) DeepCopyInto(out *SpaceRole) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]KymaEnvironment, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentList.
-func (in *KymaEnvironmentList) DeepCopy() *KymaEnvironmentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRole.
+func (in *SpaceRole) DeepCopy() *SpaceRole {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentList)
+	out := new(SpaceRole)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *KymaEnvironmentList) DeepCopyObject() runtime.Object {
+func (in *SpaceRole) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -563,107 +1699,136 @@ func (in *KymaEnvironmentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentObservation) DeepCopyInto(out *KymaEnvironmentObservation) {
+func (in *SpaceRoleList) DeepCopyInto(out *SpaceRoleList) {
 	*out = *in
-	in.EnvironmentObservation.DeepCopyInto(&out.EnvironmentObservation)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SpaceRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentObservation.
-func (in *KymaEnvironmentObservation) DeepCopy() *KymaEnvironmentObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleList.
+func (in *SpaceRoleList) DeepCopy() *SpaceRoleList {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentObservation)
+	out := new(SpaceRoleList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SpaceRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentParameters) DeepCopyInto(out *KymaEnvironmentParameters) {
+func (in *SpaceRoleParameters) DeepCopyInto(out *SpaceRoleParameters) {
 	*out = *in
-	in.Parameters.DeepCopyInto(&out.Parameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentParameters.
-func (in *KymaEnvironmentParameters) DeepCopy() *KymaEnvironmentParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleParameters.
+func (in *SpaceRoleParameters) DeepCopy() *SpaceRoleParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentParameters)
+	out := new(SpaceRoleParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentSpec) DeepCopyInto(out *KymaEnvironmentSpec) {
+func (in *SpaceRoleSpec) DeepCopyInto(out *SpaceRoleSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
-	if in.SubaccountSelector != nil {
-		in, out := &in.SubaccountSelector, &out.SubaccountSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SubaccountRef != nil {
-		in, out := &in.SubaccountRef, &out.SubaccountRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CloudManagementSelector != nil {
-		in, out := &in.CloudManagementSelector, &out.CloudManagementSelector
+	out.ForProvider = in.ForProvider
+	if in.SpaceSelector != nil {
+		in, out := &in.SpaceSelector, &out.SpaceSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CloudManagementRef != nil {
-		in, out := &in.CloudManagementRef, &out.CloudManagementRef
+	if in.SpaceRef != nil {
+		in, out := &in.SpaceRef, &out.SpaceRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentSpec.
-func (in *KymaEnvironmentSpec) DeepCopy() *KymaEnvironmentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleSpec.
+func (in *SpaceRoleSpec) DeepCopy() *SpaceRoleSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentSpec)
+	out := new(SpaceRoleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KymaEnvironmentStatus) DeepCopyInto(out *KymaEnvironmentStatus) {
+func (in *SpaceRoleStatus) DeepCopyInto(out *SpaceRoleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
-	if in.RetryStatus != nil {
-		in, out := &in.RetryStatus, &out.RetryStatus
-		*out = new(RetryStatus)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceRoleStatus.
+func (in *SpaceRoleStatus) DeepCopy() *SpaceRoleStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(SpaceRoleStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KymaEnvironmentStatus.
-func (in *KymaEnvironmentStatus) DeepCopy() *KymaEnvironmentStatus {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpaceSpec) DeepCopyInto(out *SpaceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+	if in.CloudFoundryEnvironmentSelector != nil {
+		in, out := &in.CloudFoundryEnvironmentSelector, &out.CloudFoundryEnvironmentSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudFoundryEnvironmentRef != nil {
+		in, out := &in.CloudFoundryEnvironmentRef, &out.CloudFoundryEnvironmentRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceSpec.
+func (in *SpaceSpec) DeepCopy() *SpaceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(KymaEnvironmentStatus)
+	out := new(SpaceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RetryStatus) DeepCopyInto(out *RetryStatus) {
+func (in *SpaceStatus) DeepCopyInto(out *SpaceStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryStatus.
-func (in *RetryStatus) DeepCopy() *RetryStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpaceStatus.
+func (in *SpaceStatus) DeepCopy() *SpaceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RetryStatus)
+	out := new(SpaceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -26,6 +26,64 @@ import (
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this AvailableEnvironments.
+func (mg *AvailableEnvironments) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.SubaccountGuid,
+		Extract:      v1alpha1.SubaccountUuid(),
+		Reference:    mg.Spec.SubaccountRef,
+		Selector:     mg.Spec.SubaccountSelector,
+		To: reference.To{
+			List:    &v1alpha1.SubaccountList{},
+			Managed: &v1alpha1.Subaccount{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.SubaccountGuid")
+	}
+	mg.Spec.SubaccountGuid = rsp.ResolvedValue
+	mg.Spec.SubaccountRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.CloudManagementSecret,
+		Extract:      v1alpha1.CloudManagementSecret(),
+		Reference:    mg.Spec.CloudManagementRef,
+		Selector:     mg.Spec.CloudManagementSelector,
+		To: reference.To{
+			List:    &v1alpha1.CloudManagementList{},
+			Managed: &v1alpha1.CloudManagement{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.CloudManagementSecret")
+	}
+	mg.Spec.CloudManagementSecret = rsp.ResolvedValue
+	mg.Spec.CloudManagementRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.CloudManagementSecretNamespace,
+		Extract:      v1alpha1.CloudManagementSecretSecretNamespace(),
+		Reference:    mg.Spec.CloudManagementRef,
+		Selector:     mg.Spec.CloudManagementSelector,
+		To: reference.To{
+			List:    &v1alpha1.CloudManagementList{},
+			Managed: &v1alpha1.CloudManagement{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.CloudManagementSecretNamespace")
+	}
+	mg.Spec.CloudManagementSecretNamespace = rsp.ResolvedValue
+	mg.Spec.CloudManagementRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this CloudFoundryEnvironment.
 func (mg *CloudFoundryEnvironment) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -100,6 +158,80 @@ func (mg *CloudFoundryEnvironment) ResolveReferences(ctx context.Context, c clie
 	return nil
 }
 
+// ResolveReferences of this EnvironmentInstance.
+func (mg *EnvironmentInstance) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.SubaccountGuid,
+		Extract:      v1alpha1.SubaccountUuid(),
+		Reference:    mg.Spec.SubaccountRef,
+		Selector:     mg.Spec.SubaccountSelector,
+		To: reference.To{
+			List:    &v1alpha1.SubaccountList{},
+			Managed: &v1alpha1.Subaccount{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.SubaccountGuid")
+	}
+	mg.Spec.SubaccountGuid = rsp.ResolvedValue
+	mg.Spec.SubaccountRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.CloudManagementSecret,
+		Extract:      v1alpha1.CloudManagementSecret(),
+		Reference:    mg.Spec.CloudManagementRef,
+		Selector:     mg.Spec.CloudManagementSelector,
+		To: reference.To{
+			List:    &v1alpha1.CloudManagementList{},
+			Managed: &v1alpha1.CloudManagement{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.CloudManagementSecret")
+	}
+	mg.Spec.CloudManagementSecret = rsp.ResolvedValue
+	mg.Spec.CloudManagementRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.CloudManagementSecretNamespace,
+		Extract:      v1alpha1.CloudManagementSecretSecretNamespace(),
+		Reference:    mg.Spec.CloudManagementRef,
+		Selector:     mg.Spec.CloudManagementSelector,
+		To: reference.To{
+			List:    &v1alpha1.CloudManagementList{},
+			Managed: &v1alpha1.CloudManagement{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.CloudManagementSecretNamespace")
+	}
+	mg.Spec.CloudManagementSecretNamespace = rsp.ResolvedValue
+	mg.Spec.CloudManagementRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.CloudManagementSubaccountGuid,
+		Extract:      v1alpha1.CloudManagementSubaccountUuid(),
+		Reference:    mg.Spec.CloudManagementRef,
+		Selector:     mg.Spec.CloudManagementSelector,
+		To: reference.To{
+			List:    &v1alpha1.CloudManagementList{},
+			Managed: &v1alpha1.CloudManagement{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.CloudManagementSubaccountGuid")
+	}
+	mg.Spec.CloudManagementSubaccountGuid = rsp.ResolvedValue
+	mg.Spec.CloudManagementRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this KymaEnvironment.
 func (mg *KymaEnvironment) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -247,3 +379,230 @@ func (mg *KymaEnvironmentBinding) ResolveReferences(ctx context.Context, c clien
 
 	return nil
 }
+
+// ResolveReferences of this OrgRole.
+func (mg *OrgRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ApiEndpoint,
+		Extract:      CfApiEndpoint(),
+		Reference:    mg.Spec.CloudFoundryEnvironmentRef,
+		Selector:     mg.Spec.CloudFoundryEnvironmentSelector,
+		To: reference.To{
+			List:    &CloudFoundryEnvironmentList{},
+			Managed: &CloudFoundryEnvironment{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ApiEndpoint")
+	}
+	mg.Spec.ApiEndpoint = rsp.ResolvedValue
+	mg.Spec.CloudFoundryEnvironmentRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.OrgGuid,
+		Extract:      CfOrgGuid(),
+		Reference:    mg.Spec.CloudFoundryEnvironmentRef,
+		Selector:     mg.Spec.CloudFoundryEnvironmentSelector,
+		To: reference.To{
+			List:    &CloudFoundryEnvironmentList{},
+			Managed: &CloudFoundryEnvironment{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.OrgGuid")
+	}
+	mg.Spec.OrgGuid = rsp.ResolvedValue
+	mg.Spec.CloudFoundryEnvironmentRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this OrganizationQuota.
+func (mg *OrganizationQuota) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ApiEndpoint,
+		Extract:      CfApiEndpoint(),
+		Reference:    mg.Spec.CloudFoundryEnvironmentRef,
+		Selector:     mg.Spec.CloudFoundryEnvironmentSelector,
+		To: reference.To{
+			List:    &CloudFoundryEnvironmentList{},
+			Managed: &CloudFoundryEnvironment{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ApiEndpoint")
+	}
+	mg.Spec.ApiEndpoint = rsp.ResolvedValue
+	mg.Spec.CloudFoundryEnvironmentRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.OrgGuid,
+		Extract:      CfOrgGuid(),
+		Reference:    mg.Spec.CloudFoundryEnvironmentRef,
+		Selector:     mg.Spec.CloudFoundryEnvironmentSelector,
+		To: reference.To{
+			List:    &CloudFoundryEnvironmentList{},
+			Managed: &CloudFoundryEnvironment{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.OrgGuid")
+	}
+	mg.Spec.OrgGuid = rsp.ResolvedValue
+	mg.Spec.CloudFoundryEnvironmentRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Space.
+func (mg *Space) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ApiEndpoint,
+		Extract:      CfApiEndpoint(),
+		Reference:    mg.Spec.CloudFoundryEnvironmentRef,
+		Selector:     mg.Spec.CloudFoundryEnvironmentSelector,
+		To: reference.To{
+			List:    &CloudFoundryEnvironmentList{},
+			Managed: &CloudFoundryEnvironment{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ApiEndpoint")
+	}
+	mg.Spec.ApiEndpoint = rsp.ResolvedValue
+	mg.Spec.CloudFoundryEnvironmentRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.OrgGuid,
+		Extract:      CfOrgGuid(),
+		Reference:    mg.Spec.CloudFoundryEnvironmentRef,
+		Selector:     mg.Spec.CloudFoundryEnvironmentSelector,
+		To: reference.To{
+			List:    &CloudFoundryEnvironmentList{},
+			Managed: &CloudFoundryEnvironment{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.OrgGuid")
+	}
+	mg.Spec.OrgGuid = rsp.ResolvedValue
+	mg.Spec.CloudFoundryEnvironmentRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this SpaceQuota.
+func (mg *SpaceQuota) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ApiEndpoint,
+		Extract:      CfApiEndpoint(),
+		Reference:    mg.Spec.CloudFoundryEnvironmentRef,
+		Selector:     mg.Spec.CloudFoundryEnvironmentSelector,
+		To: reference.To{
+			List:    &CloudFoundryEnvironmentList{},
+			Managed: &CloudFoundryEnvironment{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ApiEndpoint")
+	}
+	mg.Spec.ApiEndpoint = rsp.ResolvedValue
+	mg.Spec.CloudFoundryEnvironmentRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.OrgGuid,
+		Extract:      CfOrgGuid(),
+		Reference:    mg.Spec.CloudFoundryEnvironmentRef,
+		Selector:     mg.Spec.CloudFoundryEnvironmentSelector,
+		To: reference.To{
+			List:    &CloudFoundryEnvironmentList{},
+			Managed: &CloudFoundryEnvironment{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.OrgGuid")
+	}
+	mg.Spec.OrgGuid = rsp.ResolvedValue
+	mg.Spec.CloudFoundryEnvironmentRef = rsp.ResolvedReference
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.SpaceGuids,
+		Extract:       SpaceGuid(),
+		References:    mg.Spec.SpaceRefs,
+		Selector:      mg.Spec.SpaceSelector,
+		To: reference.To{
+			List:    &SpaceList{},
+			Managed: &Space{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.SpaceGuids")
+	}
+	mg.Spec.SpaceGuids = mrsp.ResolvedValues
+	mg.Spec.SpaceRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this SpaceRole.
+func (mg *SpaceRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ApiEndpoint,
+		Extract:      SpaceApiEndpoint(),
+		Reference:    mg.Spec.SpaceRef,
+		Selector:     mg.Spec.SpaceSelector,
+		To: reference.To{
+			List:    &SpaceList{},
+			Managed: &Space{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ApiEndpoint")
+	}
+	mg.Spec.ApiEndpoint = rsp.ResolvedValue
+	mg.Spec.SpaceRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.SpaceGuid,
+		Extract:      SpaceGuid(),
+		Reference:    mg.Spec.SpaceRef,
+		Selector:     mg.Spec.SpaceSelector,
+		To: reference.To{
+			List:    &SpaceList{},
+			Managed: &Space{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.SpaceGuid")
+	}
+	mg.Spec.SpaceGuid = rsp.ResolvedValue
+	mg.Spec.SpaceRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GroupParameters are the configurable fields of a Group.
+type GroupParameters struct {
+	// DisplayName of the group, used as its identifier in xsuaa
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="displayName can't be updated once set"
+	DisplayName string `json:"displayName"`
+	// Description of the group
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="description can't be updated once set"
+	Description *string `json:"description,omitempty"`
+}
+
+// GroupObservation are the observable fields of a Group.
+type GroupObservation struct {
+	// ID of the group in xsuaa
+	ID *string `json:"id,omitempty"`
+	// DisplayName of the group as saved in xsuaa
+	DisplayName *string `json:"displayName,omitempty"`
+	// Description of the group as saved in xsuaa
+	Description *string `json:"description,omitempty"`
+	// ZoneID the group belongs to
+	ZoneID *string `json:"zoneId,omitempty"`
+}
+
+// A GroupSpec defines the desired state of a Group.
+type GroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GroupParameters `json:"forProvider"`
+
+	XSUAACredentialsReference `json:",inline"`
+}
+
+// A GroupStatus represents the observed state of a Group.
+type GroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Group manages a group within xsuaa, it can be referenced by RoleCollectionAssignments
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type Group struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GroupSpec   `json:"spec"`
+	Status GroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GroupList contains a list of Group
+type GroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Group `json:"items"`
+}
+
+// Group type metadata.
+var (
+	GroupKind             = reflect.TypeOf(Group{}).Name()
+	GroupGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: GroupKind}.String()
+	GroupKindAPIVersion   = GroupKind + "." + CRDGroupVersion.String()
+	GroupGroupVersionKind = CRDGroupVersion.WithKind(GroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Group{}, &GroupList{})
+}
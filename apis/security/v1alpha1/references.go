@@ -23,6 +23,10 @@ type XSUAACredentialsReference struct {
 	// +crossplane:generate:reference:selectorFieldName=SubaccountApiCredentialSelector
 	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/security/v1alpha1.SubaccountApiCredentialSecretSecretNamespace()
 	SubaccountApiCredentialSecretNamespace string `json:"subaccountApiCredentialSecretNamespace,omitempty"`
+
+	// SecurityConfigRef references a SecurityConfig holding the xsuaa api credentials, used if neither apiCredentials nor subaccountApiCredentialRef are set
+	// +kubebuilder:validation:Optional
+	SecurityConfigRef *xpv1.Reference `json:"securityConfigRef,omitempty" reference-group:"security.btp.sap.crossplane.io" reference-kind:"SecurityConfig" reference-apiversion:"v1alpha1"`
 }
 
 // APICredentials are the credentials to authenticate against the xsuaa api
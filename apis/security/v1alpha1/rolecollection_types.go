@@ -38,13 +38,47 @@ type RoleCollectionObservation struct {
 	// RoleReferences roles as saved in the external system
 	// +kubebuilder:validation:Optional
 	RoleReferences *[]RoleReference `json:"roles"`
+	// Drift describes how the external role collection differs from the spec, empty if both are in sync
+	// +kubebuilder:validation:Optional
+	Drift *RoleCollectionDrift `json:"drift,omitempty"`
+	// ObservedGeneration is the generation of the spec that was last applied to or found in sync with the external system,
+	// differences to a newer spec are applied regardless of the drift policy
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
+// RoleCollectionDrift is a structured diff between the spec and the role collection in the external system
+type RoleCollectionDrift struct {
+	// RolesAdded are roles present in the external system but not in the spec
+	// +kubebuilder:validation:Optional
+	RolesAdded []RoleReference `json:"rolesAdded,omitempty"`
+	// RolesRemoved are roles present in the spec but missing in the external system
+	// +kubebuilder:validation:Optional
+	RolesRemoved []RoleReference `json:"rolesRemoved,omitempty"`
+	// DescriptionChanged is true if the external description differs from the spec
+	// +kubebuilder:validation:Optional
+	DescriptionChanged bool `json:"descriptionChanged,omitempty"`
+}
+
+const (
+	// DriftPolicyReconcile reverts out-of-band changes to the external role collection
+	DriftPolicyReconcile = "Reconcile"
+	// DriftPolicyObserveAndAlert only reports out-of-band changes in status and events without correcting them
+	DriftPolicyObserveAndAlert = "ObserveAndAlert"
+)
+
 // A RoleCollectionSpec defines the desired state of a RoleCollection.
 type RoleCollectionSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       RoleCollectionParameters `json:"forProvider"`
 
+	// DriftPolicy defines how differences between spec and external role collection are handled.
+	// Reconcile (default) corrects them, ObserveAndAlert only reports them in status and as events.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Reconcile;ObserveAndAlert
+	// +kubebuilder:default:=Reconcile
+	DriftPolicy string `json:"driftPolicy,omitempty"`
+
 	XSUAACredentialsReference `json:",inline"`
 }
 
@@ -60,6 +94,7 @@ type RoleCollectionStatus struct {
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
 // +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DRIFT-POLICY",type="string",JSONPath=".spec.driftPolicy",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
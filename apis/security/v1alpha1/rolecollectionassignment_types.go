@@ -10,17 +10,42 @@ import (
 )
 
 // RoleCollectionAssignmentParameters are the configurable fields of a RoleCollectionAssignment.
-// +kubebuilder:validation:XValidation:rule=(has(self.userName) && !has(self.groupName)) || (!has(self.userName) && has(self.groupName)), message="use either userName or groupName, not both"
+// +kubebuilder:validation:XValidation:rule=(has(self.userName) || has(self.userRef) || has(self.userSelector)) != (has(self.groupName) || has(self.groupRef) || has(self.groupSelector)), message="use either userName or groupName, not both"
 type RoleCollectionAssignmentParameters struct {
 	// Origin of the user or group
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="origin can't be updated once set"
 	Origin string `json:"origin"`
 	// UserName of the user to assign the role collection to
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="userName can't be updated once set"
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/security/v1alpha1.User
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/security/v1alpha1.UserName()
+	// +crossplane:generate:reference:refFieldName=UserRef
+	// +crossplane:generate:reference:selectorFieldName=UserSelector
 	UserName string `json:"userName,omitempty"`
+
+	// UserRef references a User to populate userName, the assignment will wait until the user exists
+	// +kubebuilder:validation:Optional
+	UserRef *xpv1.Reference `json:"userRef,omitempty"`
+
+	// UserSelector selects a User to populate userName
+	// +kubebuilder:validation:Optional
+	UserSelector *xpv1.Selector `json:"userSelector,omitempty"`
+
 	// GroupName of the group to assign the role collection to
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="groupName can't be updated once set"
+	// +crossplane:generate:reference:type=github.com/sap/crossplane-provider-btp/apis/security/v1alpha1.Group
+	// +crossplane:generate:reference:extractor=github.com/sap/crossplane-provider-btp/apis/security/v1alpha1.GroupName()
+	// +crossplane:generate:reference:refFieldName=GroupRef
+	// +crossplane:generate:reference:selectorFieldName=GroupSelector
 	GroupName string `json:"groupName,omitempty"`
+
+	// GroupRef references a Group to populate groupName, the assignment will wait until the group exists
+	// +kubebuilder:validation:Optional
+	GroupRef *xpv1.Reference `json:"groupRef,omitempty"`
+
+	// GroupSelector selects a Group to populate groupName
+	// +kubebuilder:validation:Optional
+	GroupSelector *xpv1.Selector `json:"groupSelector,omitempty"`
 	// RoleCollectionName is the name of the role collection to assign
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="roleCollectionName can't be updated once set"
 	RoleCollectionName string `json:"roleCollectionName"`
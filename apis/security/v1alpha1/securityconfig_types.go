@@ -0,0 +1,59 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// A SecurityConfigSpec defines the xsuaa api credentials shared by all security resources referencing it.
+// +kubebuilder:validation:XValidation:rule="has(self.apiCredentials) != has(self.subaccountApiCredentialRef)",message="use either apiCredentials or subaccountApiCredentialRef, not both"
+type SecurityConfigSpec struct {
+	// xsuaa api credentials stored as json object in a secret, same format as used by apiCredentials of the security resources
+	// +kubebuilder:validation:Optional
+	APICredentials *APICredentials `json:"apiCredentials,omitempty"`
+
+	// SubaccountApiCredentialRef references a SubaccountApiCredential, its connection secret is used as xsuaa api credentials.
+	// This allows a single SubaccountApiCredential per subaccount to serve all role objects of that subaccount.
+	// +kubebuilder:validation:Optional
+	SubaccountApiCredentialRef *xpv1.Reference `json:"subaccountApiCredentialRef,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityConfig provides xsuaa api credentials to RoleCollections, RoleCollectionAssignments, Users and Groups referencing it via securityConfigRef.
+// Its deletion is blocked as long as it is referenced.
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.apiCredentials.secretRef.name",priority=1
+// +kubebuilder:printcolumn:name="API-CREDENTIAL",type="string",JSONPath=".spec.subaccountApiCredentialRef.name",priority=1
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,btp}
+type SecurityConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec SecurityConfigSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityConfigList contains a list of SecurityConfig
+type SecurityConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityConfig `json:"items"`
+}
+
+// SecurityConfig type metadata.
+var (
+	SecurityConfigKind             = reflect.TypeOf(SecurityConfig{}).Name()
+	SecurityConfigGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SecurityConfigKind}.String()
+	SecurityConfigKindAPIVersion   = SecurityConfigKind + "." + CRDGroupVersion.String()
+	SecurityConfigGroupVersionKind = CRDGroupVersion.WithKind(SecurityConfigKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&SecurityConfig{}, &SecurityConfigList{})
+}
@@ -0,0 +1,101 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// UserParameters are the configurable fields of a User.
+type UserParameters struct {
+	// Origin of the identity provider the shadow user belongs to, e.g. sap.default
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="origin can't be updated once set"
+	Origin string `json:"origin"`
+	// UserName of the shadow user as known by the identity provider, usually the email address
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="userName can't be updated once set"
+	UserName string `json:"userName"`
+	// Email of the shadow user, defaults to userName if not set
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="email can't be updated once set"
+	Email *string `json:"email,omitempty"`
+	// GivenName of the shadow user
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="givenName can't be updated once set"
+	GivenName *string `json:"givenName,omitempty"`
+	// FamilyName of the shadow user
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="familyName can't be updated once set"
+	FamilyName *string `json:"familyName,omitempty"`
+}
+
+// UserObservation are the observable fields of a User.
+type UserObservation struct {
+	// ID of the shadow user in xsuaa
+	ID *string `json:"id,omitempty"`
+	// UserName of the shadow user as saved in xsuaa
+	UserName *string `json:"userName,omitempty"`
+	// Origin of the shadow user as saved in xsuaa
+	Origin *string `json:"origin,omitempty"`
+	// ZoneID the shadow user belongs to
+	ZoneID *string `json:"zoneId,omitempty"`
+	// Active indicates whether the shadow user is active
+	Active *bool `json:"active,omitempty"`
+	// Verified indicates whether the shadow user is verified
+	Verified *bool `json:"verified,omitempty"`
+}
+
+// A UserSpec defines the desired state of a User.
+type UserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UserParameters `json:"forProvider"`
+
+	XSUAACredentialsReference `json:",inline"`
+}
+
+// A UserStatus represents the observed state of a User.
+type UserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UserObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A User manages a shadow user of an origin within xsuaa, it can be referenced by RoleCollectionAssignments.
+// An existing shadow user is adopted, but only shadow users created by the provider are deleted with the User.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,btp}
+type User struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UserSpec   `json:"spec"`
+	Status UserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UserList contains a list of User
+type UserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []User `json:"items"`
+}
+
+// User type metadata.
+var (
+	UserKind             = reflect.TypeOf(User{}).Name()
+	UserGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: UserKind}.String()
+	UserKindAPIVersion   = UserKind + "." + CRDGroupVersion.String()
+	UserGroupVersionKind = CRDGroupVersion.WithKind(UserKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&User{}, &UserList{})
+}
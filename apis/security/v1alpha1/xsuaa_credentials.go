@@ -14,6 +14,8 @@ import (
 var InvalidXsuaaCredentials = errors.New("invalid xsuaa api credentials")
 var InvalidSourceReference = errors.New("invalid source reference")
 var FailedToGetSecret = errors.New("failed to get secret")
+var FailedToGetSecurityConfig = errors.New("failed to get security config")
+var FailedToGetSubaccountApiCredential = errors.New("failed to get subaccount api credential")
 
 // XsuaaBinding defines the json structure stored in secret to configure xsuaa api client
 type XsuaaBinding struct {
@@ -73,8 +75,9 @@ func ReadXsuaaCredentialsUpjet(creds corev1.Secret) (*XsuaaBinding, error) {
 type BindingSourceType string
 
 const (
-	BindingSourceCustom BindingSourceType = "CUSTOM"
-	BindingSourceUpjet  BindingSourceType = "UPJET"
+	BindingSourceCustom         BindingSourceType = "CUSTOM"
+	BindingSourceUpjet          BindingSourceType = "UPJET"
+	BindingSourceSecurityConfig BindingSourceType = "SECURITY_CONFIG"
 )
 
 // determineBindingSource determines the source of the binding, returns an enum
@@ -83,6 +86,8 @@ func determineBindingSource(cr *XSUAACredentialsReference) (BindingSourceType, e
 		return BindingSourceCustom, nil
 	} else if cr.SubaccountApiCredentialRef != nil {
 		return BindingSourceUpjet, nil
+	} else if cr.SecurityConfigRef != nil {
+		return BindingSourceSecurityConfig, nil
 	}
 	return "", InvalidSourceReference
 }
@@ -96,37 +101,74 @@ func CreateBindingFromSource(cr *XSUAACredentialsReference, ctx context.Context,
 
 	switch sourceType {
 	case BindingSourceCustom:
-		secretBytes, err := resource.CommonCredentialExtractor(
-			ctx,
-			cr.APICredentials.Source,
-			kube,
-			cr.APICredentials.CommonCredentialSelectors,
-		)
-		if err != nil {
-			return nil, FailedToGetSecret
-		}
-		if secretBytes == nil {
-			return nil, InvalidXsuaaCredentials
-		}
-		return ReadXsuaaCredentialsCustom(secretBytes)
+		return readCustomBinding(ctx, kube, cr.APICredentials)
 	case BindingSourceUpjet:
-		secret := &corev1.Secret{}
-		err = kube.Get(
-			ctx,
-			client.ObjectKey{
-				Name:      cr.SubaccountApiCredentialSecret,
-				Namespace: cr.SubaccountApiCredentialSecretNamespace,
-			},
-			secret,
-		)
-		if err != nil {
-			return nil, FailedToGetSecret
-		}
-		if secret.Data == nil {
-			return nil, InvalidXsuaaCredentials
-		}
-		return ReadXsuaaCredentialsUpjet(*secret)
+		return readUpjetBinding(ctx, kube, cr.SubaccountApiCredentialSecret, cr.SubaccountApiCredentialSecretNamespace)
+	case BindingSourceSecurityConfig:
+		return readSecurityConfigBinding(ctx, kube, cr.SecurityConfigRef.Name)
 	default:
 		return nil, InvalidSourceReference
 	}
 }
+
+// readCustomBinding reads the binding from a user created secret
+func readCustomBinding(ctx context.Context, kube client.Client, creds APICredentials) (*XsuaaBinding, error) {
+	secretBytes, err := resource.CommonCredentialExtractor(
+		ctx,
+		creds.Source,
+		kube,
+		creds.CommonCredentialSelectors,
+	)
+	if err != nil {
+		return nil, FailedToGetSecret
+	}
+	if secretBytes == nil {
+		return nil, InvalidXsuaaCredentials
+	}
+	return ReadXsuaaCredentialsCustom(secretBytes)
+}
+
+// readUpjetBinding reads the binding from the connection secret of a SubaccountApiCredential
+func readUpjetBinding(ctx context.Context, kube client.Client, name, namespace string) (*XsuaaBinding, error) {
+	secret := &corev1.Secret{}
+	err := kube.Get(
+		ctx,
+		client.ObjectKey{
+			Name:      name,
+			Namespace: namespace,
+		},
+		secret,
+	)
+	if err != nil {
+		return nil, FailedToGetSecret
+	}
+	if secret.Data == nil {
+		return nil, InvalidXsuaaCredentials
+	}
+	return ReadXsuaaCredentialsUpjet(*secret)
+}
+
+// readSecurityConfigBinding reads the binding from the source configured in the referenced SecurityConfig
+func readSecurityConfigBinding(ctx context.Context, kube client.Client, name string) (*XsuaaBinding, error) {
+	config := &SecurityConfig{}
+	if err := kube.Get(ctx, client.ObjectKey{Name: name}, config); err != nil {
+		return nil, FailedToGetSecurityConfig
+	}
+
+	if config.Spec.APICredentials != nil {
+		return readCustomBinding(ctx, kube, *config.Spec.APICredentials)
+	}
+	if config.Spec.SubaccountApiCredentialRef == nil {
+		return nil, InvalidSourceReference
+	}
+
+	apiCredential := &SubaccountApiCredential{}
+	if err := kube.Get(ctx, client.ObjectKey{Name: config.Spec.SubaccountApiCredentialRef.Name}, apiCredential); err != nil {
+		return nil, FailedToGetSubaccountApiCredential
+	}
+	secretRef := apiCredential.GetWriteConnectionSecretToReference()
+	if secretRef == nil {
+		return nil, InvalidXsuaaCredentials
+	}
+	return readUpjetBinding(ctx, kube, secretRef.Name, secretRef.Namespace)
+}
@@ -0,0 +1,145 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestCreateBindingFromSecurityConfig(t *testing.T) {
+	customSecret := map[string][]byte{
+		"credentials": []byte(`{"clientid": "clientid", "clientsecret": "clientsecret", "tokenurl": "tokenurl", "apiurl": "apiurl"}`),
+	}
+	upjetSecret := map[string][]byte{
+		"attribute.api_url":       []byte("aurl"),
+		"attribute.client_id":     []byte("cid"),
+		"attribute.client_secret": []byte("csecret"),
+		"attribute.token_url":     []byte("turl"),
+	}
+
+	kubeStub := func(config *SecurityConfig, apiCredential *SubaccountApiCredential, secretData map[string][]byte) client.Client {
+		return &test.MockClient{
+			MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+				switch o := obj.(type) {
+				case *SecurityConfig:
+					if config == nil {
+						return errors.New("not found")
+					}
+					*o = *config
+				case *SubaccountApiCredential:
+					if apiCredential == nil {
+						return errors.New("not found")
+					}
+					*o = *apiCredential
+				case *corev1.Secret:
+					if secretData == nil {
+						return errors.New("not found")
+					}
+					o.Data = secretData
+				}
+				return nil
+			},
+		}
+	}
+
+	customConfig := &SecurityConfig{Spec: SecurityConfigSpec{APICredentials: &APICredentials{
+		Source: xpv1.CredentialsSourceSecret,
+		CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+			SecretRef: &xpv1.SecretKeySelector{
+				Key:             "credentials",
+				SecretReference: xpv1.SecretReference{Name: "xsuaa-secret", Namespace: "default"},
+			},
+		},
+	}}}
+	upjetConfig := &SecurityConfig{Spec: SecurityConfigSpec{SubaccountApiCredentialRef: &xpv1.Reference{Name: "api-credential"}}}
+	apiCredential := &SubaccountApiCredential{}
+	apiCredential.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "api-credential-secret", Namespace: "default"})
+
+	tests := map[string]struct {
+		kube    client.Client
+		want    *XsuaaBinding
+		wantErr error
+	}{
+		"missing security config": {
+			kube:    kubeStub(nil, nil, nil),
+			wantErr: FailedToGetSecurityConfig,
+		},
+		"custom secret": {
+			kube: kubeStub(customConfig, nil, customSecret),
+			want: &XsuaaBinding{ClientId: "clientid", ClientSecret: "clientsecret", TokenURL: "tokenurl", ApiUrl: "apiurl"},
+		},
+		"missing subaccount api credential": {
+			kube:    kubeStub(upjetConfig, nil, upjetSecret),
+			wantErr: FailedToGetSubaccountApiCredential,
+		},
+		"subaccount api credential without connection secret": {
+			kube:    kubeStub(upjetConfig, &SubaccountApiCredential{}, upjetSecret),
+			wantErr: InvalidXsuaaCredentials,
+		},
+		"subaccount api credential": {
+			kube: kubeStub(upjetConfig, apiCredential, upjetSecret),
+			want: &XsuaaBinding{ClientId: "cid", ClientSecret: "csecret", TokenURL: "turl", ApiUrl: "aurl"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ref := &XSUAACredentialsReference{SecurityConfigRef: &xpv1.Reference{Name: "default"}}
+			got, err := CreateBindingFromSource(ref, context.Background(), tc.kube)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreateBindingFromSource(...): -want error, +got error:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nCreateBindingFromSource(...): -want, +got:\n", diff)
+			}
+		})
+	}
+}
+
+func TestDetermineBindingSource(t *testing.T) {
+	tests := map[string]struct {
+		ref     XSUAACredentialsReference
+		want    BindingSourceType
+		wantErr error
+	}{
+		"no source": {
+			wantErr: InvalidSourceReference,
+		},
+		"custom wins over security config": {
+			ref: XSUAACredentialsReference{
+				APICredentials:    APICredentials{Source: xpv1.CredentialsSourceSecret},
+				SecurityConfigRef: &xpv1.Reference{Name: "default"},
+			},
+			want: BindingSourceCustom,
+		},
+		"upjet wins over security config": {
+			ref: XSUAACredentialsReference{
+				SubaccountApiCredentialRef: &xpv1.Reference{Name: "api-credential"},
+				SecurityConfigRef:          &xpv1.Reference{Name: "default"},
+			},
+			want: BindingSourceUpjet,
+		},
+		"security config": {
+			ref:  XSUAACredentialsReference{SecurityConfigRef: &xpv1.Reference{Name: "default"}},
+			want: BindingSourceSecurityConfig,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := determineBindingSource(&tc.ref)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ndetermineBindingSource(...): -want error, +got error:\n", diff)
+			}
+			if got != tc.want {
+				t.Errorf("determineBindingSource() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -32,3 +32,31 @@ func SubaccountApiCredentialSecretSecretNamespace() reference.ExtractValueFn {
 		return sg.Spec.WriteConnectionSecretToReference.Namespace
 	}
 }
+
+// UserName extracts the name of a User, only set once the shadow user exists in xsuaa
+func UserName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		u, ok := mg.(*User)
+		if !ok {
+			return ""
+		}
+		if u.Status.AtProvider.UserName == nil {
+			return ""
+		}
+		return *u.Status.AtProvider.UserName
+	}
+}
+
+// GroupName extracts the display name of a Group, only set once the group exists in xsuaa
+func GroupName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		g, ok := mg.(*Group)
+		if !ok {
+			return ""
+		}
+		if g.Status.AtProvider.DisplayName == nil {
+			return ""
+		}
+		return *g.Status.AtProvider.DisplayName
+	}
+}
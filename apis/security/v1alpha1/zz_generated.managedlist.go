@@ -29,6 +29,15 @@ func (l *GlobalaccountTrustConfigurationList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this GroupList.
+func (l *GroupList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this RoleCollectionAssignmentList.
 func (l *RoleCollectionAssignmentList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -64,3 +73,12 @@ func (l *SubaccountTrustConfigurationList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this UserList.
+func (l *UserList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -26,6 +26,48 @@ import (
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this Group.
+func (mg *Group) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecret,
+		Extract:      SubaccountApiCredentialSecret(),
+		Reference:    mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialRef,
+		Selector:     mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSelector,
+		To: reference.To{
+			List:    &SubaccountApiCredentialList{},
+			Managed: &SubaccountApiCredential{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecret")
+	}
+	mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecret = rsp.ResolvedValue
+	mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecretNamespace,
+		Extract:      SubaccountApiCredentialSecretSecretNamespace(),
+		Reference:    mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialRef,
+		Selector:     mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSelector,
+		To: reference.To{
+			List:    &SubaccountApiCredentialList{},
+			Managed: &SubaccountApiCredential{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecretNamespace")
+	}
+	mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecretNamespace = rsp.ResolvedValue
+	mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this RoleCollection.
 func (mg *RoleCollection) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -75,6 +117,38 @@ func (mg *RoleCollectionAssignment) ResolveReferences(ctx context.Context, c cli
 	var rsp reference.ResolutionResponse
 	var err error
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.UserName,
+		Extract:      UserName(),
+		Reference:    mg.Spec.ForProvider.UserRef,
+		Selector:     mg.Spec.ForProvider.UserSelector,
+		To: reference.To{
+			List:    &UserList{},
+			Managed: &User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.UserName")
+	}
+	mg.Spec.ForProvider.UserName = rsp.ResolvedValue
+	mg.Spec.ForProvider.UserRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.GroupName,
+		Extract:      GroupName(),
+		Reference:    mg.Spec.ForProvider.GroupRef,
+		Selector:     mg.Spec.ForProvider.GroupSelector,
+		To: reference.To{
+			List:    &GroupList{},
+			Managed: &Group{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.GroupName")
+	}
+	mg.Spec.ForProvider.GroupName = rsp.ResolvedValue
+	mg.Spec.ForProvider.GroupRef = rsp.ResolvedReference
+
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecret,
 		Extract:      SubaccountApiCredentialSecret(),
@@ -193,3 +267,45 @@ func (mg *SubaccountTrustConfiguration) ResolveReferences(ctx context.Context, c
 
 	return nil
 }
+
+// ResolveReferences of this User.
+func (mg *User) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecret,
+		Extract:      SubaccountApiCredentialSecret(),
+		Reference:    mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialRef,
+		Selector:     mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSelector,
+		To: reference.To{
+			List:    &SubaccountApiCredentialList{},
+			Managed: &SubaccountApiCredential{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecret")
+	}
+	mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecret = rsp.ResolvedValue
+	mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecretNamespace,
+		Extract:      SubaccountApiCredentialSecretSecretNamespace(),
+		Reference:    mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialRef,
+		Selector:     mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSelector,
+		To: reference.To{
+			List:    &SubaccountApiCredentialList{},
+			Managed: &SubaccountApiCredential{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecretNamespace")
+	}
+	mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialSecretNamespace = rsp.ResolvedValue
+	mg.Spec.XSUAACredentialsReference.SubaccountApiCredentialRef = rsp.ResolvedReference
+
+	return nil
+}
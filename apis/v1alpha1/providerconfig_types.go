@@ -35,8 +35,20 @@ type ProviderConfigSpec struct {
 
 	// GlobalAccount is the Global Account Subdomain.
 	GlobalAccount string `json:"globalAccount,omitempty"`
+
+	// ServiceManagerBackend selects how ServiceInstances and ServiceBindings are managed.
+	// Terraform runs each resource through its own terraform workspace, Native calls the service manager API directly.
+	// If unset the provider wide --enable-native-service-manager flag decides.
+	// +kubebuilder:validation:Enum=Terraform;Native
+	// +optional
+	ServiceManagerBackend string `json:"serviceManagerBackend,omitempty"`
 }
 
+const (
+	ServiceManagerBackendTerraform = "Terraform"
+	ServiceManagerBackendNative    = "Native"
+)
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
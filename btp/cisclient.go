@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/go-openapi/runtime"
@@ -107,6 +109,7 @@ const (
 	cfenvironmentParameterInstanceName   = "instance_name"
 	CfOrgNameParameterName               = "Org Name"
 	KymaenvironmentParameterInstanceName = "name"
+	defaultCloudFoundryPlanName          = "standard"
 	grantTypeClientCredentials           = "client_credentials"
 	grantTypePassword                    = "password"
 	tokenURL                             = "/oauth/token"
@@ -292,14 +295,131 @@ func (c *Client) UpdateKymaEnvironment(ctx context.Context, environmentInstanceI
 	return nil
 }
 
+// CreateEnvironment creates an environment instance of any type offered to the subaccount
+func (c *Client) CreateEnvironment(ctx context.Context, environmentType EnvironmentType, instanceName string, planName string, landscape string, parameters InstanceParameters, serviceAccountEmail string) (string, error) {
+	var landscapeLabel *string
+	if landscape != "" {
+		landscapeLabel = &landscape
+	}
+	payload := provisioningclient.CreateEnvironmentInstanceRequestPayload{
+		Description:     internal.Ptr("created via crossplane-provider-btp"),
+		EnvironmentType: environmentType.Identifier,
+		LandscapeLabel:  landscapeLabel,
+		Name:            &instanceName,
+		Parameters:      parameters,
+		PlanName:        planName,
+		ServiceName:     environmentType.ServiceName,
+		User:            &serviceAccountEmail,
+	}
+	obj, _, err := c.ProvisioningServiceClient.CreateEnvironmentInstance(ctx).CreateEnvironmentInstanceRequestPayload(payload).Execute()
+	if err != nil {
+		return "", specifyAPIError(err)
+	}
+	return *obj.Id, nil
+}
+
+// UpdateEnvironment changes plan and parameters of an environment instance
+func (c *Client) UpdateEnvironment(ctx context.Context, environmentId string, planName string, parameters InstanceParameters) error {
+	payload := provisioningclient.UpdateEnvironmentInstanceRequestPayload{
+		Parameters: parameters,
+		PlanName:   planName,
+	}
+	_, _, err := c.ProvisioningServiceClient.UpdateEnvironmentInstance(ctx, environmentId).UpdateEnvironmentInstanceRequestPayload(payload).Execute()
+	if err != nil {
+		return specifyAPIError(err)
+	}
+	return nil
+}
+
+// GetAvailableEnvironments lists the environment types and plans the subaccount of the client is entitled to
+func (c *Client) GetAvailableEnvironments(ctx context.Context) ([]provisioningclient.AvailableEnvironmentResponseObject, error) {
+	// additional Authorization param needs to be set != nil to avoid client blocking the call due to mandatory condition in specs
+	response, _, err := c.ProvisioningServiceClient.GetAvailableEnvironments(ctx).Authorization("").Execute()
+	if err != nil {
+		return nil, specifyAPIError(err)
+	}
+	return response.AvailableEnvironments, nil
+}
+
+// GetEnvironmentSchemas returns the JSON schemas of the parameters on creation and on update of an environment plan,
+// both are empty if the plan isn't available in the subaccount
+func (c *Client) GetEnvironmentSchemas(ctx context.Context, environmentType EnvironmentType, planName string) (string, string, error) {
+	available, err := c.GetAvailableEnvironments(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	for _, env := range available {
+		if internal.Val(env.EnvironmentType) == environmentType.Identifier && internal.Val(env.ServiceName) == environmentType.ServiceName &&
+			internal.Val(env.PlanName) == planName {
+			return internal.Val(env.CreateSchema), internal.Val(env.UpdateSchema), nil
+		}
+	}
+	return "", "", nil
+}
+
+// CheckEnvironmentAvailability explains why an environment with the given type, plan and landscape can't be created
+// in the subaccount. The message is empty if it is available, an empty plan or landscape matches any. A plan that allows
+// no additional environment instance in the subaccount isn't available either.
+func (c *Client) CheckEnvironmentAvailability(ctx context.Context, environmentType EnvironmentType, planName string, landscape string) (string, error) {
+	available, err := c.GetAvailableEnvironments(ctx)
+	if err != nil {
+		return "", err
+	}
+	return environmentAvailability(available, environmentType, planName, landscape), nil
+}
+
+func environmentAvailability(available []provisioningclient.AvailableEnvironmentResponseObject, environmentType EnvironmentType, planName string, landscape string) string {
+	var plans, landscapes []string
+	exhausted := false
+	for _, env := range available {
+		if internal.Val(env.EnvironmentType) != environmentType.Identifier || internal.Val(env.ServiceName) != environmentType.ServiceName {
+			continue
+		}
+		plans = append(plans, internal.Val(env.PlanName))
+		if planName != "" && internal.Val(env.PlanName) != planName {
+			continue
+		}
+		landscapes = append(landscapes, internal.Val(env.LandscapeLabel))
+		if landscape == "" || internal.Val(env.LandscapeLabel) == landscape {
+			if env.AllowAdditionalEnvironmentInstance != nil && !*env.AllowAdditionalEnvironmentInstance {
+				exhausted = true
+				continue
+			}
+			return ""
+		}
+	}
+
+	switch {
+	case len(plans) == 0:
+		return fmt.Sprintf("environment type %s of service %s is not available in the subaccount", environmentType.Identifier, environmentType.ServiceName)
+	case len(landscapes) == 0:
+		return fmt.Sprintf("plan %s of environment type %s is not available in the subaccount, available plans: %s", planName, environmentType.Identifier, strings.Join(uniqueSorted(plans), ", "))
+	case exhausted:
+		return fmt.Sprintf("plan %s of environment type %s allows no additional environment instance in the subaccount", planName, environmentType.Identifier)
+	default:
+		return fmt.Sprintf("plan %s of environment type %s is not available on landscape %s, available landscapes: %s", planName, environmentType.Identifier, landscape, strings.Join(uniqueSorted(landscapes), ", "))
+	}
+}
+
+func uniqueSorted(values []string) []string {
+	set := map[string]bool{}
+	var unique []string
+	for _, v := range values {
+		if !set[v] {
+			set[v] = true
+			unique = append(unique, v)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
+
 func (c *Client) CreateCloudFoundryOrg(
 	ctx context.Context, serviceAccountEmail string, resourceUID string,
-	landscape string, orgName string, environmentName string,
+	landscape string, orgName string, environmentName string, planName string,
 ) (createdOrg string, err error) {
-	parameters := map[string]interface{}{
-		cfenvironmentParameterInstanceName: orgName, v1alpha1.SubaccountOperatorLabel: resourceUID,
-	}
-	cloudFoundryPlanName := "standard"
+	parameters := cloudFoundryParameters(orgName, resourceUID)
+	cloudFoundryPlanName := CloudFoundryPlanName(planName)
 	envType := CloudFoundryEnvironmentType()
 
 	var envName *string = nil
@@ -326,9 +446,24 @@ func (c *Client) CreateCloudFoundryOrg(
 	return createdOrg, nil
 }
 
+// CloudFoundryPlanName returns the plan a cloud foundry environment is created with, which defaults to the standard plan
+func CloudFoundryPlanName(planName string) string {
+	if planName == "" {
+		return defaultCloudFoundryPlanName
+	}
+	return planName
+}
+
+// cloudFoundryParameters are the parameters of a cloud foundry environment managed by the resource with the given UID
+func cloudFoundryParameters(orgName string, resourceUID string) map[string]interface{} {
+	return map[string]interface{}{
+		cfenvironmentParameterInstanceName: orgName, v1alpha1.SubaccountOperatorLabel: resourceUID,
+	}
+}
+
 func (c *Client) CreateCloudFoundryOrgIfNotExists(
 	ctx context.Context, instanceName string, serviceAccountEmail string, resourceUID string,
-	landscape string, orgName string, environmentName string,
+	landscape string, orgName string, environmentName string, planName string,
 ) (*CloudFoundryOrg, error) {
 	cfEnvironment, err := c.GetCFEnvironmentByNameAndOrg(ctx, instanceName, orgName)
 	if err != nil {
@@ -336,7 +471,7 @@ func (c *Client) CreateCloudFoundryOrgIfNotExists(
 	}
 	var orgId string
 	if cfEnvironment == nil {
-		orgId, err = c.CreateCloudFoundryOrg(ctx, serviceAccountEmail, resourceUID, landscape, orgName, environmentName)
+		orgId, err = c.CreateCloudFoundryOrg(ctx, serviceAccountEmail, resourceUID, landscape, orgName, environmentName, planName)
 		if err != nil {
 			return nil, err
 		}
@@ -350,6 +485,36 @@ func (c *Client) CreateCloudFoundryOrgIfNotExists(
 	return cfOrg, err
 }
 
+// UpdateCloudFoundryEnvironment changes the plan of the environment and renames its org,
+// the parameters are sent in full as on creation since the update replaces them
+func (c *Client) UpdateCloudFoundryEnvironment(ctx context.Context, environmentId string, planName string, orgName string, resourceUID string) error {
+	payload := provisioningclient.UpdateEnvironmentInstanceRequestPayload{
+		Parameters: cloudFoundryParameters(orgName, resourceUID),
+		PlanName:   planName,
+	}
+
+	_, _, err := c.ProvisioningServiceClient.UpdateEnvironmentInstance(ctx, environmentId).UpdateEnvironmentInstanceRequestPayload(payload).Execute()
+	if err != nil {
+		return specifyAPIError(err)
+	}
+	return nil
+}
+
+// SetEnvironmentLabels replaces the custom labels of an environment instance, an empty set of labels removes all of them
+func (c *Client) SetEnvironmentLabels(ctx context.Context, environmentId string, labels map[string][]string) error {
+	if len(labels) == 0 {
+		_, _, err := c.ProvisioningServiceClient.DeleteEnvironmentInstanceLabels(ctx, environmentId).Execute()
+		return specifyAPIError(err)
+	}
+
+	payload := provisioningclient.LabelAssignmentRequestPayload{Labels: make(map[string]interface{}, len(labels))}
+	for k, v := range labels {
+		payload.Labels[k] = v
+	}
+	_, _, err := c.ProvisioningServiceClient.CreateEnvironmentInstanceLabels(ctx, environmentId).LabelAssignmentRequestPayload(payload).Execute()
+	return specifyAPIError(err)
+}
+
 func (c *Client) GetCloudFoundryOrg(
 	ctx context.Context, orgId string,
 ) (*CloudFoundryOrg, error) {
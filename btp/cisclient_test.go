@@ -4,6 +4,9 @@ import (
 	"net/url"
 	"reflect"
 	"testing"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
 )
 
 func Test_authenticationParams(t *testing.T) {
@@ -129,3 +132,56 @@ func Test_authenticationParams(t *testing.T) {
 		)
 	}
 }
+
+func Test_environmentAvailability(t *testing.T) {
+	available := []provisioningclient.AvailableEnvironmentResponseObject{
+		{EnvironmentType: internal.Ptr("kyma"), ServiceName: internal.Ptr("kymaruntime"), PlanName: internal.Ptr("azure")},
+		{EnvironmentType: internal.Ptr("kyma"), ServiceName: internal.Ptr("kymaruntime"), PlanName: internal.Ptr("aws")},
+		{EnvironmentType: internal.Ptr("kyma"), ServiceName: internal.Ptr("kymaruntime"), PlanName: internal.Ptr("trial"), AllowAdditionalEnvironmentInstance: internal.Ptr(false)},
+		{EnvironmentType: internal.Ptr("cloudfoundry"), ServiceName: internal.Ptr("cloudfoundry"), PlanName: internal.Ptr("standard"), LandscapeLabel: internal.Ptr("cf-eu10")},
+		{EnvironmentType: internal.Ptr("cloudfoundry"), ServiceName: internal.Ptr("cloudfoundry"), PlanName: internal.Ptr("standard"), LandscapeLabel: internal.Ptr("cf-eu10-002")},
+	}
+
+	tests := []struct {
+		name      string
+		envType   EnvironmentType
+		planName  string
+		landscape string
+		want      string
+	}{
+		{name: "Available", envType: KymaEnvironmentType(), planName: "aws"},
+		{name: "AnyPlan", envType: CloudFoundryEnvironmentType()},
+		{name: "AvailableOnLandscape", envType: CloudFoundryEnvironmentType(), planName: "standard", landscape: "cf-eu10-002"},
+		{
+			name:    "TypeNotAvailable",
+			envType: EnvironmentType{Identifier: "abap", ServiceName: "abap"},
+			want:    "environment type abap of service abap is not available in the subaccount",
+		},
+		{
+			name:     "PlanNotAvailable",
+			envType:  KymaEnvironmentType(),
+			planName: "gcp",
+			want:     "plan gcp of environment type kyma is not available in the subaccount, available plans: aws, azure, trial",
+		},
+		{
+			name:     "NoAdditionalInstance",
+			envType:  KymaEnvironmentType(),
+			planName: "trial",
+			want:     "plan trial of environment type kyma allows no additional environment instance in the subaccount",
+		},
+		{
+			name:      "LandscapeNotAvailable",
+			envType:   CloudFoundryEnvironmentType(),
+			planName:  "standard",
+			landscape: "cf-us10",
+			want:      "plan standard of environment type cloudfoundry is not available on landscape cf-us10, available landscapes: cf-eu10, cf-eu10-002",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := environmentAvailability(available, tt.envType, tt.planName, tt.landscape); got != tt.want {
+				t.Errorf("environmentAvailability() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
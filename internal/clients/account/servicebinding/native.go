@@ -0,0 +1,361 @@
+package servicebindingclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	instanceClient "github.com/sap/crossplane-provider-btp/internal/clients/account/serviceinstance"
+	"github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
+	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
+	smapi "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errGetServiceInstance = "cannot get service instance of binding"
+	errNoServiceInstance  = "no ServiceInstance with id %s found"
+	errLoadSmSecret       = "cannot load service manager secret"
+	errInitSmClient       = "cannot initialize service manager client"
+	errBuildParameter     = "cannot build service binding parameters"
+	errMarshalCredentials = "cannot marshal service binding credentials"
+	errUpdateStatus       = "cannot update service binding status"
+	errUpdateExternalName = "cannot update service binding external name"
+	errDeleteExpired      = "cannot delete expired service binding %s"
+	errGetParameters      = "cannot get service binding parameters"
+
+	// connectionDetailsCredentials is the key the credentials document is passed on with, the controller brings it into the configured secret format
+	connectionDetailsCredentials = "attribute.credentials"
+)
+
+// NewNativeServiceBindingConnector creates a connector that manages service bindings directly via the service manager API,
+// using the service manager credentials of the ServiceInstance the binding belongs to
+func NewNativeServiceBindingConnector(kube client.Client, newClientFn instanceClient.NewNativeClientFn, loadSecretFn instanceClient.LoadSecretFn) tfclient.TfProxyConnectorI[*v1alpha1.ServiceBinding] {
+	return &NativeServiceBindingConnector{
+		kube:         kube,
+		newClientFn:  newClientFn,
+		loadSecretFn: loadSecretFn,
+	}
+}
+
+type NativeServiceBindingConnector struct {
+	kube         client.Client
+	newClientFn  instanceClient.NewNativeClientFn
+	loadSecretFn instanceClient.LoadSecretFn
+}
+
+func (n *NativeServiceBindingConnector) Connect(ctx context.Context, sb *v1alpha1.ServiceBinding) (tfclient.TfProxyControllerI, error) {
+	si, err := n.serviceInstance(ctx, sb)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetServiceInstance)
+	}
+	secretData, err := n.loadSecretFn(n.kube, ctx, si.Spec.ForProvider.ServiceManagerSecret, si.Spec.ForProvider.ServiceManagerSecretNamespace)
+	if err != nil {
+		return nil, errors.Wrap(err, errLoadSmSecret)
+	}
+	smClient, err := n.newClientFn(ctx, secretData)
+	if err != nil {
+		return nil, errors.Wrap(err, errInitSmClient)
+	}
+	return &NativeServiceBindingController{client: smClient, kube: n.kube, cr: sb}, nil
+}
+
+// serviceInstance resolves the ServiceInstance CR of the binding, either via its reference or by the instance id
+func (n *NativeServiceBindingConnector) serviceInstance(ctx context.Context, sb *v1alpha1.ServiceBinding) (*v1alpha1.ServiceInstance, error) {
+	if ref := sb.Spec.ForProvider.ServiceInstanceRef; ref != nil {
+		si := &v1alpha1.ServiceInstance{}
+		if err := n.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, si); err != nil {
+			return nil, err
+		}
+		return si, nil
+	}
+	instanceID := internal.Val(sb.Spec.ForProvider.ServiceInstanceID)
+	list := &v1alpha1.ServiceInstanceList{}
+	if err := n.kube.List(ctx, list); err != nil {
+		return nil, err
+	}
+	for i := range list.Items {
+		if instanceID != "" && list.Items[i].Status.AtProvider.ID == instanceID {
+			return &list.Items[i], nil
+		}
+	}
+	return nil, errors.Errorf(errNoServiceInstance, instanceID)
+}
+
+var _ tfclient.TfProxyControllerI = &NativeServiceBindingController{}
+
+// NativeServiceBindingController provides the lifecycle of a single service binding via the service manager API.
+// Bindings can't be updated, Observe polls their asynchronous creation and deletion.
+type NativeServiceBindingController struct {
+	client servicemanager.NativeClientI
+	kube   client.Client
+	cr     *v1alpha1.ServiceBinding
+
+	// observed external state, set by Observe
+	binding   *smapi.ServiceBindingResponseObject
+	operation *smapi.OperationResponseObject
+}
+
+func (n *NativeServiceBindingController) Observe(ctx context.Context) (tfclient.Status, map[string][]byte, error) {
+	binding, err := n.lookup(ctx)
+	if err != nil {
+		return tfclient.Unknown, nil, err
+	}
+	if binding == nil {
+		return tfclient.NotExisting, nil, nil
+	}
+	op, err := n.client.CurrentOperation(ctx, servicemanager.ResourceTypeServiceBindings, internal.Val(binding.Id), binding.LastOperation)
+	if err != nil {
+		return tfclient.Unknown, nil, err
+	}
+	n.binding, n.operation = binding, op
+
+	switch servicemanager.OperationState(op) {
+	case servicemanager.OperationStateInProgress:
+		return tfclient.UpToDate, map[string][]byte{}, nil
+	case servicemanager.OperationStateFailed:
+		if !meta.WasDeleted(n.cr) {
+			return tfclient.Unknown, nil, servicemanager.OperationError(op)
+		}
+	}
+
+	details, err := connectionDetails(binding)
+	if err != nil {
+		return tfclient.Unknown, nil, err
+	}
+	if !meta.WasDeleted(n.cr) {
+		drift, err := n.parameterDrift(ctx)
+		if err != nil {
+			return tfclient.Unknown, nil, err
+		}
+		// bindings can't be updated, the drift is only reported and persisted along with the rest of the status by the managed reconciler
+		n.cr.Status.AtProvider.ParameterDrift = drift
+	}
+	if rotationEnabled(n.cr) && !meta.WasDeleted(n.cr) {
+		n.track(binding, time.Now())
+		if rotationDue(n.cr, time.Now()) {
+			return tfclient.Drift, details, nil
+		}
+	}
+	return tfclient.UpToDate, details, nil
+}
+
+func (n *NativeServiceBindingController) Create(ctx context.Context) error {
+	name := n.cr.Spec.ForProvider.Name
+	if rotationEnabled(n.cr) && len(n.cr.Status.AtProvider.Bindings) > 0 {
+		// the active binding is gone, its replacement must not clash with the names of the remaining ones
+		name = rotatedName(name, time.Now())
+	}
+	return n.createBinding(ctx, name)
+}
+
+// Update rotates the binding if rotation is enabled and due, otherwise it is a no-op since service bindings are immutable
+func (n *NativeServiceBindingController) Update(ctx context.Context) error {
+	if !rotationEnabled(n.cr) {
+		return nil
+	}
+	now := time.Now()
+	if active := activeBinding(n.cr); active == nil || !now.Before(active.CreatedAt.Add(n.cr.Spec.ForProvider.RotationInterval.Duration)) {
+		if err := n.createBinding(ctx, rotatedName(n.cr.Spec.ForProvider.Name, now)); err != nil {
+			return err
+		}
+	}
+	return n.deleteExpired(ctx, now)
+}
+
+func (n *NativeServiceBindingController) Delete(ctx context.Context) error {
+	if rotationEnabled(n.cr) {
+		for _, b := range n.cr.Status.AtProvider.Bindings {
+			if n.binding != nil && b.ID == internal.Val(n.binding.Id) {
+				continue
+			}
+			if err := n.client.DeleteBinding(ctx, b.ID); err != nil {
+				return err
+			}
+		}
+	}
+	if n.binding == nil || servicemanager.OperationState(n.operation) == servicemanager.OperationStateInProgress {
+		return nil
+	}
+	return n.client.DeleteBinding(ctx, internal.Val(n.binding.Id))
+}
+
+// createBinding creates a binding with the given name and makes it the active one
+func (n *NativeServiceBindingController) createBinding(ctx context.Context, name string) error {
+	parameterJson, err := instanceClient.BuildComplexParameterJson(ctx, n.kube, n.cr.Spec.ForProvider.ParameterSecretRefs, n.cr.Spec.ForProvider.Parameters.Raw)
+	if err != nil {
+		return errors.Wrap(err, errBuildParameter)
+	}
+	parameters, err := servicemanager.RawParameters(parameterJson)
+	if err != nil {
+		return errors.Wrap(err, errBuildParameter)
+	}
+	id, err := n.client.CreateBinding(ctx, servicemanager.BindingPayload{
+		Name:              name,
+		ServiceInstanceID: internal.Val(n.cr.Spec.ForProvider.ServiceInstanceID),
+		Parameters:        parameters,
+	})
+	if err != nil {
+		return err
+	}
+	if !rotationEnabled(n.cr) {
+		// without rotation bindings are only created in Create, the managed reconciler persists the external name right after it
+		meta.SetExternalName(n.cr, id)
+		return nil
+	}
+	now := time.Now()
+	for i := range n.cr.Status.AtProvider.Bindings {
+		n.cr.Status.AtProvider.Bindings[i].IsActive = false
+	}
+	n.cr.Status.AtProvider.Bindings = append(n.cr.Status.AtProvider.Bindings, v1alpha1.RotatedBinding{
+		ID:        id,
+		Name:      name,
+		IsActive:  true,
+		CreatedAt: metav1.NewTime(now),
+		ExpiresAt: metav1.NewTime(now.Add(bindingTTL(n.cr))),
+	})
+	// the binding list is only kept in the status, persist it right away so we don't lose track of the new binding
+	if err := n.kube.Status().Update(ctx, n.cr); err != nil {
+		return errors.Wrap(err, errUpdateStatus)
+	}
+	// rotations happen in Update, after which the managed reconciler doesn't save the metadata, so the external name is saved manually
+	meta.SetExternalName(n.cr, id)
+	return errors.Wrap(n.kube.Update(ctx, n.cr), errUpdateExternalName)
+}
+
+// parameterDrift compares the parameters of the binding with the ones in the spec
+func (n *NativeServiceBindingController) parameterDrift(ctx context.Context) ([]string, error) {
+	actual, err := n.client.GetParameters(ctx, servicemanager.ResourceTypeServiceBindings, internal.Val(n.binding.Id))
+	if err != nil {
+		return nil, errors.Wrap(err, errGetParameters)
+	}
+	if actual == nil {
+		return nil, nil
+	}
+	parameterJson, err := instanceClient.BuildComplexParameterJson(ctx, n.kube, n.cr.Spec.ForProvider.ParameterSecretRefs, n.cr.Spec.ForProvider.Parameters.Raw)
+	if err != nil {
+		return nil, errors.Wrap(err, errBuildParameter)
+	}
+	drift, err := instanceClient.ParameterJsonDrift(parameterJson, actual)
+	return drift, errors.Wrap(err, errBuildParameter)
+}
+
+// deleteExpired deletes all inactive bindings that passed their ttl and removes them from the status
+func (n *NativeServiceBindingController) deleteExpired(ctx context.Context, now time.Time) error {
+	remaining := make([]v1alpha1.RotatedBinding, 0, len(n.cr.Status.AtProvider.Bindings))
+	for _, b := range n.cr.Status.AtProvider.Bindings {
+		if b.IsActive || now.Before(b.ExpiresAt.Time) {
+			remaining = append(remaining, b)
+			continue
+		}
+		if err := n.client.DeleteBinding(ctx, b.ID); err != nil {
+			return errors.Wrapf(err, errDeleteExpired, b.ID)
+		}
+	}
+	if len(remaining) == len(n.cr.Status.AtProvider.Bindings) {
+		return nil
+	}
+	n.cr.Status.AtProvider.Bindings = remaining
+	return errors.Wrap(n.kube.Status().Update(ctx, n.cr), errUpdateStatus)
+}
+
+// track adds an existing binding to the status if it isn't tracked yet, e.g. when rotation has been enabled later on
+func (n *NativeServiceBindingController) track(binding *smapi.ServiceBindingResponseObject, now time.Time) {
+	if activeBinding(n.cr) != nil {
+		return
+	}
+	createdAt := now
+	if binding.CreatedAt != nil {
+		createdAt = *binding.CreatedAt
+	}
+	n.cr.Status.AtProvider.Bindings = append(n.cr.Status.AtProvider.Bindings, v1alpha1.RotatedBinding{
+		ID:        internal.Val(binding.Id),
+		Name:      internal.Val(binding.Name),
+		IsActive:  true,
+		CreatedAt: metav1.NewTime(createdAt),
+		ExpiresAt: metav1.NewTime(createdAt.Add(bindingTTL(n.cr))),
+	})
+}
+
+// QueryAsyncData returns the relevant status data once the binding is ready
+func (n *NativeServiceBindingController) QueryAsyncData(ctx context.Context) *tfclient.ObservationData {
+	if n.binding == nil || !internal.Val(n.binding.Ready) || servicemanager.OperationState(n.operation) == servicemanager.OperationStateInProgress {
+		return nil
+	}
+	id := internal.Val(n.binding.Id)
+	return &tfclient.ObservationData{
+		ExternalName: id,
+		ID:           id,
+		Conditions:   []xpv1.Condition{xpv1.Available()},
+	}
+}
+
+// lookup finds the binding by its id, as long as it hasn't been created yet the external name still defaults to the CR name and we look it up by name.
+// With rotation enabled the active binding in the status takes precedence.
+func (n *NativeServiceBindingController) lookup(ctx context.Context) (*smapi.ServiceBindingResponseObject, error) {
+	if active := activeBinding(n.cr); rotationEnabled(n.cr) && active != nil {
+		return n.client.GetBinding(ctx, active.ID)
+	}
+	if id := meta.GetExternalName(n.cr); id != "" && id != n.cr.GetName() {
+		return n.client.GetBinding(ctx, id)
+	}
+	return n.client.FindBinding(ctx, n.cr.Spec.ForProvider.Name, internal.Val(n.cr.Spec.ForProvider.ServiceInstanceID))
+}
+
+func connectionDetails(binding *smapi.ServiceBindingResponseObject) (map[string][]byte, error) {
+	if binding.Credentials == nil {
+		return map[string][]byte{}, nil
+	}
+	credentials, err := json.Marshal(binding.Credentials)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalCredentials)
+	}
+	return map[string][]byte{connectionDetailsCredentials: credentials}, nil
+}
+
+func rotationEnabled(cr *v1alpha1.ServiceBinding) bool {
+	return cr.Spec.ForProvider.RotationInterval != nil && cr.Spec.ForProvider.RotationInterval.Duration > 0
+}
+
+// bindingTTL returns the configured ttl of rotated bindings, defaulting to twice the rotation interval
+func bindingTTL(cr *v1alpha1.ServiceBinding) time.Duration {
+	if ttl := cr.Spec.ForProvider.BindingTTL; ttl != nil && ttl.Duration > 0 {
+		return ttl.Duration
+	}
+	return 2 * cr.Spec.ForProvider.RotationInterval.Duration
+}
+
+func activeBinding(cr *v1alpha1.ServiceBinding) *v1alpha1.RotatedBinding {
+	for i := range cr.Status.AtProvider.Bindings {
+		if cr.Status.AtProvider.Bindings[i].IsActive {
+			return &cr.Status.AtProvider.Bindings[i]
+		}
+	}
+	return nil
+}
+
+// rotationDue reports whether the active binding needs to be rotated or an inactive one passed its ttl
+func rotationDue(cr *v1alpha1.ServiceBinding, now time.Time) bool {
+	for _, b := range cr.Status.AtProvider.Bindings {
+		if b.IsActive && !now.Before(b.CreatedAt.Add(cr.Spec.ForProvider.RotationInterval.Duration)) {
+			return true
+		}
+		if !b.IsActive && !now.Before(b.ExpiresAt.Time) {
+			return true
+		}
+	}
+	return false
+}
+
+// rotatedName makes binding names unique per instance, the service manager rejects duplicates
+func rotatedName(name string, now time.Time) string {
+	return fmt.Sprintf("%s-%d", name, now.Unix())
+}
@@ -0,0 +1,353 @@
+package servicebindingclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
+	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
+	smapi "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestNativeConnect(t *testing.T) {
+	instance := v1alpha1.ServiceInstance{}
+	instance.Spec.ForProvider.ServiceManagerSecret = "sm-secret"
+	instance.Spec.ForProvider.ServiceManagerSecretNamespace = "default"
+	instance.Status.AtProvider.ID = "instance-id"
+
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			if key.Name != "si" {
+				return errors.New("not found")
+			}
+			*obj.(*v1alpha1.ServiceInstance) = instance
+			return nil
+		},
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*v1alpha1.ServiceInstanceList).Items = []v1alpha1.ServiceInstance{instance}
+			return nil
+		},
+	}
+
+	tests := map[string]struct {
+		cr         *v1alpha1.ServiceBinding
+		wantSecret string
+		wantErr    bool
+	}{
+		"ByReference": {
+			cr: nativeBinding(func(sb *v1alpha1.ServiceBinding) {
+				sb.Spec.ForProvider.ServiceInstanceRef = &xpv1.Reference{Name: "si"}
+			}),
+			wantSecret: "default/sm-secret",
+		},
+		"MissingReference": {
+			cr: nativeBinding(func(sb *v1alpha1.ServiceBinding) {
+				sb.Spec.ForProvider.ServiceInstanceRef = &xpv1.Reference{Name: "other"}
+			}),
+			wantErr: true,
+		},
+		"ByID": {
+			cr:         nativeBinding(),
+			wantSecret: "default/sm-secret",
+		},
+		"UnknownID": {
+			cr:      nativeBinding(func(sb *v1alpha1.ServiceBinding) { sb.Spec.ForProvider.ServiceInstanceID = internal.Ptr("other-id") }),
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var secret string
+			c := NewNativeServiceBindingConnector(kube,
+				func(ctx context.Context, secretData map[string][]byte) (servicemanager.NativeClientI, error) {
+					return &servicemanager.NativeClientFake{}, nil
+				},
+				func(kube client.Client, ctx context.Context, secretName, secretNamespace string) (map[string][]byte, error) {
+					secret = secretNamespace + "/" + secretName
+					return map[string][]byte{}, nil
+				},
+			)
+			_, err := c.Connect(context.TODO(), tc.cr)
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Connect() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if secret != tc.wantSecret {
+				t.Errorf("Connect() loaded secret %v, want %v", secret, tc.wantSecret)
+			}
+		})
+	}
+}
+
+func TestNativeObserve(t *testing.T) {
+	tests := map[string]struct {
+		cr          *v1alpha1.ServiceBinding
+		client      *servicemanager.NativeClientFake
+		wantStatus  tfclient.Status
+		wantDetails map[string][]byte
+		wantData    *tfclient.ObservationData
+		wantDrift   []string
+		wantErr     bool
+	}{
+		"NotExisting": {
+			cr:         nativeBinding(),
+			client:     &servicemanager.NativeClientFake{},
+			wantStatus: tfclient.NotExisting,
+		},
+		"InProgress": {
+			cr: nativeBinding(withExternalName("binding-id")),
+			client: &servicemanager.NativeClientFake{
+				Binding:   smBinding(false),
+				Operation: &smapi.OperationResponseObject{Id: internal.Ptr("op"), State: internal.Ptr(servicemanager.OperationStateInProgress)},
+			},
+			wantStatus:  tfclient.UpToDate,
+			wantDetails: map[string][]byte{},
+		},
+		"Failed": {
+			cr: nativeBinding(withExternalName("binding-id")),
+			client: &servicemanager.NativeClientFake{
+				Binding:   smBinding(false),
+				Operation: &smapi.OperationResponseObject{Id: internal.Ptr("op"), State: internal.Ptr(servicemanager.OperationStateFailed), Description: internal.Ptr("broker error")},
+			},
+			wantStatus: tfclient.Unknown,
+			wantErr:    true,
+		},
+		"Ready": {
+			cr: nativeBinding(withExternalName("binding-id")),
+			client: &servicemanager.NativeClientFake{
+				Binding: smBinding(true),
+			},
+			wantStatus:  tfclient.UpToDate,
+			wantDetails: map[string][]byte{"attribute.credentials": []byte(`{"clientid":"id"}`)},
+			wantData:    &tfclient.ObservationData{ExternalName: "binding-id", ID: "binding-id", Conditions: []xpv1.Condition{xpv1.Available()}},
+		},
+		"ParameterDrift": {
+			cr: nativeBinding(withExternalName("binding-id"), func(sb *v1alpha1.ServiceBinding) {
+				sb.Spec.ForProvider.Parameters = runtime.RawExtension{Raw: []byte(`{"role": "admin"}`)}
+			}),
+			client: &servicemanager.NativeClientFake{
+				Binding:    smBinding(true),
+				Parameters: map[string]interface{}{"role": "viewer"},
+			},
+			wantStatus:  tfclient.UpToDate,
+			wantDetails: map[string][]byte{"attribute.credentials": []byte(`{"clientid":"id"}`)},
+			wantData:    &tfclient.ObservationData{ExternalName: "binding-id", ID: "binding-id", Conditions: []xpv1.Condition{xpv1.Available()}},
+			wantDrift:   []string{"role: changed"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			n := &NativeServiceBindingController{client: tc.client, cr: tc.cr}
+			status, details, err := n.Observe(context.TODO())
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Observe() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if status != tc.wantStatus {
+				t.Errorf("Observe() status = %v, want %v", status, tc.wantStatus)
+			}
+			if diff := cmp.Diff(tc.wantDetails, details); diff != "" {
+				t.Errorf("\n%s\nObserve(): -want details, +got details:\n", diff)
+			}
+			if diff := cmp.Diff(tc.wantData, n.QueryAsyncData(context.TODO())); diff != "" {
+				t.Errorf("\n%s\nQueryAsyncData(): -want, +got:\n", diff)
+			}
+			if diff := cmp.Diff(tc.wantDrift, tc.cr.Status.AtProvider.ParameterDrift); diff != "" {
+				t.Errorf("\n%s\nObserve(): -want drift, +got drift:\n", diff)
+			}
+		})
+	}
+}
+
+func TestNativeCreate(t *testing.T) {
+	fake := &servicemanager.NativeClientFake{CreatedID: "binding-id"}
+	cr := nativeBinding()
+	n := &NativeServiceBindingController{client: fake, cr: cr}
+
+	if err := n.Create(context.TODO()); err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
+
+	wantPayload := &servicemanager.BindingPayload{Name: "sb-name", ServiceInstanceID: "instance-id"}
+	if diff := cmp.Diff(wantPayload, fake.BindingPayload); diff != "" {
+		t.Errorf("\n%s\nCreate(): -want payload, +got payload:\n", diff)
+	}
+	if got := meta.GetExternalName(cr); got != "binding-id" {
+		t.Errorf("Create() external name = %v, want binding-id", got)
+	}
+}
+
+func TestNativeRotationObserve(t *testing.T) {
+	now := time.Now()
+
+	tests := map[string]struct {
+		cr           *v1alpha1.ServiceBinding
+		wantStatus   tfclient.Status
+		wantLookup   string
+		wantBindings []v1alpha1.RotatedBinding
+	}{
+		"TracksExisting": {
+			cr:         nativeBinding(withRotation(time.Hour, 2*time.Hour), withExternalName("binding-id")),
+			wantStatus: tfclient.UpToDate,
+			wantLookup: "binding-id",
+			wantBindings: []v1alpha1.RotatedBinding{
+				{ID: "binding-id", Name: "sb-name", IsActive: true, CreatedAt: metav1.NewTime(now), ExpiresAt: metav1.NewTime(now.Add(2 * time.Hour))},
+			},
+		},
+		"NotDue": {
+			cr: nativeBinding(withRotation(time.Hour, 2*time.Hour), withBindings(
+				v1alpha1.RotatedBinding{ID: "binding-id", IsActive: true, CreatedAt: metav1.NewTime(now.Add(-30 * time.Minute))},
+			)),
+			wantStatus: tfclient.UpToDate,
+			wantLookup: "binding-id",
+		},
+		"IntervalElapsed": {
+			cr: nativeBinding(withRotation(time.Hour, 2*time.Hour), withBindings(
+				v1alpha1.RotatedBinding{ID: "binding-id", IsActive: true, CreatedAt: metav1.NewTime(now.Add(-2 * time.Hour))},
+			)),
+			wantStatus: tfclient.Drift,
+			wantLookup: "binding-id",
+		},
+		"Expired": {
+			cr: nativeBinding(withRotation(time.Hour, 2*time.Hour), withBindings(
+				v1alpha1.RotatedBinding{ID: "old-id", ExpiresAt: metav1.NewTime(now.Add(-time.Minute))},
+				v1alpha1.RotatedBinding{ID: "binding-id", IsActive: true, CreatedAt: metav1.NewTime(now)},
+			)),
+			wantStatus: tfclient.Drift,
+			wantLookup: "binding-id",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake := &servicemanager.NativeClientFake{Binding: smBinding(true)}
+			n := &NativeServiceBindingController{client: fake, cr: tc.cr}
+			status, _, err := n.Observe(context.TODO())
+			if err != nil {
+				t.Fatalf("Observe() unexpected error = %v", err)
+			}
+			if status != tc.wantStatus {
+				t.Errorf("Observe() status = %v, want %v", status, tc.wantStatus)
+			}
+			if fake.LookedUpID != tc.wantLookup {
+				t.Errorf("Observe() looked up %v, want %v", fake.LookedUpID, tc.wantLookup)
+			}
+			if tc.wantBindings != nil {
+				if diff := cmp.Diff(tc.wantBindings, tc.cr.Status.AtProvider.Bindings, cmpopts.EquateApproxTime(time.Minute)); diff != "" {
+					t.Errorf("\n%s\nObserve(): -want bindings, +got bindings:\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestNativeRotate(t *testing.T) {
+	now := time.Now()
+	fake := &servicemanager.NativeClientFake{CreatedID: "new-id"}
+	var statusUpdates, updates int
+	kube := &test.MockClient{
+		MockStatusUpdate: func(_ context.Context, _ client.Object, _ ...client.SubResourceUpdateOption) error {
+			statusUpdates++
+			return nil
+		},
+		MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+			updates++
+			if got := meta.GetExternalName(obj); got != "new-id" {
+				t.Errorf("Update() saved external name %v, want new-id", got)
+			}
+			return nil
+		},
+	}
+	cr := nativeBinding(withRotation(time.Hour, 2*time.Hour), withBindings(
+		v1alpha1.RotatedBinding{ID: "expired-id", ExpiresAt: metav1.NewTime(now.Add(-time.Minute))},
+		v1alpha1.RotatedBinding{ID: "binding-id", IsActive: true, CreatedAt: metav1.NewTime(now.Add(-2 * time.Hour)), ExpiresAt: metav1.NewTime(now.Add(time.Hour))},
+	))
+	n := &NativeServiceBindingController{client: fake, kube: kube, cr: cr}
+
+	if err := n.Update(context.TODO()); err != nil {
+		t.Fatalf("Update() unexpected error = %v", err)
+	}
+
+	if got := fake.BindingPayload.Name; got != rotatedName("sb-name", now) {
+		t.Errorf("Update() created binding %v, want %v", got, rotatedName("sb-name", now))
+	}
+	if diff := cmp.Diff([]string{"expired-id"}, fake.DeletedIDs); diff != "" {
+		t.Errorf("\n%s\nUpdate(): -want deleted, +got deleted:\n", diff)
+	}
+	wantBindings := []v1alpha1.RotatedBinding{
+		{ID: "binding-id", CreatedAt: metav1.NewTime(now.Add(-2 * time.Hour)), ExpiresAt: metav1.NewTime(now.Add(time.Hour))},
+		{ID: "new-id", Name: rotatedName("sb-name", now), IsActive: true, CreatedAt: metav1.NewTime(now), ExpiresAt: metav1.NewTime(now.Add(2 * time.Hour))},
+	}
+	if diff := cmp.Diff(wantBindings, cr.Status.AtProvider.Bindings, cmpopts.EquateApproxTime(time.Minute)); diff != "" {
+		t.Errorf("\n%s\nUpdate(): -want bindings, +got bindings:\n", diff)
+	}
+	if got := meta.GetExternalName(cr); got != "new-id" {
+		t.Errorf("Update() external name = %v, want new-id", got)
+	}
+	if statusUpdates != 2 {
+		t.Errorf("Update() persisted status %d times, want 2", statusUpdates)
+	}
+	if updates != 1 {
+		t.Errorf("Update() persisted external name %d times, want 1", updates)
+	}
+}
+
+func TestNativeRotationDelete(t *testing.T) {
+	fake := &servicemanager.NativeClientFake{Binding: smBinding(true)}
+	cr := nativeBinding(withRotation(time.Hour, 2*time.Hour), withBindings(
+		v1alpha1.RotatedBinding{ID: "old-id"},
+		v1alpha1.RotatedBinding{ID: "binding-id", IsActive: true, CreatedAt: metav1.Now()},
+	))
+	n := &NativeServiceBindingController{client: fake, cr: cr}
+	_, _, _ = n.Observe(context.TODO())
+
+	if err := n.Delete(context.TODO()); err != nil {
+		t.Fatalf("Delete() unexpected error = %v", err)
+	}
+	if diff := cmp.Diff([]string{"old-id", "binding-id"}, fake.DeletedIDs); diff != "" {
+		t.Errorf("\n%s\nDelete(): -want deleted, +got deleted:\n", diff)
+	}
+}
+
+func withRotation(interval, ttl time.Duration) func(*v1alpha1.ServiceBinding) {
+	return func(sb *v1alpha1.ServiceBinding) {
+		sb.Spec.ForProvider.RotationInterval = &metav1.Duration{Duration: interval}
+		sb.Spec.ForProvider.BindingTTL = &metav1.Duration{Duration: ttl}
+	}
+}
+
+func withBindings(bindings ...v1alpha1.RotatedBinding) func(*v1alpha1.ServiceBinding) {
+	return func(sb *v1alpha1.ServiceBinding) {
+		sb.Status.AtProvider.Bindings = bindings
+	}
+}
+
+func nativeBinding(opts ...func(*v1alpha1.ServiceBinding)) *v1alpha1.ServiceBinding {
+	cr := expectedServiceBinding()
+	cr.SetName("sb")
+	cr.Spec.ForProvider.Name = "sb-name"
+	cr.Spec.ForProvider.ServiceInstanceID = internal.Ptr("instance-id")
+	cr.Spec.ForProvider.Parameters = runtime.RawExtension{}
+	for _, opt := range opts {
+		opt(cr)
+	}
+	return cr
+}
+
+func smBinding(ready bool) *smapi.ServiceBindingResponseObject {
+	return &smapi.ServiceBindingResponseObject{
+		Id:          internal.Ptr("binding-id"),
+		Name:        internal.Ptr("sb-name"),
+		Ready:       internal.Ptr(ready),
+		Credentials: map[string]interface{}{"clientid": "id"},
+	}
+}
@@ -0,0 +1,221 @@
+package servicebindingclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+)
+
+const (
+	errUnmarshalCredentials = "cannot unmarshal service binding credentials"
+	errRenderTemplate       = "cannot render secret template for key %s"
+	errUnknownSecretFormat  = "unknown secret format %s"
+
+	secretKeyCredentials = "credentials"
+	secretKeyVcap        = "VCAP_SERVICES"
+	secretKeyMetadata    = ".metadata"
+
+	// vcapDefaultLabel is used as service label if the offering of the instance is unknown
+	vcapDefaultLabel = "user-provided"
+)
+
+// BindingMetadata describes the binding and its instance for the secret formats that publish more than the credentials
+type BindingMetadata struct {
+	BindingName  string
+	BindingID    string
+	InstanceName string
+	InstanceID   string
+	Offering     string
+	Plan         string
+}
+
+// NeedsInstanceMetadata reports whether the secret format publishes details of the service instance
+func NeedsInstanceMetadata(format *v1alpha1.SecretFormat) bool {
+	return format != nil && format.Type != "" && format.Type != v1alpha1.SecretFormatFlat && format.Type != v1alpha1.SecretFormatJSON
+}
+
+// FormatConnectionDetails brings the credentials of a binding into the layout of the given secret format, defaulting to Flat.
+// It accepts the flattened details of the terraform backend as well as the credentials document of the native backend.
+func FormatConnectionDetails(format *v1alpha1.SecretFormat, details map[string][]byte, md BindingMetadata) (map[string][]byte, error) {
+	if len(details) == 0 {
+		return details, nil
+	}
+	credentials, err := credentialsOf(details)
+	if err != nil {
+		return nil, err
+	}
+
+	formatType := v1alpha1.SecretFormatFlat
+	if format != nil && format.Type != "" {
+		formatType = format.Type
+	}
+	switch formatType {
+	case v1alpha1.SecretFormatFlat:
+		return flatCredentials(credentials)
+	case v1alpha1.SecretFormatJSON:
+		return marshalKey(secretKeyCredentials, credentials)
+	case v1alpha1.SecretFormatServiceOperator:
+		return serviceOperatorCredentials(credentials, md)
+	case v1alpha1.SecretFormatVCAP:
+		return vcapCredentials(credentials, md)
+	case v1alpha1.SecretFormatTemplate:
+		return templateCredentials(format.Templates, credentials, md)
+	}
+	return nil, errors.Errorf(errUnknownSecretFormat, formatType)
+}
+
+// credentialsOf restores the credentials document from the connection details
+func credentialsOf(details map[string][]byte) (map[string]any, error) {
+	if raw, ok := details[connectionDetailsCredentials]; ok {
+		credentials := map[string]any{}
+		if err := json.Unmarshal(raw, &credentials); err != nil {
+			return nil, errors.Wrap(err, errUnmarshalCredentials)
+		}
+		return credentials, nil
+	}
+	credentials := make(map[string]any, len(details))
+	for k, v := range details {
+		credentials[k] = string(v)
+		// flattened values keep nested objects and lists as JSON, plain values stay strings
+		if trimmed := strings.TrimSpace(string(v)); strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+			var nested any
+			if err := json.Unmarshal(v, &nested); err == nil {
+				credentials[k] = nested
+			}
+		}
+	}
+	return credentials, nil
+}
+
+func flatCredentials(credentials map[string]any) (map[string][]byte, error) {
+	result := make(map[string][]byte, len(credentials))
+	for k, v := range credentials {
+		if s, ok := v.(string); ok {
+			result[k] = []byte(s)
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrap(err, errMarshalCredentials)
+		}
+		result[k] = b
+	}
+	return result, nil
+}
+
+func marshalKey(key string, value any) (map[string][]byte, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalCredentials)
+	}
+	return map[string][]byte{key: b}, nil
+}
+
+type secretProperty struct {
+	Name   string `json:"name"`
+	Format string `json:"format"`
+}
+
+// serviceOperatorCredentials follows the default secret layout of the SAP BTP service operator,
+// every credential as its own key plus instance metadata and a ".metadata" key describing both
+func serviceOperatorCredentials(credentials map[string]any, md BindingMetadata) (map[string][]byte, error) {
+	result, err := flatCredentials(credentials)
+	if err != nil {
+		return nil, err
+	}
+	metadata := map[string]string{
+		"instance_name": md.InstanceName,
+		"instance_guid": md.InstanceID,
+		"plan":          md.Plan,
+		"label":         md.Offering,
+		"type":          md.Offering,
+	}
+	for k, v := range metadata {
+		result[k] = []byte(v)
+	}
+
+	properties := struct {
+		MetaDataProperties   []secretProperty `json:"metaDataProperties"`
+		CredentialProperties []secretProperty `json:"credentialProperties"`
+	}{
+		MetaDataProperties:   properties(metadata),
+		CredentialProperties: properties(credentials),
+	}
+	if result[secretKeyMetadata], err = json.Marshal(properties); err != nil {
+		return nil, errors.Wrap(err, errMarshalCredentials)
+	}
+	return result, nil
+}
+
+// properties lists the keys of a map sorted by name along with their format in the secret
+func properties[V any](values map[string]V) []secretProperty {
+	props := make([]secretProperty, 0, len(values))
+	for k, v := range values {
+		format := "json"
+		if _, ok := any(v).(string); ok {
+			format = "text"
+		}
+		props = append(props, secretProperty{Name: k, Format: format})
+	}
+	sort.Slice(props, func(i, j int) bool { return props[i].Name < props[j].Name })
+	return props
+}
+
+func vcapCredentials(credentials map[string]any, md BindingMetadata) (map[string][]byte, error) {
+	label := md.Offering
+	if label == "" {
+		label = vcapDefaultLabel
+	}
+	return marshalKey(secretKeyVcap, map[string][]map[string]any{
+		label: {{
+			"name":          md.BindingName,
+			"binding_name":  md.BindingName,
+			"binding_guid":  md.BindingID,
+			"instance_name": md.InstanceName,
+			"instance_guid": md.InstanceID,
+			"label":         label,
+			"plan":          md.Plan,
+			"tags":          []string{},
+			"credentials":   credentials,
+		}},
+	})
+}
+
+func templateCredentials(templates map[string]string, credentials map[string]any, md BindingMetadata) (map[string][]byte, error) {
+	data := map[string]any{
+		"credentials": credentials,
+		"binding": map[string]string{
+			"bindingName":  md.BindingName,
+			"bindingId":    md.BindingID,
+			"instanceName": md.InstanceName,
+			"instanceId":   md.InstanceID,
+			"offering":     md.Offering,
+			"plan":         md.Plan,
+		},
+	}
+	funcs := template.FuncMap{"toJson": toJson}
+
+	result := make(map[string][]byte, len(templates))
+	for key, text := range templates {
+		tmpl, err := template.New(key).Funcs(funcs).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, errors.Wrapf(err, errRenderTemplate, key)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, errors.Wrapf(err, errRenderTemplate, key)
+		}
+		result[key] = buf.Bytes()
+	}
+	return result, nil
+}
+
+func toJson(v any) (string, error) {
+	b, err := json.Marshal(v)
+	return string(b), err
+}
@@ -0,0 +1,113 @@
+package servicebindingclient
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+)
+
+func TestFormatConnectionDetails(t *testing.T) {
+	md := BindingMetadata{
+		BindingName:  "binding",
+		BindingID:    "binding-id",
+		InstanceName: "instance",
+		InstanceID:   "instance-id",
+		Offering:     "destination",
+		Plan:         "lite",
+	}
+	flattened := map[string][]byte{
+		"clientid": []byte("id"),
+		"uaa":      []byte(`{"url":"https://uaa"}`),
+	}
+	native := map[string][]byte{
+		connectionDetailsCredentials: []byte(`{"clientid":"id","uaa":{"url":"https://uaa"}}`),
+	}
+
+	tests := map[string]struct {
+		format  *v1alpha1.SecretFormat
+		details map[string][]byte
+		want    map[string][]byte
+		wantErr bool
+	}{
+		"Empty": {
+			details: map[string][]byte{},
+			want:    map[string][]byte{},
+		},
+		"DefaultFlat": {
+			details: flattened,
+			want:    flattened,
+		},
+		"FlatFromNative": {
+			format:  &v1alpha1.SecretFormat{Type: v1alpha1.SecretFormatFlat},
+			details: native,
+			want:    flattened,
+		},
+		"JSON": {
+			format:  &v1alpha1.SecretFormat{Type: v1alpha1.SecretFormatJSON},
+			details: flattened,
+			want:    map[string][]byte{"credentials": []byte(`{"clientid":"id","uaa":{"url":"https://uaa"}}`)},
+		},
+		"ServiceOperator": {
+			format:  &v1alpha1.SecretFormat{Type: v1alpha1.SecretFormatServiceOperator},
+			details: native,
+			want: map[string][]byte{
+				"clientid":      []byte("id"),
+				"uaa":           []byte(`{"url":"https://uaa"}`),
+				"instance_name": []byte("instance"),
+				"instance_guid": []byte("instance-id"),
+				"plan":          []byte("lite"),
+				"label":         []byte("destination"),
+				"type":          []byte("destination"),
+				".metadata": []byte(`{"metaDataProperties":[{"name":"instance_guid","format":"text"},{"name":"instance_name","format":"text"},{"name":"label","format":"text"},{"name":"plan","format":"text"},{"name":"type","format":"text"}],` +
+					`"credentialProperties":[{"name":"clientid","format":"text"},{"name":"uaa","format":"json"}]}`),
+			},
+		},
+		"VCAP": {
+			format:  &v1alpha1.SecretFormat{Type: v1alpha1.SecretFormatVCAP},
+			details: flattened,
+			want: map[string][]byte{
+				"VCAP_SERVICES": []byte(`{"destination":[{"binding_guid":"binding-id","binding_name":"binding","credentials":{"clientid":"id","uaa":{"url":"https://uaa"}},` +
+					`"instance_guid":"instance-id","instance_name":"instance","label":"destination","name":"binding","plan":"lite","tags":[]}]}`),
+			},
+		},
+		"Template": {
+			format: &v1alpha1.SecretFormat{Type: v1alpha1.SecretFormatTemplate, Templates: map[string]string{
+				"CLIENT_ID": "{{ .credentials.clientid }}",
+				"uaa.json":  "{{ .credentials.uaa | toJson }}",
+				"name":      "{{ .binding.instanceName }}/{{ .binding.bindingName }}",
+			}},
+			details: native,
+			want: map[string][]byte{
+				"CLIENT_ID": []byte("id"),
+				"uaa.json":  []byte(`{"url":"https://uaa"}`),
+				"name":      []byte("instance/binding"),
+			},
+		},
+		"TemplateMissingKey": {
+			format:  &v1alpha1.SecretFormat{Type: v1alpha1.SecretFormatTemplate, Templates: map[string]string{"secret": "{{ .credentials.clientsecret }}"}},
+			details: flattened,
+			wantErr: true,
+		},
+		"TemplateInvalid": {
+			format:  &v1alpha1.SecretFormat{Type: v1alpha1.SecretFormatTemplate, Templates: map[string]string{"secret": "{{ .credentials"}},
+			details: flattened,
+			wantErr: true,
+		},
+		"InvalidNativeCredentials": {
+			details: map[string][]byte{connectionDetailsCredentials: []byte("no json")},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := FormatConnectionDetails(tc.format, tc.details, md)
+			if tc.wantErr != (err != nil) {
+				t.Errorf("FormatConnectionDetails() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nFormatConnectionDetails(): -want, +got:\n", diff)
+			}
+		})
+	}
+}
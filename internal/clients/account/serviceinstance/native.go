@@ -0,0 +1,212 @@
+package serviceinstanceclient
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/pkg/errors"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
+	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
+	smapi "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errLoadSmSecret   = "cannot load service manager secret"
+	errInitSmClient   = "cannot initialize service manager client"
+	errBuildParameter = "cannot build service instance parameters"
+	errGetParameters  = "cannot get service instance parameters"
+)
+
+type NewNativeClientFn func(ctx context.Context, secretData map[string][]byte) (servicemanager.NativeClientI, error)
+type LoadSecretFn func(kube client.Client, ctx context.Context, secretName, secretNamespace string) (map[string][]byte, error)
+
+// NewNativeServiceInstanceConnector creates a connector that manages service instances directly via the service manager API
+func NewNativeServiceInstanceConnector(kube client.Client, newClientFn NewNativeClientFn, loadSecretFn LoadSecretFn) tfclient.TfProxyConnectorI[*v1alpha1.ServiceInstance] {
+	return &NativeServiceInstanceConnector{
+		kube:         kube,
+		newClientFn:  newClientFn,
+		loadSecretFn: loadSecretFn,
+	}
+}
+
+type NativeServiceInstanceConnector struct {
+	kube         client.Client
+	newClientFn  NewNativeClientFn
+	loadSecretFn LoadSecretFn
+}
+
+func (n *NativeServiceInstanceConnector) Connect(ctx context.Context, si *v1alpha1.ServiceInstance) (tfclient.TfProxyControllerI, error) {
+	secretData, err := n.loadSecretFn(n.kube, ctx, si.Spec.ForProvider.ServiceManagerSecret, si.Spec.ForProvider.ServiceManagerSecretNamespace)
+	if err != nil {
+		return nil, errors.Wrap(err, errLoadSmSecret)
+	}
+	smClient, err := n.newClientFn(ctx, secretData)
+	if err != nil {
+		return nil, errors.Wrap(err, errInitSmClient)
+	}
+	return &NativeServiceInstanceController{client: smClient, kube: n.kube, cr: si}, nil
+}
+
+var _ tfclient.TfProxyControllerI = &NativeServiceInstanceController{}
+
+// NativeServiceInstanceController provides the lifecycle of a single service instance via the service manager API.
+// Operations are triggered asynchronously, Observe polls their state until they are finished.
+type NativeServiceInstanceController struct {
+	client servicemanager.NativeClientI
+	kube   client.Client
+	cr     *v1alpha1.ServiceInstance
+
+	// observed external state, set by Observe
+	instance  *smapi.ServiceInstanceResponseObject
+	operation *smapi.OperationResponseObject
+}
+
+func (n *NativeServiceInstanceController) Observe(ctx context.Context) (tfclient.Status, map[string][]byte, error) {
+	instance, err := n.lookup(ctx)
+	if err != nil {
+		return tfclient.Unknown, nil, err
+	}
+	if instance == nil {
+		return tfclient.NotExisting, nil, nil
+	}
+	op, err := n.client.CurrentOperation(ctx, servicemanager.ResourceTypeServiceInstances, internal.Val(instance.Id), instance.LastOperation)
+	if err != nil {
+		return tfclient.Unknown, nil, err
+	}
+	n.instance, n.operation = instance, op
+
+	switch servicemanager.OperationState(op) {
+	case servicemanager.OperationStateInProgress:
+		return tfclient.UpToDate, map[string][]byte{}, nil
+	case servicemanager.OperationStateFailed:
+		// a failed update is retried, a failed creation needs attention unless the instance is about to be deleted anyway
+		if internal.Val(op.Type) == servicemanager.OperationTypeUpdate {
+			return tfclient.Drift, map[string][]byte{}, nil
+		}
+		if !meta.WasDeleted(n.cr) {
+			return tfclient.Unknown, nil, servicemanager.OperationError(op)
+		}
+	}
+
+	if meta.WasDeleted(n.cr) {
+		return tfclient.UpToDate, map[string][]byte{}, nil
+	}
+	drift, err := n.parameterDrift(ctx)
+	if err != nil {
+		return tfclient.Unknown, nil, err
+	}
+	// persisted along with the rest of the status by the managed reconciler
+	n.cr.Status.AtProvider.ParameterDrift = drift
+
+	if len(drift) > 0 || n.needsUpdate(instance) {
+		return tfclient.Drift, map[string][]byte{}, nil
+	}
+	return tfclient.UpToDate, map[string][]byte{}, nil
+}
+
+func (n *NativeServiceInstanceController) Create(ctx context.Context) error {
+	payload, err := n.payload(ctx)
+	if err != nil {
+		return err
+	}
+	id, err := n.client.CreateInstance(ctx, payload)
+	if err != nil {
+		return err
+	}
+	// the id is persisted as external name by the managed reconciler right after creation
+	meta.SetExternalName(n.cr, id)
+	return nil
+}
+
+func (n *NativeServiceInstanceController) Update(ctx context.Context) error {
+	if n.instance == nil || servicemanager.OperationState(n.operation) == servicemanager.OperationStateInProgress {
+		return nil
+	}
+	if n.sharingChanged(n.instance) {
+		// sharing can't be combined with other changes, those follow with the next update
+		return n.client.UpdateInstance(ctx, internal.Val(n.instance.Id), servicemanager.InstancePayload{Shared: n.cr.Spec.ForProvider.Shared})
+	}
+	payload, err := n.payload(ctx)
+	if err != nil {
+		return err
+	}
+	return n.client.UpdateInstance(ctx, internal.Val(n.instance.Id), payload)
+}
+
+func (n *NativeServiceInstanceController) Delete(ctx context.Context) error {
+	if n.instance == nil || servicemanager.OperationState(n.operation) == servicemanager.OperationStateInProgress {
+		return nil
+	}
+	return n.client.DeleteInstance(ctx, internal.Val(n.instance.Id))
+}
+
+// QueryAsyncData returns the relevant status data once the instance is ready
+func (n *NativeServiceInstanceController) QueryAsyncData(ctx context.Context) *tfclient.ObservationData {
+	if n.instance == nil || !internal.Val(n.instance.Ready) || servicemanager.OperationState(n.operation) == servicemanager.OperationStateInProgress {
+		return nil
+	}
+	id := internal.Val(n.instance.Id)
+	return &tfclient.ObservationData{
+		ExternalName: id,
+		ID:           id,
+		Conditions:   []xpv1.Condition{xpv1.Available()},
+	}
+}
+
+// lookup finds the instance by its id, as long as it hasn't been created yet the external name still defaults to the CR name and we look it up by name
+func (n *NativeServiceInstanceController) lookup(ctx context.Context) (*smapi.ServiceInstanceResponseObject, error) {
+	if id := meta.GetExternalName(n.cr); id != "" && id != n.cr.GetName() {
+		return n.client.GetInstance(ctx, id)
+	}
+	return n.client.FindInstance(ctx, n.cr.Spec.ForProvider.Name)
+}
+
+func (n *NativeServiceInstanceController) needsUpdate(instance *smapi.ServiceInstanceResponseObject) bool {
+	if internal.Val(instance.Name) != n.cr.Spec.ForProvider.Name || n.sharingChanged(instance) {
+		return true
+	}
+	planID := n.cr.Status.AtProvider.ServiceplanID
+	return planID != "" && internal.Val(instance.ServicePlanId) != planID
+}
+
+// parameterDrift compares the parameters of the instance with the ones in the spec
+func (n *NativeServiceInstanceController) parameterDrift(ctx context.Context) ([]string, error) {
+	actual, err := n.client.GetParameters(ctx, servicemanager.ResourceTypeServiceInstances, internal.Val(n.instance.Id))
+	if err != nil {
+		return nil, errors.Wrap(err, errGetParameters)
+	}
+	if actual == nil {
+		return nil, nil
+	}
+	parameterJson, err := InstanceParameterJson(ctx, n.kube, n.cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errBuildParameter)
+	}
+	drift, err := ParameterJsonDrift(parameterJson, actual)
+	return drift, errors.Wrap(err, errBuildParameter)
+}
+
+func (n *NativeServiceInstanceController) sharingChanged(instance *smapi.ServiceInstanceResponseObject) bool {
+	shared := n.cr.Spec.ForProvider.Shared
+	return shared != nil && *shared != internal.Val(instance.Shared)
+}
+
+func (n *NativeServiceInstanceController) payload(ctx context.Context) (servicemanager.InstancePayload, error) {
+	parameterJson, err := InstanceParameterJson(ctx, n.kube, n.cr)
+	if err != nil {
+		return servicemanager.InstancePayload{}, errors.Wrap(err, errBuildParameter)
+	}
+	parameters, err := servicemanager.RawParameters(parameterJson)
+	if err != nil {
+		return servicemanager.InstancePayload{}, errors.Wrap(err, errBuildParameter)
+	}
+	return servicemanager.InstancePayload{
+		Name:          n.cr.Spec.ForProvider.Name,
+		ServicePlanID: n.cr.Status.AtProvider.ServiceplanID,
+		Parameters:    parameters,
+	}, nil
+}
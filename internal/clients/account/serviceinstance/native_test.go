@@ -0,0 +1,313 @@
+package serviceinstanceclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
+	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
+	smapi "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestNativeConnect(t *testing.T) {
+	tests := map[string]struct {
+		loadSecretFn LoadSecretFn
+		newClientFn  NewNativeClientFn
+		wantErr      bool
+	}{
+		"SecretError": {
+			loadSecretFn: func(kube client.Client, ctx context.Context, secretName, secretNamespace string) (map[string][]byte, error) {
+				return nil, errors.New("secret error")
+			},
+			wantErr: true,
+		},
+		"ClientError": {
+			loadSecretFn: func(kube client.Client, ctx context.Context, secretName, secretNamespace string) (map[string][]byte, error) {
+				return map[string][]byte{}, nil
+			},
+			newClientFn: func(ctx context.Context, secretData map[string][]byte) (servicemanager.NativeClientI, error) {
+				return nil, errors.New("client error")
+			},
+			wantErr: true,
+		},
+		"Success": {
+			loadSecretFn: func(kube client.Client, ctx context.Context, secretName, secretNamespace string) (map[string][]byte, error) {
+				return map[string][]byte{}, nil
+			},
+			newClientFn: func(ctx context.Context, secretData map[string][]byte) (servicemanager.NativeClientI, error) {
+				return &servicemanager.NativeClientFake{}, nil
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := NewNativeServiceInstanceConnector(nil, tc.newClientFn, tc.loadSecretFn)
+			ctrl, err := c.Connect(context.TODO(), expectedServiceInstance())
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Connect() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr != (ctrl == nil) {
+				t.Errorf("Connect() unexpected controller %v", ctrl)
+			}
+		})
+	}
+}
+
+func TestNativeObserve(t *testing.T) {
+	tests := map[string]struct {
+		cr         *v1alpha1.ServiceInstance
+		client     *servicemanager.NativeClientFake
+		wantStatus tfclient.Status
+		wantData   *tfclient.ObservationData
+		wantErr    bool
+		wantLookup string
+		wantDrift  []string
+	}{
+		"LookupError": {
+			cr:         nativeInstance(),
+			client:     &servicemanager.NativeClientFake{Err: errors.New("api error")},
+			wantStatus: tfclient.Unknown,
+			wantErr:    true,
+			wantLookup: "name:si-name",
+		},
+		"NotExisting": {
+			cr:         nativeInstance(),
+			client:     &servicemanager.NativeClientFake{},
+			wantStatus: tfclient.NotExisting,
+			wantLookup: "name:si-name",
+		},
+		"LookupByID": {
+			cr:         nativeInstance(withExternalName("instance-id")),
+			client:     &servicemanager.NativeClientFake{},
+			wantStatus: tfclient.NotExisting,
+			wantLookup: "id:instance-id",
+		},
+		"InProgress": {
+			cr: nativeInstance(withExternalName("instance-id")),
+			client: &servicemanager.NativeClientFake{
+				Instance:  smInstance(false, "plan-id"),
+				Operation: smOperation(servicemanager.OperationTypeCreate, servicemanager.OperationStateInProgress),
+			},
+			wantStatus: tfclient.UpToDate,
+			wantLookup: "id:instance-id",
+		},
+		"CreateFailed": {
+			cr: nativeInstance(withExternalName("instance-id")),
+			client: &servicemanager.NativeClientFake{
+				Instance:  smInstance(false, "plan-id"),
+				Operation: smOperation(servicemanager.OperationTypeCreate, servicemanager.OperationStateFailed),
+			},
+			wantStatus: tfclient.Unknown,
+			wantErr:    true,
+			wantLookup: "id:instance-id",
+		},
+		"CreateFailedWhileDeleting": {
+			cr: nativeInstance(withExternalName("instance-id"), withDeletionTimestamp()),
+			client: &servicemanager.NativeClientFake{
+				Instance:  smInstance(false, "plan-id"),
+				Operation: smOperation(servicemanager.OperationTypeCreate, servicemanager.OperationStateFailed),
+			},
+			wantStatus: tfclient.UpToDate,
+			wantLookup: "id:instance-id",
+		},
+		"UpdateFailed": {
+			cr: nativeInstance(withExternalName("instance-id")),
+			client: &servicemanager.NativeClientFake{
+				Instance:  smInstance(true, "plan-id"),
+				Operation: smOperation(servicemanager.OperationTypeUpdate, servicemanager.OperationStateFailed),
+			},
+			wantStatus: tfclient.Drift,
+			wantData:   &tfclient.ObservationData{ExternalName: "instance-id", ID: "instance-id", Conditions: []xpv1.Condition{xpv1.Available()}},
+			wantLookup: "id:instance-id",
+		},
+		"PlanChanged": {
+			cr: nativeInstance(withExternalName("instance-id")),
+			client: &servicemanager.NativeClientFake{
+				Instance: smInstance(true, "other-plan-id"),
+			},
+			wantStatus: tfclient.Drift,
+			wantData:   &tfclient.ObservationData{ExternalName: "instance-id", ID: "instance-id", Conditions: []xpv1.Condition{xpv1.Available()}},
+			wantLookup: "id:instance-id",
+		},
+		"ParameterDrift": {
+			cr: nativeInstance(withExternalName("instance-id"), withParameters(`{"size": 2}`)),
+			client: &servicemanager.NativeClientFake{
+				Instance:   smInstance(true, "plan-id"),
+				Parameters: map[string]interface{}{"size": float64(1)},
+			},
+			wantStatus: tfclient.Drift,
+			wantDrift:  []string{"size: changed"},
+			wantData:   &tfclient.ObservationData{ExternalName: "instance-id", ID: "instance-id", Conditions: []xpv1.Condition{xpv1.Available()}},
+			wantLookup: "id:instance-id",
+		},
+		"ParametersUpToDate": {
+			cr: nativeInstance(withExternalName("instance-id"), withParameters(`{"size": 1}`)),
+			client: &servicemanager.NativeClientFake{
+				Instance:   smInstance(true, "plan-id"),
+				Parameters: map[string]interface{}{"size": float64(1), "region": "eu10"},
+			},
+			wantStatus: tfclient.UpToDate,
+			wantData:   &tfclient.ObservationData{ExternalName: "instance-id", ID: "instance-id", Conditions: []xpv1.Condition{xpv1.Available()}},
+			wantLookup: "id:instance-id",
+		},
+		"SharingChanged": {
+			cr: nativeInstance(withExternalName("instance-id"), withShared(true)),
+			client: &servicemanager.NativeClientFake{
+				Instance: smInstance(true, "plan-id"),
+			},
+			wantStatus: tfclient.Drift,
+			wantData:   &tfclient.ObservationData{ExternalName: "instance-id", ID: "instance-id", Conditions: []xpv1.Condition{xpv1.Available()}},
+			wantLookup: "id:instance-id",
+		},
+		"UpToDate": {
+			cr: nativeInstance(withExternalName("instance-id")),
+			client: &servicemanager.NativeClientFake{
+				Instance:  smInstance(true, "plan-id"),
+				Operation: smOperation(servicemanager.OperationTypeCreate, servicemanager.OperationStateSucceeded),
+			},
+			wantStatus: tfclient.UpToDate,
+			wantData:   &tfclient.ObservationData{ExternalName: "instance-id", ID: "instance-id", Conditions: []xpv1.Condition{xpv1.Available()}},
+			wantLookup: "id:instance-id",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			n := &NativeServiceInstanceController{client: tc.client, cr: tc.cr}
+			status, _, err := n.Observe(context.TODO())
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Observe() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if status != tc.wantStatus {
+				t.Errorf("Observe() status = %v, want %v", status, tc.wantStatus)
+			}
+			if diff := cmp.Diff(tc.wantData, n.QueryAsyncData(context.TODO())); diff != "" {
+				t.Errorf("\n%s\nQueryAsyncData(): -want, +got:\n", diff)
+			}
+			lookup := "id:" + tc.client.LookedUpID
+			if tc.client.LookedUpName != "" {
+				lookup = "name:" + tc.client.LookedUpName
+			}
+			if lookup != tc.wantLookup {
+				t.Errorf("Observe() looked up %v, want %v", lookup, tc.wantLookup)
+			}
+			if diff := cmp.Diff(tc.wantDrift, tc.cr.Status.AtProvider.ParameterDrift); diff != "" {
+				t.Errorf("\n%s\nObserve(): -want drift, +got drift:\n", diff)
+			}
+		})
+	}
+}
+
+func TestNativeCreate(t *testing.T) {
+	fake := &servicemanager.NativeClientFake{CreatedID: "instance-id"}
+	cr := nativeInstance(withParameters(`{"key": "value"}`))
+	n := &NativeServiceInstanceController{client: fake, cr: cr}
+
+	if err := n.Create(context.TODO()); err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
+
+	wantPayload := &servicemanager.InstancePayload{Name: "si-name", ServicePlanID: "plan-id", Parameters: json.RawMessage(`{"key":"value"}`)}
+	if diff := cmp.Diff(wantPayload, fake.InstancePayload); diff != "" {
+		t.Errorf("\n%s\nCreate(): -want payload, +got payload:\n", diff)
+	}
+	if got := meta.GetExternalName(cr); got != "instance-id" {
+		t.Errorf("Create() external name = %v, want instance-id", got)
+	}
+}
+
+func TestNativeUpdateAndDelete(t *testing.T) {
+	tests := map[string]struct {
+		client    *servicemanager.NativeClientFake
+		wantCalls bool
+	}{
+		"NotObserved": {
+			client: &servicemanager.NativeClientFake{},
+		},
+		"InProgress": {
+			client: &servicemanager.NativeClientFake{
+				Instance:  smInstance(false, "plan-id"),
+				Operation: smOperation(servicemanager.OperationTypeCreate, servicemanager.OperationStateInProgress),
+			},
+		},
+		"Finished": {
+			client: &servicemanager.NativeClientFake{
+				Instance: smInstance(true, "plan-id"),
+			},
+			wantCalls: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			n := &NativeServiceInstanceController{client: tc.client, cr: nativeInstance(withExternalName("instance-id"))}
+			_, _, _ = n.Observe(context.TODO())
+
+			if err := n.Update(context.TODO()); err != nil {
+				t.Errorf("Update() unexpected error = %v", err)
+			}
+			if err := n.Delete(context.TODO()); err != nil {
+				t.Errorf("Delete() unexpected error = %v", err)
+			}
+			if tc.wantCalls != (tc.client.UpdatedID == "instance-id") {
+				t.Errorf("Update() called with %q, want call %v", tc.client.UpdatedID, tc.wantCalls)
+			}
+			if tc.wantCalls != (tc.client.DeletedID == "instance-id") {
+				t.Errorf("Delete() called with %q, want call %v", tc.client.DeletedID, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestNativeUpdateSharing(t *testing.T) {
+	fake := &servicemanager.NativeClientFake{Instance: smInstance(true, "plan-id")}
+	n := &NativeServiceInstanceController{client: fake, cr: nativeInstance(withExternalName("instance-id"), withShared(true), withParameters(`{"key": "value"}`))}
+	_, _, _ = n.Observe(context.TODO())
+
+	if err := n.Update(context.TODO()); err != nil {
+		t.Fatalf("Update() unexpected error = %v", err)
+	}
+	wantPayload := &servicemanager.InstancePayload{Shared: internal.Ptr(true)}
+	if diff := cmp.Diff(wantPayload, fake.InstancePayload); diff != "" {
+		t.Errorf("\n%s\nUpdate(): -want payload, +got payload:\n", diff)
+	}
+}
+
+func nativeInstance(opts ...func(*v1alpha1.ServiceInstance)) *v1alpha1.ServiceInstance {
+	cr := expectedServiceInstance(opts...)
+	cr.SetName("si")
+	cr.Spec.ForProvider.Name = "si-name"
+	cr.Status.AtProvider.ServiceplanID = "plan-id"
+	return cr
+}
+
+func withDeletionTimestamp() func(*v1alpha1.ServiceInstance) {
+	return func(cr *v1alpha1.ServiceInstance) {
+		now := metav1.Now()
+		cr.SetDeletionTimestamp(&now)
+	}
+}
+
+func smInstance(ready bool, planID string) *smapi.ServiceInstanceResponseObject {
+	return &smapi.ServiceInstanceResponseObject{
+		Id:            internal.Ptr("instance-id"),
+		Name:          internal.Ptr("si-name"),
+		ServicePlanId: internal.Ptr(planID),
+		Ready:         internal.Ptr(ready),
+	}
+}
+
+func smOperation(opType string, state string) *smapi.OperationResponseObject {
+	return &smapi.OperationResponseObject{
+		Id:    internal.Ptr("operation-id"),
+		Type:  internal.Ptr(opType),
+		State: internal.Ptr(state),
+	}
+}
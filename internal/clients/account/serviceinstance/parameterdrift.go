@@ -0,0 +1,56 @@
+package serviceinstanceclient
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+const (
+	driftMissing = "missing"
+	driftChanged = "changed"
+)
+
+// ParameterDrift lists the paths of the desired parameters the actual ones differ from, e.g. "database.size: changed".
+// Values are left out since parameters may contain secrets, parameters that are only set on the instance are ignored,
+// since brokers tend to return defaults for everything that hasn't been specified.
+func ParameterDrift(desired, actual map[string]interface{}) []string {
+	var drift []string
+	collectDrift("", desired, actual, &drift)
+	sort.Strings(drift)
+	return drift
+}
+
+// ParameterJsonDrift is ParameterDrift for desired parameters as built by BuildComplexParameterJson
+func ParameterJsonDrift(parameterJson []byte, actual map[string]interface{}) ([]string, error) {
+	desired := map[string]interface{}{}
+	if err := json.Unmarshal(parameterJson, &desired); err != nil {
+		return nil, err
+	}
+	return ParameterDrift(desired, actual), nil
+}
+
+func collectDrift(prefix string, desired, actual map[string]interface{}, drift *[]string) {
+	for key, want := range desired {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		got, ok := actual[key]
+		if !ok {
+			if want != nil {
+				*drift = append(*drift, path+": "+driftMissing)
+			}
+			continue
+		}
+		wantMap, wantIsMap := want.(map[string]interface{})
+		gotMap, gotIsMap := got.(map[string]interface{})
+		if wantIsMap && gotIsMap {
+			collectDrift(path, wantMap, gotMap, drift)
+			continue
+		}
+		if !reflect.DeepEqual(want, got) {
+			*drift = append(*drift, path+": "+driftChanged)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package serviceinstanceclient
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParameterDrift(t *testing.T) {
+	tests := map[string]struct {
+		desired map[string]interface{}
+		actual  map[string]interface{}
+		want    []string
+	}{
+		"Equal": {
+			desired: map[string]interface{}{"size": float64(1), "db": map[string]interface{}{"name": "db"}},
+			actual:  map[string]interface{}{"size": float64(1), "db": map[string]interface{}{"name": "db"}},
+		},
+		"IgnoresDefaults": {
+			desired: map[string]interface{}{"size": float64(1)},
+			actual:  map[string]interface{}{"size": float64(1), "region": "eu10"},
+		},
+		"IgnoresNull": {
+			desired: map[string]interface{}{"size": nil},
+			actual:  map[string]interface{}{},
+		},
+		"Changed": {
+			desired: map[string]interface{}{"size": float64(2), "db": map[string]interface{}{"name": "db", "password": "secret"}, "tags": []interface{}{"a"}},
+			actual:  map[string]interface{}{"size": float64(1), "db": map[string]interface{}{"name": "db", "password": "other"}, "tags": []interface{}{"a", "b"}},
+			want:    []string{"db.password: changed", "size: changed", "tags: changed"},
+		},
+		"Missing": {
+			desired: map[string]interface{}{"db": map[string]interface{}{"name": "db"}},
+			actual:  map[string]interface{}{"db": map[string]interface{}{}},
+			want:    []string{"db.name: missing"},
+		},
+		"TypeChanged": {
+			desired: map[string]interface{}{"db": map[string]interface{}{"name": "db"}},
+			actual:  map[string]interface{}{"db": "db"},
+			want:    []string{"db: changed"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ParameterDrift(tc.desired, tc.actual)); diff != "" {
+				t.Errorf("\n%s\nParameterDrift(): -want, +got:\n", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// referencedInstanceParameter is the parameter reference instances are pointed to their shared instance with
+const referencedInstanceParameter = "referenced_instance_id"
+
 // NewServiceInstanceConnector creates a connector for the service instance client using the generic TfProxyConnector
 func NewServiceInstanceConnector(saveConditionsCallback tfclient.SaveConditionsFn, kube client.Client) tfclient.TfProxyConnectorI[*v1alpha1.ServiceInstance] {
 	con := &ServiceInstanceConnector{
@@ -49,7 +52,7 @@ func (s *ServiceInstanceMapper) TfResource(ctx context.Context, si *v1alpha1.Ser
 	sInstance := buildBaseTfResource(si)
 
 	// combine parameters
-	parameterJson, err := BuildComplexParameterJson(ctx, kube, si.Spec.ForProvider.ParameterSecretRefs, si.Spec.ForProvider.Parameters.Raw)
+	parameterJson, err := InstanceParameterJson(ctx, kube, si)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to map tf resource")
 	}
@@ -69,6 +72,24 @@ func (s *ServiceInstanceMapper) TfResource(ctx context.Context, si *v1alpha1.Ser
 	return sInstance, nil
 }
 
+// InstanceParameterJson builds the parameters of the instance, instances referencing a shared instance pass its id as parameter
+func InstanceParameterJson(ctx context.Context, kube client.Client, si *v1alpha1.ServiceInstance) ([]byte, error) {
+	parameterJson, err := BuildComplexParameterJson(ctx, kube, si.Spec.ForProvider.ParameterSecretRefs, si.Spec.ForProvider.Parameters.Raw)
+	if err != nil {
+		return nil, err
+	}
+	referencedID := internal.Val(si.Spec.ForProvider.ReferencedInstanceID)
+	if referencedID == "" {
+		return parameterJson, nil
+	}
+	parameters := map[string]interface{}{}
+	if err := json.Unmarshal(parameterJson, &parameters); err != nil {
+		return nil, err
+	}
+	parameters[referencedInstanceParameter] = referencedID
+	return json.Marshal(parameters)
+}
+
 func BuildComplexParameterJson(ctx context.Context, kube client.Client, secretRefs []xpv1.SecretKeySelector, specParams []byte) ([]byte, error) {
 	// resolve all parameter secret references and merge them into a single map
 	parameterData, err := lookupSecrets(ctx, kube, secretRefs)
@@ -113,6 +134,7 @@ func buildBaseTfResource(si *v1alpha1.ServiceInstance) *v1alpha1.SubaccountServi
 			ForProvider: v1alpha1.SubaccountServiceInstanceParameters{
 				SubaccountID: si.Spec.ForProvider.SubaccountID,
 				Name:         internal.Ptr(si.Spec.ForProvider.Name),
+				Shared:       si.Spec.ForProvider.Shared,
 			},
 			InitProvider: v1alpha1.SubaccountServiceInstanceInitParameters{},
 		},
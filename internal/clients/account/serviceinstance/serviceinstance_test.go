@@ -275,6 +275,46 @@ func TestTfResource(t *testing.T) {
 				),
 			},
 		},
+		"Shared instance": {
+			reason: "Sharing flag should be transferred to the tf resource",
+			args: args{
+				si: expectedServiceInstance(
+					withExternalName("123"),
+					withProviderConfigRef("default"),
+					withShared(true),
+				),
+			},
+			want: want{
+				hasErr: false,
+				tfResource: expectedTfSerivceInstance(
+					withTfExternalName("123"),
+					withTfParameters(`{}`),
+					withTfProviderConfigRef("default"),
+					withTfShared(true),
+					withTfCondition(conditionUnknown),
+				),
+			},
+		},
+		"Reference instance": {
+			reason: "The id of the referenced shared instance should be merged into the parameters",
+			args: args{
+				si: expectedServiceInstance(
+					withParameters(`{"key": "value"}`),
+					withExternalName("123"),
+					withProviderConfigRef("default"),
+					withReferencedInstance("shared-id"),
+				),
+			},
+			want: want{
+				hasErr: false,
+				tfResource: expectedTfSerivceInstance(
+					withTfExternalName("123"),
+					withTfParameters(`{"key":"value","referenced_instance_id":"shared-id"}`),
+					withTfProviderConfigRef("default"),
+					withTfCondition(conditionUnknown),
+				),
+			},
+		},
 		"Without ManagementPolicies": {
 			reason: "Make sure ManagementPolicies transfered to tf resource",
 			args: args{
@@ -436,6 +476,25 @@ func withObservation(obs v1alpha1.ServiceInstanceObservation) func(*v1alpha1.Ser
 	}
 }
 
+func withShared(shared bool) func(*v1alpha1.ServiceInstance) {
+	return func(cr *v1alpha1.ServiceInstance) {
+		cr.Spec.ForProvider.Shared = &shared
+	}
+}
+
+func withTfShared(shared bool) func(*v1alpha1.SubaccountServiceInstance) {
+	return func(cr *v1alpha1.SubaccountServiceInstance) {
+		cr.Spec.ForProvider.Shared = &shared
+	}
+}
+
+func withReferencedInstance(id string) func(*v1alpha1.ServiceInstance) {
+	return func(cr *v1alpha1.ServiceInstance) {
+		cr.Spec.ForProvider.PlanName = v1alpha1.ReferenceInstancePlanName
+		cr.Spec.ForProvider.ReferencedInstanceID = &id
+	}
+}
+
 func withTfServicePlanID(servicePlanID string) func(*v1alpha1.SubaccountServiceInstance) {
 	return func(cr *v1alpha1.SubaccountServiceInstance) {
 		cr.Spec.ForProvider.ServiceplanID = &servicePlanID
@@ -0,0 +1,46 @@
+package availableenvironments
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+)
+
+// Client lists the environments available to a subaccount, it is implemented by btp.Client
+type Client interface {
+	GetAvailableEnvironments(ctx context.Context) ([]provisioningclient.AvailableEnvironmentResponseObject, error)
+}
+
+// GenerateObservation converts the available environments of the given type, all if the type is empty. The list is
+// sorted to keep the status stable across reconciliations.
+func GenerateObservation(available []provisioningclient.AvailableEnvironmentResponseObject, environmentType string) v1alpha1.AvailableEnvironmentsObservation {
+	observation := v1alpha1.AvailableEnvironmentsObservation{}
+	for _, env := range available {
+		if environmentType != "" && internal.Val(env.EnvironmentType) != environmentType {
+			continue
+		}
+		observation.Environments = append(observation.Environments, v1alpha1.AvailableEnvironment{
+			EnvironmentType:                    internal.Val(env.EnvironmentType),
+			ServiceName:                        internal.Val(env.ServiceName),
+			PlanName:                           internal.Val(env.PlanName),
+			LandscapeLabel:                     internal.Val(env.LandscapeLabel),
+			AvailabilityLevel:                  internal.Val(env.AvailabilityLevel),
+			AllowAdditionalEnvironmentInstance: env.AllowAdditionalEnvironmentInstance,
+			PlanUpdatable:                      env.PlanUpdatable,
+		})
+	}
+	sort.SliceStable(observation.Environments, func(i, j int) bool {
+		a, b := observation.Environments[i], observation.Environments[j]
+		if a.EnvironmentType != b.EnvironmentType {
+			return a.EnvironmentType < b.EnvironmentType
+		}
+		if a.PlanName != b.PlanName {
+			return a.PlanName < b.PlanName
+		}
+		return a.LandscapeLabel < b.LandscapeLabel
+	})
+	return observation
+}
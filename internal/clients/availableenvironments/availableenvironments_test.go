@@ -0,0 +1,50 @@
+package availableenvironments
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+)
+
+func TestGenerateObservation(t *testing.T) {
+	available := []provisioningclient.AvailableEnvironmentResponseObject{
+		{EnvironmentType: internal.Ptr("kyma"), ServiceName: internal.Ptr("kymaruntime"), PlanName: internal.Ptr("azure"), CreateSchema: internal.Ptr(`{"type": "object"}`)},
+		{EnvironmentType: internal.Ptr("cloudfoundry"), ServiceName: internal.Ptr("cloudfoundry"), PlanName: internal.Ptr("standard"), LandscapeLabel: internal.Ptr("cf-eu10")},
+		{EnvironmentType: internal.Ptr("kyma"), ServiceName: internal.Ptr("kymaruntime"), PlanName: internal.Ptr("aws"), PlanUpdatable: internal.Ptr(false)},
+	}
+
+	tests := map[string]struct {
+		environmentType string
+		want            v1alpha1.AvailableEnvironmentsObservation
+	}{
+		"All": {
+			want: v1alpha1.AvailableEnvironmentsObservation{Environments: []v1alpha1.AvailableEnvironment{
+				{EnvironmentType: "cloudfoundry", ServiceName: "cloudfoundry", PlanName: "standard", LandscapeLabel: "cf-eu10"},
+				{EnvironmentType: "kyma", ServiceName: "kymaruntime", PlanName: "aws", PlanUpdatable: internal.Ptr(false)},
+				{EnvironmentType: "kyma", ServiceName: "kymaruntime", PlanName: "azure"},
+			}},
+		},
+		"FilteredByType": {
+			environmentType: "cloudfoundry",
+			want: v1alpha1.AvailableEnvironmentsObservation{Environments: []v1alpha1.AvailableEnvironment{
+				{EnvironmentType: "cloudfoundry", ServiceName: "cloudfoundry", PlanName: "standard", LandscapeLabel: "cf-eu10"},
+			}},
+		},
+		"NoneOfType": {
+			environmentType: "abap",
+			want:            v1alpha1.AvailableEnvironmentsObservation{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateObservation(available, tc.environmentType)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
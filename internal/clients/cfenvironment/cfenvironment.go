@@ -2,7 +2,9 @@ package environments
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
 	"github.com/cloudfoundry/go-cfclient/v3/config"
@@ -12,6 +14,7 @@ import (
 
 	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
 	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
 )
 
@@ -22,8 +25,16 @@ const (
 	errRoleUpdateFailed       = "role update failed with status code %d"
 	errLogin                  = "cloud not login to cloud foundry"
 	errClient                 = "cloud not create cf client"
-
-	defaultOrigin = "sap.ids"
+	errUpdateManagers         = "cannot update org managers"
+	errUpdateEnvironment      = "cannot update cloud foundry environment"
+	errUpdateLabels           = "cannot update labels of cloud foundry environment"
+	errImmutableFields        = "%s can't be changed after creation, recreate the environment to apply the change"
+	errEnvironmentNotFound    = "cloud foundry environment not found"
+	errAddManager             = "cannot add org manager %s"
+	errRemoveManager          = "cannot remove org manager %s"
+
+	defaultOrigin         = "sap.ids"
+	instanceNameParameter = "instance_name"
 )
 
 var _ Client = &CloudFoundryOrganization{}
@@ -32,16 +43,206 @@ type CloudFoundryOrganization struct {
 	btp btp.Client
 }
 
-// NeedsUpdate not needed anymore (no reconciliation wanted)
+// NeedsUpdate reports whether plan, org name or custom labels differ from the spec,
+// the org managers are only compared with the Authoritative policy
 func (c CloudFoundryOrganization) NeedsUpdate(cr v1alpha1.CloudFoundryEnvironment) bool {
-	return false
+	return environmentNeedsUpdate(cr) || labelsNeedUpdate(cr) || c.managersNeedUpdate(cr)
 }
 
-// UpdateInstance not needed anymore (no reconciliation wanted)
+func (c CloudFoundryOrganization) managersNeedUpdate(cr v1alpha1.CloudFoundryEnvironment) bool {
+	if cr.Spec.ForProvider.OrgManagersPolicy != v1alpha1.OrgManagersPolicyAuthoritative {
+		return false
+	}
+	add, remove := managerChanges(cr.Spec.ForProvider.OrgManagers, cr.Status.AtProvider.Managers, c.technicalUser())
+	return len(add) > 0 || len(remove) > 0
+}
+
+// UpdateInstance applies plan, org name and custom labels to the environment and, with the Authoritative policy,
+// adds missing and removes superfluous org managers. Landscape and environment name can't be updated.
 func (c CloudFoundryOrganization) UpdateInstance(ctx context.Context, cr v1alpha1.CloudFoundryEnvironment) error {
+	name := meta.GetExternalName(&cr)
+	orgName := formOrgName(cr.Spec.ForProvider.OrgName, cr.Spec.SubaccountGuid, cr.Name)
+	environment, err := c.btp.GetCFEnvironmentByNameAndOrg(ctx, name, orgName)
+	if err != nil {
+		return errors.Wrap(err, errUpdateEnvironment)
+	}
+	if environment == nil || environment.Id == nil {
+		return errors.New(errEnvironmentNotFound)
+	}
+
+	if environmentNeedsUpdate(cr) {
+		planName := cr.Spec.ForProvider.PlanName
+		if planName == "" {
+			planName = internal.Val(environment.PlanName)
+		}
+		// without an org name in the spec the org keeps its current name, which may differ from the default for adopted orgs
+		updatedOrgName := cr.Spec.ForProvider.OrgName
+		if updatedOrgName == "" {
+			updatedOrgName = instanceName(environment.Parameters)
+		}
+		if updatedOrgName == "" {
+			updatedOrgName = orgName
+		}
+		if err := c.btp.UpdateCloudFoundryEnvironment(ctx, *environment.Id, planName, updatedOrgName, string(cr.UID)); err != nil {
+			return errors.Wrap(err, errUpdateEnvironment)
+		}
+	}
+	if labelsNeedUpdate(cr) {
+		if err := c.btp.SetEnvironmentLabels(ctx, *environment.Id, *cr.Spec.ForProvider.Labels); err != nil {
+			return errors.Wrap(err, errUpdateLabels)
+		}
+	}
+	if c.managersNeedUpdate(cr) {
+		if err := c.updateManagers(ctx, environment, cr.Spec.ForProvider.OrgManagers); err != nil {
+			return errors.Wrap(err, errUpdateManagers)
+		}
+	}
+
+	if changes := ImmutableFieldChanges(cr); len(changes) > 0 {
+		return errors.Errorf(errImmutableFields, strings.Join(changes, ", "))
+	}
+	return nil
+}
+
+func (c CloudFoundryOrganization) updateManagers(ctx context.Context, environment *provisioningclient.BusinessEnvironmentInstanceResponseObject, desired []v1alpha1.User) error {
+	cloudFoundryClient, err := c.createClient(environment)
+	if err != nil {
+		return err
+	}
+
+	roles, err := cloudFoundryClient.getManagerRoles(ctx)
+	if err != nil {
+		return err
+	}
+	actual := make([]v1alpha1.User, 0, len(roles))
+	for _, r := range roles {
+		actual = append(actual, r.user)
+	}
+
+	add, remove := managerChanges(desired, actual, c.technicalUser())
+	for _, m := range add {
+		if err := cloudFoundryClient.addManager(ctx, m.Username, originOf(m)); err != nil {
+			return errors.Wrapf(err, errAddManager, m.String())
+		}
+	}
+	for _, m := range remove {
+		for _, r := range roles {
+			if sameUser(r.user, m) {
+				if _, err := cloudFoundryClient.c.Roles.Delete(ctx, r.guid); err != nil {
+					return errors.Wrapf(err, errRemoveManager, m.String())
+				}
+			}
+		}
+	}
 	return nil
 }
 
+// ImmutableFieldChanges lists the fields of the spec that differ from the environment but can't be changed after creation
+func ImmutableFieldChanges(cr v1alpha1.CloudFoundryEnvironment) []string {
+	var changes []string
+	observed := cr.Status.AtProvider
+	if landscape := cr.Spec.ForProvider.Landscape; landscape != "" && observed.LandscapeLabel != nil && *observed.LandscapeLabel != landscape {
+		changes = append(changes, "landscape")
+	}
+	if envName := cr.Spec.ForProvider.EnvironmentName; envName != "" && observed.Name != nil && *observed.Name != envName {
+		changes = append(changes, "environmentName")
+	}
+	return changes
+}
+
+// environmentNeedsUpdate checks plan and org name, both are only compared if set in the spec
+func environmentNeedsUpdate(cr v1alpha1.CloudFoundryEnvironment) bool {
+	observed := cr.Status.AtProvider
+	if planName := cr.Spec.ForProvider.PlanName; planName != "" && observed.PlanName != nil && *observed.PlanName != planName {
+		return true
+	}
+	if orgName := cr.Spec.ForProvider.OrgName; orgName != "" {
+		if observedOrgName := instanceName(observed.Parameters); observedOrgName != "" && observedOrgName != orgName {
+			return true
+		}
+	}
+	return false
+}
+
+// labelsNeedUpdate compares the custom labels, labels are left alone if not set in the spec
+func labelsNeedUpdate(cr v1alpha1.CloudFoundryEnvironment) bool {
+	if cr.Spec.ForProvider.Labels == nil {
+		return false
+	}
+	var observed map[string][]string
+	if cr.Status.AtProvider.CustomLabels != nil {
+		observed = *cr.Status.AtProvider.CustomLabels
+	}
+	return !internal.LabelsEqual(*cr.Spec.ForProvider.Labels, observed)
+}
+
+// instanceName extracts the org name the environment was created or last updated with from its parameters
+func instanceName(parameters *string) string {
+	if parameters == nil {
+		return ""
+	}
+	var parameterList map[string]interface{}
+	if err := json.Unmarshal([]byte(*parameters), &parameterList); err != nil {
+		return ""
+	}
+	name, _ := parameterList[instanceNameParameter].(string)
+	return name
+}
+
+// technicalUser is the user the provider acts as in cloud foundry, it must keep its manager role
+func (c CloudFoundryOrganization) technicalUser() []string {
+	if c.btp.Credential == nil || c.btp.Credential.UserCredential == nil {
+		return nil
+	}
+	return []string{c.btp.Credential.UserCredential.Username, c.btp.Credential.UserCredential.Email}
+}
+
+// managerChanges compares desired with actual managers by username (case-insensitive) and origin,
+// the technical user is never removed even if it is not listed
+func managerChanges(desired []v1alpha1.User, actual []v1alpha1.User, technicalUser []string) (add []v1alpha1.User, remove []v1alpha1.User) {
+	for _, d := range desired {
+		if !containsUser(actual, d) && !containsUser(add, d) {
+			add = append(add, d)
+		}
+	}
+	for _, a := range actual {
+		if containsUser(desired, a) || isTechnicalUser(a, technicalUser) || containsUser(remove, a) {
+			continue
+		}
+		remove = append(remove, a)
+	}
+	return add, remove
+}
+
+func containsUser(users []v1alpha1.User, user v1alpha1.User) bool {
+	for _, u := range users {
+		if sameUser(u, user) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameUser(a v1alpha1.User, b v1alpha1.User) bool {
+	return strings.EqualFold(a.Username, b.Username) && originOf(a) == originOf(b)
+}
+
+func isTechnicalUser(user v1alpha1.User, technicalUser []string) bool {
+	for _, t := range technicalUser {
+		if t != "" && strings.EqualFold(user.Username, t) {
+			return true
+		}
+	}
+	return false
+}
+
+func originOf(user v1alpha1.User) string {
+	if user.Origin == "" {
+		return defaultOrigin
+	}
+	return user.Origin
+}
+
 func NewCloudFoundryOrganization(btp btp.Client) *CloudFoundryOrganization {
 	return &CloudFoundryOrganization{btp: btp}
 }
@@ -112,7 +313,7 @@ func (c CloudFoundryOrganization) CreateInstance(ctx context.Context, cr v1alpha
 	orgName := formOrgName(cr.Spec.ForProvider.OrgName, cr.Spec.SubaccountGuid, cr.Name)
 	org, err := c.btp.CreateCloudFoundryOrgIfNotExists(
 		ctx, cr.Name, adminServiceAccountEmail, string(cr.UID),
-		cr.Spec.ForProvider.Landscape, orgName, cr.Spec.ForProvider.EnvironmentName, 
+		cr.Spec.ForProvider.Landscape, orgName, cr.Spec.ForProvider.EnvironmentName, cr.Spec.ForProvider.PlanName,
 	)
 	if err != nil {
 		return "", errors.Wrap(err, instanceCreateFailed)
@@ -128,10 +329,22 @@ func (c CloudFoundryOrganization) CreateInstance(ctx context.Context, cr v1alpha
 			return "", errors.Wrap(err, instanceCreateFailed)
 		}
 	}
+	if cr.Spec.ForProvider.OrgManagersPolicy == v1alpha1.OrgManagersPolicyAuthoritative {
+		for _, m := range cr.Spec.ForProvider.OrgManagers {
+			if err := cloudFoundryClient.addManager(ctx, m.Username, originOf(m)); err != nil {
+				return "", errors.Wrap(err, instanceCreateFailed)
+			}
+		}
+	}
 
 	return org.Name, nil
 }
 
+// CheckAvailability explains why plan and landscape of the spec can't be used in the subaccount, empty if they can
+func (c CloudFoundryOrganization) CheckAvailability(ctx context.Context, cr v1alpha1.CloudFoundryEnvironment) (string, error) {
+	return c.btp.CheckEnvironmentAvailability(ctx, btp.CloudFoundryEnvironmentType(), btp.CloudFoundryPlanName(cr.Spec.ForProvider.PlanName), cr.Spec.ForProvider.Landscape)
+}
+
 func (c CloudFoundryOrganization) DeleteInstance(ctx context.Context, cr v1alpha1.CloudFoundryEnvironment) error {
 	name := meta.GetExternalName(&cr) 
 	orgName := formOrgName(cr.Spec.ForProvider.OrgName, cr.Spec.SubaccountGuid, cr.Name)
@@ -182,6 +395,40 @@ func (o organizationClient) getManagerUsernames(ctx context.Context) ([]v1alpha1
 	return managers, nil
 }
 
+// managerRole is an Org Manager role assignment together with its user
+type managerRole struct {
+	guid string
+	user v1alpha1.User
+}
+
+func (o organizationClient) getManagerRoles(ctx context.Context) ([]managerRole, error) {
+	listOptions := cfv3.NewRoleListOptions()
+	listOptions.OrganizationGUIDs.EqualTo(o.orgGuid)
+	listOptions.WithOrganizationRoleType(resource.OrganizationRoleManager)
+
+	roles, users, err := o.c.Roles.ListIncludeUsersAll(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	usersByGuid := make(map[string]*resource.User, len(users))
+	for _, u := range users {
+		usersByGuid[u.GUID] = u
+	}
+	managers := make([]managerRole, 0, len(roles))
+	for _, r := range roles {
+		if r.Relationships.User.Data == nil {
+			continue
+		}
+		u, ok := usersByGuid[r.Relationships.User.Data.GUID]
+		if !ok {
+			continue
+		}
+		managers = append(managers, managerRole{guid: r.GUID, user: v1alpha1.User{Username: u.Username, Origin: u.Origin}})
+	}
+	return managers, nil
+}
+
 func newOrganizationClient(organizationName string, url string, orgId string, username string, password string) (
 	*organizationClient, error,
 ) {
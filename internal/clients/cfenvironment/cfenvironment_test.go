@@ -1,15 +1,190 @@
 package environments
 
 import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
 )
 
+func TestNeedsUpdate(t *testing.T) {
+	technical := btp.Client{Credential: &btp.Credentials{UserCredential: &btp.UserCredential{Username: "tech-user", Email: "tech@example.com"}}}
+
+	tests := map[string]struct {
+		client btp.Client
+		cr     v1alpha1.CloudFoundryEnvironment
+		want   bool
+	}{
+		"InitialPolicyIgnoresDrift": {
+			cr:   crWithManagers("", []string{"a@example.com"}, []string{"b@example.com"}),
+			want: false,
+		},
+		"AuthoritativeInSync": {
+			cr:   crWithManagers(v1alpha1.OrgManagersPolicyAuthoritative, []string{"a@example.com"}, []string{"A@example.com"}),
+			want: false,
+		},
+		"AuthoritativeMissingManager": {
+			cr:   crWithManagers(v1alpha1.OrgManagersPolicyAuthoritative, []string{"a@example.com", "b@example.com"}, []string{"a@example.com"}),
+			want: true,
+		},
+		"AuthoritativeSuperfluousManager": {
+			cr:   crWithManagers(v1alpha1.OrgManagersPolicyAuthoritative, []string{"a@example.com"}, []string{"a@example.com", "b@example.com"}),
+			want: true,
+		},
+		"AuthoritativeKeepsTechnicalUser": {
+			client: technical,
+			cr:     crWithManagers(v1alpha1.OrgManagersPolicyAuthoritative, []string{"a@example.com"}, []string{"a@example.com", "tech@example.com"}),
+			want:   false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := NewCloudFoundryOrganization(tc.client).NeedsUpdate(tc.cr)
+			if got != tc.want {
+				t.Errorf("NeedsUpdate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNeedsUpdateEnvironment(t *testing.T) {
+	observed := v1alpha1.CfEnvironmentObservation{
+		EnvironmentObservation: v1alpha1.EnvironmentObservation{
+			PlanName:     internal.Ptr("standard"),
+			Parameters:   internal.Ptr(`{"instance_name":"my-org","status":"active"}`),
+			CustomLabels: &map[string][]string{"team": {"a"}},
+		},
+	}
+
+	tests := map[string]struct {
+		params v1alpha1.CfEnvironmentParameters
+		want   bool
+	}{
+		"NothingSet": {
+			want: false,
+		},
+		"InSync": {
+			params: v1alpha1.CfEnvironmentParameters{PlanName: "standard", OrgName: "my-org", Labels: &map[string][]string{"team": {"a"}}},
+			want:   false,
+		},
+		"PlanChanged": {
+			params: v1alpha1.CfEnvironmentParameters{PlanName: "free"},
+			want:   true,
+		},
+		"OrgRenamed": {
+			params: v1alpha1.CfEnvironmentParameters{OrgName: "other-org"},
+			want:   true,
+		},
+		"LabelChanged": {
+			params: v1alpha1.CfEnvironmentParameters{Labels: &map[string][]string{"team": {"b"}}},
+			want:   true,
+		},
+		"LabelsRemoved": {
+			params: v1alpha1.CfEnvironmentParameters{Labels: &map[string][]string{}},
+			want:   true,
+		},
+		"ImmutableFieldsIgnored": {
+			params: v1alpha1.CfEnvironmentParameters{Landscape: "cf-us10", EnvironmentName: "other"},
+			want:   false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := v1alpha1.CloudFoundryEnvironment{
+				Spec:   v1alpha1.CfEnvironmentSpec{ForProvider: tc.params},
+				Status: v1alpha1.EnvironmentStatus{AtProvider: observed},
+			}
+			got := NewCloudFoundryOrganization(btp.Client{}).NeedsUpdate(cr)
+			if got != tc.want {
+				t.Errorf("NeedsUpdate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestImmutableFieldChanges(t *testing.T) {
+	observed := v1alpha1.CfEnvironmentObservation{
+		EnvironmentObservation: v1alpha1.EnvironmentObservation{
+			LandscapeLabel: internal.Ptr("cf-eu10"),
+			Name:           internal.Ptr("my-env"),
+		},
+	}
+
+	tests := map[string]struct {
+		params v1alpha1.CfEnvironmentParameters
+		want   []string
+	}{
+		"NotSet": {},
+		"Unchanged": {
+			params: v1alpha1.CfEnvironmentParameters{Landscape: "cf-eu10", EnvironmentName: "my-env"},
+		},
+		"BothChanged": {
+			params: v1alpha1.CfEnvironmentParameters{Landscape: "cf-us10", EnvironmentName: "other-env"},
+			want:   []string{"landscape", "environmentName"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := v1alpha1.CloudFoundryEnvironment{
+				Spec:   v1alpha1.CfEnvironmentSpec{ForProvider: tc.params},
+				Status: v1alpha1.EnvironmentStatus{AtProvider: observed},
+			}
+			if diff := cmp.Diff(tc.want, ImmutableFieldChanges(cr)); diff != "" {
+				t.Errorf("ImmutableFieldChanges(): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestManagerChanges(t *testing.T) {
+	tests := map[string]struct {
+		desired    []v1alpha1.User
+		actual     []v1alpha1.User
+		wantAdd    []v1alpha1.User
+		wantRemove []v1alpha1.User
+	}{
+		"DefaultOrigin": {
+			desired: []v1alpha1.User{{Username: "a@example.com"}},
+			actual:  []v1alpha1.User{{Username: "a@example.com", Origin: "sap.ids"}},
+		},
+		"OriginDiffers": {
+			desired:    []v1alpha1.User{{Username: "a@example.com", Origin: "custom-idp"}},
+			actual:     []v1alpha1.User{{Username: "a@example.com", Origin: "sap.ids"}},
+			wantAdd:    []v1alpha1.User{{Username: "a@example.com", Origin: "custom-idp"}},
+			wantRemove: []v1alpha1.User{{Username: "a@example.com", Origin: "sap.ids"}},
+		},
+		"DuplicatesOnlyOnce": {
+			desired: []v1alpha1.User{{Username: "a@example.com"}, {Username: "A@example.com"}},
+			wantAdd: []v1alpha1.User{{Username: "a@example.com"}},
+		},
+		"TechnicalUserNeverRemoved": {
+			actual:     []v1alpha1.User{{Username: "tech-user", Origin: "sap.ids"}, {Username: "b@example.com", Origin: "sap.ids"}},
+			wantRemove: []v1alpha1.User{{Username: "b@example.com", Origin: "sap.ids"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			add, remove := managerChanges(tc.desired, tc.actual, []string{"tech-user", "tech@example.com"})
+			if diff := cmp.Diff(tc.wantAdd, add); diff != "" {
+				t.Errorf("managerChanges() add: -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.wantRemove, remove); diff != "" {
+				t.Errorf("managerChanges() remove: -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
 // crWithManagers returns a CloudFoundryEnvironment CR with the given managers.
-func _(specManagers []string, statusManagers []string) v1alpha1.CloudFoundryEnvironment {
+func crWithManagers(policy string, specManagers []string, statusManagers []string) v1alpha1.CloudFoundryEnvironment {
 	return v1alpha1.CloudFoundryEnvironment{
 		Spec: v1alpha1.CfEnvironmentSpec{
 			ForProvider: v1alpha1.CfEnvironmentParameters{
-				Managers: specManagers,
+				OrgManagersPolicy: policy,
+				OrgManagers:       toUserSlice(specManagers),
 			},
 		},
 		Status: v1alpha1.EnvironmentStatus{
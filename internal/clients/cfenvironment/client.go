@@ -22,6 +22,7 @@ type Client interface {
 	CreateInstance(ctx context.Context, cr v1alpha1.CloudFoundryEnvironment) (string, error)
 	UpdateInstance(ctx context.Context, cr v1alpha1.CloudFoundryEnvironment) error
 	DeleteInstance(ctx context.Context, cr v1alpha1.CloudFoundryEnvironment) error
+	CheckAvailability(ctx context.Context, cr v1alpha1.CloudFoundryEnvironment) (string, error)
 
 	NeedsUpdate(cr v1alpha1.CloudFoundryEnvironment) bool
 }
@@ -0,0 +1,35 @@
+package cloudfoundry
+
+import (
+	"encoding/json"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/config"
+	"github.com/pkg/errors"
+
+	"github.com/sap/crossplane-provider-btp/btp"
+)
+
+const (
+	errParseUserCredential = "cannot parse service account secret"
+	errLogin               = "cannot login to cloud foundry"
+	errClient              = "cannot create cloud foundry client"
+	errNoApiEndpoint       = "missing or empty cloud foundry api endpoint"
+)
+
+// NewClient logs into the cloud foundry api endpoint as the technical user of the provider config
+func NewClient(apiEndpoint string, serviceAccountSecretData []byte) (*cfv3.Client, error) {
+	if apiEndpoint == "" {
+		return nil, errors.New(errNoApiEndpoint)
+	}
+	var credential btp.UserCredential
+	if err := json.Unmarshal(serviceAccountSecretData, &credential); err != nil {
+		return nil, errors.Wrap(err, errParseUserCredential)
+	}
+	cfg, err := config.New(apiEndpoint, config.UserPassword(credential.Username, credential.Password))
+	if err != nil {
+		return nil, errors.Wrap(err, errLogin)
+	}
+	c, err := cfv3.New(cfg)
+	return c, errors.Wrap(err, errClient)
+}
@@ -0,0 +1,260 @@
+package cloudfoundry
+
+import (
+	"context"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/pkg/errors"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+var (
+	internalServerError = errors.New("internal server error")
+	notFoundError       = resource.NewResourceNotFoundError()
+)
+
+// spaceApiFake stubs a single space and records the last write
+type spaceApiFake struct {
+	space *resource.Space
+	err   error
+
+	created *resource.SpaceCreate
+	updated *resource.SpaceUpdate
+	deleted string
+}
+
+var _ spaceAPI = &spaceApiFake{}
+
+func (s *spaceApiFake) Get(ctx context.Context, guid string) (*resource.Space, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.space == nil || s.space.GUID != guid {
+		return nil, notFoundError
+	}
+	return s.space, nil
+}
+
+func (s *spaceApiFake) Single(ctx context.Context, opts *cfv3.SpaceListOptions) (*resource.Space, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.space == nil {
+		return nil, cfv3.ErrNoResultsReturned
+	}
+	return s.space, nil
+}
+
+func (s *spaceApiFake) Create(ctx context.Context, r *resource.SpaceCreate) (*resource.Space, error) {
+	s.created = r
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &resource.Space{Name: r.Name, Resource: resource.Resource{GUID: "created-guid"}}, nil
+}
+
+func (s *spaceApiFake) Update(ctx context.Context, guid string, r *resource.SpaceUpdate) (*resource.Space, error) {
+	s.updated = r
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &resource.Space{Name: r.Name, Resource: resource.Resource{GUID: guid}}, nil
+}
+
+func (s *spaceApiFake) Delete(ctx context.Context, guid string) (string, error) {
+	s.deleted = guid
+	return "job-guid", s.err
+}
+
+// roleApiFake stubs the role assignments and users of a space or org
+type roleApiFake struct {
+	roles []*resource.Role
+	users []*resource.User
+	err   error
+
+	created string
+	deleted string
+}
+
+var _ roleAPI = &roleApiFake{}
+
+func (r *roleApiFake) Get(ctx context.Context, guid string) (*resource.Role, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	for _, role := range r.roles {
+		if role.GUID == guid {
+			return role, nil
+		}
+	}
+	return nil, notFoundError
+}
+
+func (r *roleApiFake) ListIncludeUsersAll(ctx context.Context, opts *cfv3.RoleListOptions) ([]*resource.Role, []*resource.User, error) {
+	return r.roles, r.users, r.err
+}
+
+func (r *roleApiFake) CreateSpaceRoleWithUsername(ctx context.Context, spaceGUID string, userName string, roleType resource.SpaceRoleType, origin string) (*resource.Role, error) {
+	r.created = roleType.String() + ":" + userName + ":" + origin
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &resource.Role{Resource: resource.Resource{GUID: "created-guid"}}, nil
+}
+
+func (r *roleApiFake) CreateOrganizationRoleWithUsername(ctx context.Context, organizationGUID string, userName string, roleType resource.OrganizationRoleType, origin string) (*resource.Role, error) {
+	r.created = roleType.String() + ":" + userName + ":" + origin
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &resource.Role{Resource: resource.Resource{GUID: "created-guid"}}, nil
+}
+
+func (r *roleApiFake) Delete(ctx context.Context, guid string) (string, error) {
+	r.deleted = guid
+	return "job-guid", r.err
+}
+
+func role(guid string, userGuid string) *resource.Role {
+	return &resource.Role{
+		Resource:      resource.Resource{GUID: guid},
+		Relationships: resource.RoleSpaceUserOrganizationRelationships{User: resource.ToOneRelationship{Data: &resource.Relationship{GUID: userGuid}}},
+	}
+}
+
+func user(guid string, username string, origin string) *resource.User {
+	return &resource.User{Username: username, Origin: origin, Resource: resource.Resource{GUID: guid}}
+}
+
+// orgQuotaApiFake stubs the org quota definitions by name and records applies and deletes
+type orgQuotaApiFake struct {
+	quotas []*resource.OrganizationQuota
+	err    error
+
+	created *resource.OrganizationQuotaCreateOrUpdate
+	updated *resource.OrganizationQuotaCreateOrUpdate
+	applied map[string][]string
+	deleted string
+}
+
+var _ organizationQuotaAPI = &orgQuotaApiFake{}
+
+func (q *orgQuotaApiFake) Get(ctx context.Context, guid string) (*resource.OrganizationQuota, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	for _, quota := range q.quotas {
+		if quota.GUID == guid {
+			return quota, nil
+		}
+	}
+	return nil, notFoundError
+}
+
+func (q *orgQuotaApiFake) Single(ctx context.Context, opts *cfv3.OrganizationQuotaListOptions) (*resource.OrganizationQuota, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	for _, quota := range q.quotas {
+		if contains(opts.Names.Values, quota.Name) {
+			return quota, nil
+		}
+	}
+	return nil, cfv3.ErrNoResultsReturned
+}
+
+func (q *orgQuotaApiFake) Create(ctx context.Context, r *resource.OrganizationQuotaCreateOrUpdate) (*resource.OrganizationQuota, error) {
+	q.created = r
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &resource.OrganizationQuota{Name: internal.Val(r.Name), Resource: resource.Resource{GUID: "created-guid"}}, nil
+}
+
+func (q *orgQuotaApiFake) Update(ctx context.Context, guid string, r *resource.OrganizationQuotaCreateOrUpdate) (*resource.OrganizationQuota, error) {
+	q.updated = r
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &resource.OrganizationQuota{Name: internal.Val(r.Name), Resource: resource.Resource{GUID: guid}}, nil
+}
+
+func (q *orgQuotaApiFake) Apply(ctx context.Context, guid string, organizationGUIDs []string) ([]string, error) {
+	if q.applied == nil {
+		q.applied = map[string][]string{}
+	}
+	q.applied[guid] = append(q.applied[guid], organizationGUIDs...)
+	return organizationGUIDs, q.err
+}
+
+func (q *orgQuotaApiFake) Delete(ctx context.Context, guid string) (string, error) {
+	q.deleted = guid
+	return "job-guid", q.err
+}
+
+// spaceQuotaApiFake stubs a single space quota and records applies, removals and deletes
+type spaceQuotaApiFake struct {
+	quota *resource.SpaceQuota
+	err   error
+
+	created *resource.SpaceQuotaCreateOrUpdate
+	updated *resource.SpaceQuotaCreateOrUpdate
+	applied []string
+	removed []string
+	deleted string
+}
+
+var _ spaceQuotaAPI = &spaceQuotaApiFake{}
+
+func (q *spaceQuotaApiFake) Get(ctx context.Context, guid string) (*resource.SpaceQuota, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	if q.quota == nil || q.quota.GUID != guid {
+		return nil, notFoundError
+	}
+	return q.quota, nil
+}
+
+func (q *spaceQuotaApiFake) Single(ctx context.Context, opts *cfv3.SpaceQuotaListOptions) (*resource.SpaceQuota, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	if q.quota == nil {
+		return nil, cfv3.ErrNoResultsReturned
+	}
+	return q.quota, nil
+}
+
+func (q *spaceQuotaApiFake) Create(ctx context.Context, r *resource.SpaceQuotaCreateOrUpdate) (*resource.SpaceQuota, error) {
+	q.created = r
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &resource.SpaceQuota{Name: internal.Val(r.Name), Resource: resource.Resource{GUID: "created-guid"}}, nil
+}
+
+func (q *spaceQuotaApiFake) Update(ctx context.Context, guid string, r *resource.SpaceQuotaCreateOrUpdate) (*resource.SpaceQuota, error) {
+	q.updated = r
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &resource.SpaceQuota{Name: internal.Val(r.Name), Resource: resource.Resource{GUID: guid}}, nil
+}
+
+func (q *spaceQuotaApiFake) Apply(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error) {
+	q.applied = append(q.applied, spaceGUIDs...)
+	return spaceGUIDs, q.err
+}
+
+func (q *spaceQuotaApiFake) Remove(ctx context.Context, guid, spaceGUID string) error {
+	q.removed = append(q.removed, spaceGUID)
+	return q.err
+}
+
+func (q *spaceQuotaApiFake) Delete(ctx context.Context, guid string) (string, error) {
+	q.deleted = guid
+	return "job-guid", q.err
+}
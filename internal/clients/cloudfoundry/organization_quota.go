@@ -0,0 +1,138 @@
+package cloudfoundry
+
+import (
+	"context"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/pkg/errors"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+const errRestoreDefaultQuota = "cannot apply the default quota to org %s"
+
+// organizationQuotaAPI is the subset of the go-cfclient OrganizationQuotaClient used to manage org quotas
+type organizationQuotaAPI interface {
+	Get(ctx context.Context, guid string) (*resource.OrganizationQuota, error)
+	Single(ctx context.Context, opts *cfv3.OrganizationQuotaListOptions) (*resource.OrganizationQuota, error)
+	Create(ctx context.Context, r *resource.OrganizationQuotaCreateOrUpdate) (*resource.OrganizationQuota, error)
+	Update(ctx context.Context, guid string, r *resource.OrganizationQuotaCreateOrUpdate) (*resource.OrganizationQuota, error)
+	Apply(ctx context.Context, guid string, organizationGUIDs []string) ([]string, error)
+	Delete(ctx context.Context, guid string) (string, error)
+}
+
+var _ organizationQuotaAPI = &cfv3.OrganizationQuotaClient{}
+
+// NewCfOrganizationQuotaMaintainer creates a CfOrganizationQuotaMaintainer using the org quota api of the given client
+func NewCfOrganizationQuotaMaintainer(c *cfv3.Client) *CfOrganizationQuotaMaintainer {
+	return &CfOrganizationQuotaMaintainer{quotas: c.OrganizationQuotas}
+}
+
+// CfOrganizationQuotaMaintainer manages org quota definitions and applies them to an org
+type CfOrganizationQuotaMaintainer struct {
+	quotas organizationQuotaAPI
+}
+
+// GenerateObservation looks up the quota by its GUID if known, otherwise by its name.
+// An empty observation is returned if the quota does not exist.
+func (m *CfOrganizationQuotaMaintainer) GenerateObservation(ctx context.Context, guid string, name string) (v1alpha1.OrganizationQuotaObservation, error) {
+	var quota *resource.OrganizationQuota
+	var err error
+	if guid != "" {
+		quota, err = m.quotas.Get(ctx, guid)
+	} else {
+		quota, err = m.quotas.Single(ctx, orgQuotaByName(name))
+	}
+	if isNotFound(err) {
+		return v1alpha1.OrganizationQuotaObservation{}, nil
+	}
+	if err != nil {
+		return v1alpha1.OrganizationQuotaObservation{}, err
+	}
+	return v1alpha1.OrganizationQuotaObservation{
+		ID:                internal.Ptr(quota.GUID),
+		Name:              internal.Ptr(quota.Name),
+		QuotaLimits:       limitsOf(quota.Apps, quota.Services, quota.Routes),
+		OrganizationGuids: guidsOf(quota.Relationships.Organizations.Data),
+	}, nil
+}
+
+// NeedsCreation checks if the quota has been found
+func (m *CfOrganizationQuotaMaintainer) NeedsCreation(observation v1alpha1.OrganizationQuotaObservation) bool {
+	return observation.ID == nil
+}
+
+// IsUpToDate checks name and limits of the quota and whether it is applied to the org
+func (m *CfOrganizationQuotaMaintainer) IsUpToDate(params v1alpha1.OrganizationQuotaParameters, orgGuid string, observation v1alpha1.OrganizationQuotaObservation) bool {
+	return internal.Val(observation.Name) == params.Name &&
+		limitsEqual(params.QuotaLimits, observation.QuotaLimits) &&
+		contains(observation.OrganizationGuids, orgGuid)
+}
+
+// Create creates the quota applied to the org and returns its GUID
+func (m *CfOrganizationQuotaMaintainer) Create(ctx context.Context, orgGuid string, params v1alpha1.OrganizationQuotaParameters) (string, error) {
+	create := resource.NewOrganizationQuotaCreate(params.Name).WithOrganizations(orgGuid)
+	create.Apps, create.Services, create.Routes = &resource.AppsQuota{}, &resource.ServicesQuota{}, &resource.RoutesQuota{}
+	applyLimits(params.QuotaLimits, create.Apps, create.Services, create.Routes)
+
+	quota, err := m.quotas.Create(ctx, create)
+	if err != nil {
+		return "", err
+	}
+	return quota.GUID, nil
+}
+
+// Update sets name and limits of the quota and applies it to the org if it isn't yet
+func (m *CfOrganizationQuotaMaintainer) Update(ctx context.Context, guid string, orgGuid string, params v1alpha1.OrganizationQuotaParameters) error {
+	quota, err := m.quotas.Get(ctx, guid)
+	if err != nil {
+		return err
+	}
+	applyLimits(params.QuotaLimits, &quota.Apps, &quota.Services, &quota.Routes)
+	update := resource.NewOrganizationQuotaUpdate().WithName(params.Name)
+	update.Apps, update.Services, update.Routes = &quota.Apps, &quota.Services, &quota.Routes
+	if _, err := m.quotas.Update(ctx, guid, update); err != nil {
+		return err
+	}
+
+	if contains(guidsOf(quota.Relationships.Organizations.Data), orgGuid) {
+		return nil
+	}
+	_, err = m.quotas.Apply(ctx, guid, []string{orgGuid})
+	return err
+}
+
+// Delete deletes the quota, a quota still applied to the org can't be deleted so the org gets the default quota first
+func (m *CfOrganizationQuotaMaintainer) Delete(ctx context.Context, guid string, orgGuid string) error {
+	quota, err := m.quotas.Get(ctx, guid)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if contains(guidsOf(quota.Relationships.Organizations.Data), orgGuid) {
+		defaultQuota, err := m.quotas.Single(ctx, orgQuotaByName(defaultOrgQuota))
+		if err != nil {
+			return errors.Wrapf(err, errRestoreDefaultQuota, orgGuid)
+		}
+		if _, err := m.quotas.Apply(ctx, defaultQuota.GUID, []string{orgGuid}); err != nil {
+			return errors.Wrapf(err, errRestoreDefaultQuota, orgGuid)
+		}
+	}
+
+	_, err = m.quotas.Delete(ctx, guid)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func orgQuotaByName(name string) *cfv3.OrganizationQuotaListOptions {
+	opts := cfv3.NewOrganizationQuotaListOptions()
+	opts.Names.EqualTo(name)
+	return opts
+}
@@ -0,0 +1,80 @@
+package cloudfoundry
+
+import (
+	"reflect"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+// defaultOrgQuota is the quota definition every cloud foundry landscape applies to new orgs
+const defaultOrgQuota = "default"
+
+func limitsOf(apps resource.AppsQuota, services resource.ServicesQuota, routes resource.RoutesQuota) v1alpha1.QuotaLimits {
+	return v1alpha1.QuotaLimits{
+		TotalMemoryInMB:       apps.TotalMemoryInMB,
+		PerProcessMemoryInMB:  apps.PerProcessMemoryInMB,
+		TotalInstances:        apps.TotalInstances,
+		TotalRoutes:           routes.TotalRoutes,
+		TotalReservedPorts:    routes.TotalReservedPorts,
+		TotalServiceInstances: services.TotalServiceInstances,
+		TotalServiceKeys:      services.TotalServiceKeys,
+		PaidServicesAllowed:   services.PaidServicesAllowed,
+	}
+}
+
+// applyLimits sets the limits managed by the provider and leaves the others, like the log rate limit, as they are
+func applyLimits(limits v1alpha1.QuotaLimits, apps *resource.AppsQuota, services *resource.ServicesQuota, routes *resource.RoutesQuota) {
+	apps.TotalMemoryInMB = limits.TotalMemoryInMB
+	apps.PerProcessMemoryInMB = limits.PerProcessMemoryInMB
+	apps.TotalInstances = limits.TotalInstances
+	routes.TotalRoutes = limits.TotalRoutes
+	routes.TotalReservedPorts = limits.TotalReservedPorts
+	services.TotalServiceInstances = limits.TotalServiceInstances
+	services.TotalServiceKeys = limits.TotalServiceKeys
+	services.PaidServicesAllowed = internal.Ptr(paidServicesAllowed(limits))
+}
+
+// limitsEqual compares desired with observed limits, paid services are allowed unless forbidden explicitly
+func limitsEqual(desired v1alpha1.QuotaLimits, observed v1alpha1.QuotaLimits) bool {
+	desired.PaidServicesAllowed = internal.Ptr(paidServicesAllowed(desired))
+	observed.PaidServicesAllowed = internal.Ptr(paidServicesAllowed(observed))
+	return reflect.DeepEqual(desired, observed)
+}
+
+func paidServicesAllowed(limits v1alpha1.QuotaLimits) bool {
+	if limits.PaidServicesAllowed == nil {
+		return true
+	}
+	return *limits.PaidServicesAllowed
+}
+
+func guidsOf(relationships []resource.Relationship) []string {
+	if len(relationships) == 0 {
+		return nil
+	}
+	guids := make([]string, 0, len(relationships))
+	for _, r := range relationships {
+		guids = append(guids, r.GUID)
+	}
+	return guids
+}
+
+func relationships(guids []string) []resource.Relationship {
+	r := make([]resource.Relationship, 0, len(guids))
+	for _, g := range guids {
+		r = append(r, resource.Relationship{GUID: g})
+	}
+	return r
+}
+
+func contains(guids []string, guid string) bool {
+	for _, g := range guids {
+		if g == guid {
+			return true
+		}
+	}
+	return false
+}
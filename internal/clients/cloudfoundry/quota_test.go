@@ -0,0 +1,243 @@
+package cloudfoundry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+func TestLimitsEqual(t *testing.T) {
+	tests := map[string]struct {
+		desired  v1alpha1.QuotaLimits
+		observed v1alpha1.QuotaLimits
+		want     bool
+	}{
+		"unlimited": {
+			observed: v1alpha1.QuotaLimits{PaidServicesAllowed: internal.Ptr(true)},
+			want:     true,
+		},
+		"paid services forbidden": {
+			desired:  v1alpha1.QuotaLimits{PaidServicesAllowed: internal.Ptr(false)},
+			observed: v1alpha1.QuotaLimits{PaidServicesAllowed: internal.Ptr(true)},
+			want:     false,
+		},
+		"memory differs": {
+			desired:  v1alpha1.QuotaLimits{TotalMemoryInMB: internal.Ptr(2048)},
+			observed: v1alpha1.QuotaLimits{TotalMemoryInMB: internal.Ptr(1024)},
+			want:     false,
+		},
+		"memory no longer limited": {
+			observed: v1alpha1.QuotaLimits{TotalMemoryInMB: internal.Ptr(1024)},
+			want:     false,
+		},
+		"same limits": {
+			desired:  v1alpha1.QuotaLimits{TotalMemoryInMB: internal.Ptr(1024), TotalRoutes: internal.Ptr(10)},
+			observed: v1alpha1.QuotaLimits{TotalMemoryInMB: internal.Ptr(1024), TotalRoutes: internal.Ptr(10), PaidServicesAllowed: internal.Ptr(true)},
+			want:     true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := limitsEqual(tc.desired, tc.observed); got != tc.want {
+				t.Errorf("limitsEqual() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOrganizationQuotaGenerateObservation(t *testing.T) {
+	type want struct {
+		obs v1alpha1.OrganizationQuotaObservation
+		err error
+	}
+
+	small := orgQuota("quota-guid", "small", "org-guid")
+
+	tests := map[string]struct {
+		apiFake *orgQuotaApiFake
+		guid    string
+		want    want
+	}{
+		"api error": {
+			apiFake: &orgQuotaApiFake{err: internalServerError},
+			want:    want{err: internalServerError},
+		},
+		"not existing quota": {
+			apiFake: &orgQuotaApiFake{},
+			want:    want{obs: v1alpha1.OrganizationQuotaObservation{}},
+		},
+		"quota deleted externally": {
+			apiFake: &orgQuotaApiFake{},
+			guid:    "quota-guid",
+			want:    want{obs: v1alpha1.OrganizationQuotaObservation{}},
+		},
+		"found by name": {
+			apiFake: &orgQuotaApiFake{quotas: []*resource.OrganizationQuota{small}},
+			want: want{obs: v1alpha1.OrganizationQuotaObservation{
+				ID:                internal.Ptr("quota-guid"),
+				Name:              internal.Ptr("small"),
+				QuotaLimits:       v1alpha1.QuotaLimits{TotalMemoryInMB: internal.Ptr(1024), PaidServicesAllowed: internal.Ptr(true)},
+				OrganizationGuids: []string{"org-guid"},
+			}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := &CfOrganizationQuotaMaintainer{quotas: tc.apiFake}
+			obs, err := m.GenerateObservation(context.TODO(), tc.guid, "small")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nGenerateObservation(): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\nGenerateObservation(): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestOrganizationQuotaUpdate(t *testing.T) {
+	quota := orgQuota("quota-guid", "small", "other-org")
+	quota.Apps.LogRateLimitInBytesPerSecond = internal.Ptr(1000)
+	fake := &orgQuotaApiFake{quotas: []*resource.OrganizationQuota{quota}}
+	m := &CfOrganizationQuotaMaintainer{quotas: fake}
+
+	params := v1alpha1.OrganizationQuotaParameters{Name: "medium", QuotaLimits: v1alpha1.QuotaLimits{TotalMemoryInMB: internal.Ptr(4096)}}
+	if err := m.Update(context.TODO(), "quota-guid", "org-guid", params); err != nil {
+		t.Fatalf("Update() = %v", err)
+	}
+	if internal.Val(fake.updated.Name) != "medium" || internal.Val(fake.updated.Apps.TotalMemoryInMB) != 4096 {
+		t.Errorf("Update() sent %+v", fake.updated)
+	}
+	if internal.Val(fake.updated.Apps.LogRateLimitInBytesPerSecond) != 1000 {
+		t.Errorf("Update() must keep limits not managed by the provider")
+	}
+	if diff := cmp.Diff(map[string][]string{"quota-guid": {"org-guid"}}, fake.applied); diff != "" {
+		t.Errorf("Update() applied: -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestOrganizationQuotaDelete(t *testing.T) {
+	tests := map[string]struct {
+		apiFake     *orgQuotaApiFake
+		wantErr     bool
+		wantApplied map[string][]string
+		wantDeleted string
+	}{
+		"already deleted": {
+			apiFake: &orgQuotaApiFake{},
+		},
+		"restores default quota": {
+			apiFake:     &orgQuotaApiFake{quotas: []*resource.OrganizationQuota{orgQuota("quota-guid", "small", "org-guid"), orgQuota("default-guid", "default")}},
+			wantApplied: map[string][]string{"default-guid": {"org-guid"}},
+			wantDeleted: "quota-guid",
+		},
+		"not applied to org": {
+			apiFake:     &orgQuotaApiFake{quotas: []*resource.OrganizationQuota{orgQuota("quota-guid", "small")}},
+			wantDeleted: "quota-guid",
+		},
+		"default quota missing": {
+			apiFake: &orgQuotaApiFake{quotas: []*resource.OrganizationQuota{orgQuota("quota-guid", "small", "org-guid")}},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := &CfOrganizationQuotaMaintainer{quotas: tc.apiFake}
+			err := m.Delete(context.TODO(), "quota-guid", "org-guid")
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantApplied, tc.apiFake.applied); diff != "" {
+				t.Errorf("Delete() applied: -want, +got:\n%s\n", diff)
+			}
+			if tc.apiFake.deleted != tc.wantDeleted {
+				t.Errorf("Delete() deleted %q, want %q", tc.apiFake.deleted, tc.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestSpaceQuotaIsUpToDate(t *testing.T) {
+	obs := v1alpha1.SpaceQuotaObservation{
+		ID:          internal.Ptr("quota-guid"),
+		Name:        internal.Ptr("dev"),
+		QuotaLimits: v1alpha1.QuotaLimits{TotalMemoryInMB: internal.Ptr(1024), PaidServicesAllowed: internal.Ptr(true)},
+		SpaceGuids:  []string{"space-a", "space-b"},
+	}
+	params := v1alpha1.SpaceQuotaParameters{Name: "dev", QuotaLimits: v1alpha1.QuotaLimits{TotalMemoryInMB: internal.Ptr(1024)}}
+
+	tests := map[string]struct {
+		params v1alpha1.SpaceQuotaParameters
+		spaces []string
+		want   bool
+	}{
+		"up to date":     {params: params, spaces: []string{"space-b", "space-a"}, want: true},
+		"space missing":  {params: params, spaces: []string{"space-a", "space-b", "space-c"}, want: false},
+		"space removed":  {params: params, spaces: []string{"space-a"}, want: false},
+		"limits changed": {params: v1alpha1.SpaceQuotaParameters{Name: "dev"}, spaces: []string{"space-a", "space-b"}, want: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := &CfSpaceQuotaMaintainer{}
+			if got := m.IsUpToDate(tc.params, tc.spaces, obs); got != tc.want {
+				t.Errorf("IsUpToDate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSpaceQuotaCreateUpdateDelete(t *testing.T) {
+	fake := &spaceQuotaApiFake{}
+	m := &CfSpaceQuotaMaintainer{quotas: fake}
+	params := v1alpha1.SpaceQuotaParameters{Name: "dev", QuotaLimits: v1alpha1.QuotaLimits{TotalMemoryInMB: internal.Ptr(1024)}}
+
+	guid, err := m.Create(context.TODO(), "org-guid", params, []string{"space-a"})
+	if err != nil || guid != "created-guid" {
+		t.Errorf("Create() = %v, %v", guid, err)
+	}
+	if fake.created.Relationships.Organization.Data.GUID != "org-guid" || internal.Val(fake.created.Apps.TotalMemoryInMB) != 1024 {
+		t.Errorf("Create() sent %+v", fake.created)
+	}
+	if diff := cmp.Diff(relationships([]string{"space-a"}), fake.created.Relationships.Spaces.Data); diff != "" {
+		t.Errorf("Create() spaces: -want, +got:\n%s\n", diff)
+	}
+
+	fake.quota = &resource.SpaceQuota{
+		Name:          "dev",
+		Resource:      resource.Resource{GUID: guid},
+		Relationships: resource.SpaceQuotaRelationships{Spaces: &resource.ToManyRelationships{Data: relationships([]string{"space-a", "space-b"})}},
+	}
+	if err := m.Update(context.TODO(), guid, params, []string{"space-b", "space-c"}); err != nil {
+		t.Fatalf("Update() = %v", err)
+	}
+	if diff := cmp.Diff([]string{"space-c"}, fake.applied); diff != "" {
+		t.Errorf("Update() applied: -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff([]string{"space-a"}, fake.removed); diff != "" {
+		t.Errorf("Update() removed: -want, +got:\n%s\n", diff)
+	}
+
+	fake.removed = nil
+	if err := m.Delete(context.TODO(), guid); err != nil || fake.deleted != guid {
+		t.Errorf("Delete() = %v", err)
+	}
+	if diff := cmp.Diff([]string{"space-a", "space-b"}, fake.removed); diff != "" {
+		t.Errorf("Delete() removed: -want, +got:\n%s\n", diff)
+	}
+}
+
+func orgQuota(guid string, name string, orgGuids ...string) *resource.OrganizationQuota {
+	return &resource.OrganizationQuota{
+		Name:          name,
+		Resource:      resource.Resource{GUID: guid},
+		Apps:          resource.AppsQuota{TotalMemoryInMB: internal.Ptr(1024)},
+		Services:      resource.ServicesQuota{PaidServicesAllowed: internal.Ptr(true)},
+		Relationships: resource.OrganizationQuotaRelationships{Organizations: resource.ToManyRelationships{Data: relationships(orgGuids)}},
+	}
+}
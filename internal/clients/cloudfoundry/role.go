@@ -0,0 +1,149 @@
+package cloudfoundry
+
+import (
+	"context"
+	"strings"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+const defaultOrigin = "sap.ids"
+
+// roleAPI is the subset of the go-cfclient RoleClient used to manage role assignments
+type roleAPI interface {
+	Get(ctx context.Context, guid string) (*resource.Role, error)
+	ListIncludeUsersAll(ctx context.Context, opts *cfv3.RoleListOptions) ([]*resource.Role, []*resource.User, error)
+	CreateSpaceRoleWithUsername(ctx context.Context, spaceGUID string, userName string, roleType resource.SpaceRoleType, origin string) (*resource.Role, error)
+	CreateOrganizationRoleWithUsername(ctx context.Context, organizationGUID string, userName string, roleType resource.OrganizationRoleType, origin string) (*resource.Role, error)
+	Delete(ctx context.Context, guid string) (string, error)
+}
+
+var _ roleAPI = &cfv3.RoleClient{}
+
+var spaceRoleTypes = map[string]resource.SpaceRoleType{
+	v1alpha1.SpaceRoleDeveloper: resource.SpaceRoleDeveloper,
+	v1alpha1.SpaceRoleManager:   resource.SpaceRoleManager,
+	v1alpha1.SpaceRoleAuditor:   resource.SpaceRoleAuditor,
+	v1alpha1.SpaceRoleSupporter: resource.SpaceRoleSupporter,
+}
+
+var orgRoleTypes = map[string]resource.OrganizationRoleType{
+	v1alpha1.OrgRoleUser:           resource.OrganizationRoleUser,
+	v1alpha1.OrgRoleManager:        resource.OrganizationRoleManager,
+	v1alpha1.OrgRoleBillingManager: resource.OrganizationRoleBillingManager,
+	v1alpha1.OrgRoleAuditor:        resource.OrganizationRoleAuditor,
+}
+
+// NewCfRoleMaintainer creates a CfRoleMaintainer using the roles api of the given client
+func NewCfRoleMaintainer(c *cfv3.Client) *CfRoleMaintainer {
+	return &CfRoleMaintainer{roles: c.Roles}
+}
+
+// CfRoleMaintainer manages space and org role assignments of cloud foundry users
+type CfRoleMaintainer struct {
+	roles roleAPI
+}
+
+// ObserveSpaceRole looks up the role by its GUID if known, otherwise by type and user within the space.
+// An empty observation is returned if the role does not exist.
+func (m *CfRoleMaintainer) ObserveSpaceRole(ctx context.Context, guid string, spaceGuid string, params v1alpha1.SpaceRoleParameters) (v1alpha1.RoleObservation, error) {
+	if guid != "" {
+		return m.observeByGuid(ctx, guid)
+	}
+	opts := cfv3.NewRoleListOptions()
+	opts.SpaceGUIDs.EqualTo(spaceGuid)
+	opts.WithSpaceRoleType(spaceRoleTypes[params.Type])
+	return m.observeByUser(ctx, opts, params.Username, params.Origin)
+}
+
+// ObserveOrgRole looks up the role by its GUID if known, otherwise by type and user within the org.
+// An empty observation is returned if the role does not exist.
+func (m *CfRoleMaintainer) ObserveOrgRole(ctx context.Context, guid string, orgGuid string, params v1alpha1.OrgRoleParameters) (v1alpha1.RoleObservation, error) {
+	if guid != "" {
+		return m.observeByGuid(ctx, guid)
+	}
+	opts := cfv3.NewRoleListOptions()
+	opts.OrganizationGUIDs.EqualTo(orgGuid)
+	opts.WithOrganizationRoleType(orgRoleTypes[params.Type])
+	return m.observeByUser(ctx, opts, params.Username, params.Origin)
+}
+
+// NeedsCreation checks if the role assignment has been found
+func (m *CfRoleMaintainer) NeedsCreation(observation v1alpha1.RoleObservation) bool {
+	return observation.ID == nil
+}
+
+// CreateSpaceRole assigns the space role to the user and returns the GUID of the assignment
+func (m *CfRoleMaintainer) CreateSpaceRole(ctx context.Context, spaceGuid string, params v1alpha1.SpaceRoleParameters) (string, error) {
+	role, err := m.roles.CreateSpaceRoleWithUsername(ctx, spaceGuid, params.Username, spaceRoleTypes[params.Type], originOf(params.Origin))
+	if err != nil {
+		return "", err
+	}
+	return role.GUID, nil
+}
+
+// CreateOrgRole assigns the org role to the user and returns the GUID of the assignment
+func (m *CfRoleMaintainer) CreateOrgRole(ctx context.Context, orgGuid string, params v1alpha1.OrgRoleParameters) (string, error) {
+	role, err := m.roles.CreateOrganizationRoleWithUsername(ctx, orgGuid, params.Username, orgRoleTypes[params.Type], originOf(params.Origin))
+	if err != nil {
+		return "", err
+	}
+	return role.GUID, nil
+}
+
+// Delete triggers the asynchronous removal of the role assignment, an assignment which is already gone counts as deleted
+func (m *CfRoleMaintainer) Delete(ctx context.Context, guid string) error {
+	_, err := m.roles.Delete(ctx, guid)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (m *CfRoleMaintainer) observeByGuid(ctx context.Context, guid string) (v1alpha1.RoleObservation, error) {
+	role, err := m.roles.Get(ctx, guid)
+	if isNotFound(err) {
+		return v1alpha1.RoleObservation{}, nil
+	}
+	if err != nil {
+		return v1alpha1.RoleObservation{}, err
+	}
+	return roleObservation(role), nil
+}
+
+func (m *CfRoleMaintainer) observeByUser(ctx context.Context, opts *cfv3.RoleListOptions, username string, origin string) (v1alpha1.RoleObservation, error) {
+	roles, users, err := m.roles.ListIncludeUsersAll(ctx, opts)
+	if err != nil {
+		return v1alpha1.RoleObservation{}, err
+	}
+	for _, u := range users {
+		if !strings.EqualFold(u.Username, username) || originOf(u.Origin) != originOf(origin) {
+			continue
+		}
+		for _, r := range roles {
+			if r.Relationships.User.Data != nil && r.Relationships.User.Data.GUID == u.GUID {
+				return roleObservation(r), nil
+			}
+		}
+	}
+	return v1alpha1.RoleObservation{}, nil
+}
+
+func roleObservation(role *resource.Role) v1alpha1.RoleObservation {
+	obs := v1alpha1.RoleObservation{ID: internal.Ptr(role.GUID)}
+	if role.Relationships.User.Data != nil {
+		obs.UserID = internal.Ptr(role.Relationships.User.Data.GUID)
+	}
+	return obs
+}
+
+func originOf(origin string) string {
+	if origin == "" {
+		return defaultOrigin
+	}
+	return origin
+}
@@ -0,0 +1,102 @@
+package cloudfoundry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+func TestObserveSpaceRole(t *testing.T) {
+	type want struct {
+		obs v1alpha1.RoleObservation
+		err error
+	}
+
+	params := v1alpha1.SpaceRoleParameters{Type: v1alpha1.SpaceRoleDeveloper, Username: "jane@example.com"}
+	existing := v1alpha1.RoleObservation{ID: internal.Ptr("role-guid"), UserID: internal.Ptr("user-guid")}
+
+	tests := map[string]struct {
+		apiFake *roleApiFake
+		guid    string
+		params  v1alpha1.SpaceRoleParameters
+		want    want
+	}{
+		"api error": {
+			apiFake: &roleApiFake{err: internalServerError},
+			params:  params,
+			want:    want{err: internalServerError},
+		},
+		"not assigned": {
+			apiFake: &roleApiFake{},
+			params:  params,
+			want:    want{obs: v1alpha1.RoleObservation{}},
+		},
+		"found by user with default origin": {
+			apiFake: &roleApiFake{
+				roles: []*resource.Role{role("other-role", "other-user"), role("role-guid", "user-guid")},
+				users: []*resource.User{user("other-user", "john@example.com", "sap.ids"), user("user-guid", "Jane@example.com", "sap.ids")},
+			},
+			params: params,
+			want:   want{obs: existing},
+		},
+		"user of other origin": {
+			apiFake: &roleApiFake{
+				roles: []*resource.Role{role("role-guid", "user-guid")},
+				users: []*resource.User{user("user-guid", "jane@example.com", "custom-idp")},
+			},
+			params: params,
+			want:   want{obs: v1alpha1.RoleObservation{}},
+		},
+		"found by guid": {
+			apiFake: &roleApiFake{roles: []*resource.Role{role("role-guid", "user-guid")}},
+			guid:    "role-guid",
+			params:  params,
+			want:    want{obs: existing},
+		},
+		"removed externally": {
+			apiFake: &roleApiFake{},
+			guid:    "role-guid",
+			params:  params,
+			want:    want{obs: v1alpha1.RoleObservation{}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := &CfRoleMaintainer{roles: tc.apiFake}
+			obs, err := m.ObserveSpaceRole(context.TODO(), tc.guid, "space-guid", tc.params)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nObserveSpaceRole(): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\nObserveSpaceRole(): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreateRoles(t *testing.T) {
+	fake := &roleApiFake{}
+	m := &CfRoleMaintainer{roles: fake}
+
+	guid, err := m.CreateSpaceRole(context.TODO(), "space-guid", v1alpha1.SpaceRoleParameters{Type: v1alpha1.SpaceRoleSupporter, Username: "jane@example.com"})
+	if err != nil || guid != "created-guid" {
+		t.Errorf("CreateSpaceRole() = %v, %v", guid, err)
+	}
+	if fake.created != "space_supporter:jane@example.com:sap.ids" {
+		t.Errorf("CreateSpaceRole() sent %s", fake.created)
+	}
+
+	_, err = m.CreateOrgRole(context.TODO(), "org-guid", v1alpha1.OrgRoleParameters{Type: v1alpha1.OrgRoleBillingManager, Username: "jane@example.com", Origin: "custom-idp"})
+	if err != nil {
+		t.Errorf("CreateOrgRole() = %v", err)
+	}
+	if fake.created != "organization_billing_manager:jane@example.com:custom-idp" {
+		t.Errorf("CreateOrgRole() sent %s", fake.created)
+	}
+}
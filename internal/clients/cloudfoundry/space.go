@@ -0,0 +1,97 @@
+package cloudfoundry
+
+import (
+	"context"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/pkg/errors"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+// spaceAPI is the subset of the go-cfclient SpaceClient used to manage spaces
+type spaceAPI interface {
+	Get(ctx context.Context, guid string) (*resource.Space, error)
+	Single(ctx context.Context, opts *cfv3.SpaceListOptions) (*resource.Space, error)
+	Create(ctx context.Context, r *resource.SpaceCreate) (*resource.Space, error)
+	Update(ctx context.Context, guid string, r *resource.SpaceUpdate) (*resource.Space, error)
+	Delete(ctx context.Context, guid string) (string, error)
+}
+
+var _ spaceAPI = &cfv3.SpaceClient{}
+
+// NewCfSpaceMaintainer creates a CfSpaceMaintainer using the spaces api of the given client
+func NewCfSpaceMaintainer(c *cfv3.Client) *CfSpaceMaintainer {
+	return &CfSpaceMaintainer{spaces: c.Spaces}
+}
+
+// CfSpaceMaintainer manages spaces within a cloud foundry org
+type CfSpaceMaintainer struct {
+	spaces spaceAPI
+}
+
+// GenerateObservation looks up the space by its GUID if known, otherwise by its name within the org.
+// An empty observation is returned if the space does not exist.
+func (m *CfSpaceMaintainer) GenerateObservation(ctx context.Context, guid string, orgGuid string, name string) (v1alpha1.SpaceObservation, error) {
+	var space *resource.Space
+	var err error
+	if guid != "" {
+		space, err = m.spaces.Get(ctx, guid)
+	} else {
+		opts := cfv3.NewSpaceListOptions()
+		opts.OrganizationGUIDs.EqualTo(orgGuid)
+		opts.Names.EqualTo(name)
+		space, err = m.spaces.Single(ctx, opts)
+	}
+	if isNotFound(err) {
+		return v1alpha1.SpaceObservation{}, nil
+	}
+	if err != nil {
+		return v1alpha1.SpaceObservation{}, err
+	}
+	return v1alpha1.SpaceObservation{
+		ID:   internal.Ptr(space.GUID),
+		Name: internal.Ptr(space.Name),
+	}, nil
+}
+
+// NeedsCreation checks if the space has been found in the org
+func (m *CfSpaceMaintainer) NeedsCreation(observation v1alpha1.SpaceObservation) bool {
+	return observation.ID == nil
+}
+
+// IsUpToDate checks whether the space has the desired name
+func (m *CfSpaceMaintainer) IsUpToDate(params v1alpha1.SpaceParameters, observation v1alpha1.SpaceObservation) bool {
+	return internal.Val(observation.Name) == params.Name
+}
+
+// Create creates the space in the org and returns its GUID
+func (m *CfSpaceMaintainer) Create(ctx context.Context, orgGuid string, params v1alpha1.SpaceParameters) (string, error) {
+	space, err := m.spaces.Create(ctx, resource.NewSpaceCreate(params.Name, orgGuid))
+	if err != nil {
+		return "", err
+	}
+	return space.GUID, nil
+}
+
+// Update renames the space
+func (m *CfSpaceMaintainer) Update(ctx context.Context, guid string, params v1alpha1.SpaceParameters) error {
+	_, err := m.spaces.Update(ctx, guid, &resource.SpaceUpdate{Name: params.Name})
+	return err
+}
+
+// Delete triggers the asynchronous deletion of the space, a space which is already gone counts as deleted
+func (m *CfSpaceMaintainer) Delete(ctx context.Context, guid string) error {
+	_, err := m.spaces.Delete(ctx, guid)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// isNotFound covers both a failed lookup by GUID and an empty search result
+func isNotFound(err error) bool {
+	return err != nil && (resource.IsResourceNotFoundError(err) || errors.Is(err, cfv3.ErrNoResultsReturned))
+}
@@ -0,0 +1,161 @@
+package cloudfoundry
+
+import (
+	"context"
+
+	cfv3 "github.com/cloudfoundry/go-cfclient/v3/client"
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+// spaceQuotaAPI is the subset of the go-cfclient SpaceQuotaClient used to manage space quotas
+type spaceQuotaAPI interface {
+	Get(ctx context.Context, guid string) (*resource.SpaceQuota, error)
+	Single(ctx context.Context, opts *cfv3.SpaceQuotaListOptions) (*resource.SpaceQuota, error)
+	Create(ctx context.Context, r *resource.SpaceQuotaCreateOrUpdate) (*resource.SpaceQuota, error)
+	Update(ctx context.Context, guid string, r *resource.SpaceQuotaCreateOrUpdate) (*resource.SpaceQuota, error)
+	Apply(ctx context.Context, guid string, spaceGUIDs []string) ([]string, error)
+	Remove(ctx context.Context, guid, spaceGUID string) error
+	Delete(ctx context.Context, guid string) (string, error)
+}
+
+var _ spaceQuotaAPI = &cfv3.SpaceQuotaClient{}
+
+// NewCfSpaceQuotaMaintainer creates a CfSpaceQuotaMaintainer using the space quota api of the given client
+func NewCfSpaceQuotaMaintainer(c *cfv3.Client) *CfSpaceQuotaMaintainer {
+	return &CfSpaceQuotaMaintainer{quotas: c.SpaceQuotas}
+}
+
+// CfSpaceQuotaMaintainer manages space quota definitions of an org and the spaces they are applied to
+type CfSpaceQuotaMaintainer struct {
+	quotas spaceQuotaAPI
+}
+
+// GenerateObservation looks up the quota by its GUID if known, otherwise by its name within the org.
+// An empty observation is returned if the quota does not exist.
+func (m *CfSpaceQuotaMaintainer) GenerateObservation(ctx context.Context, guid string, orgGuid string, name string) (v1alpha1.SpaceQuotaObservation, error) {
+	var quota *resource.SpaceQuota
+	var err error
+	if guid != "" {
+		quota, err = m.quotas.Get(ctx, guid)
+	} else {
+		opts := cfv3.NewSpaceQuotaListOptions()
+		opts.OrganizationGUIDs.EqualTo(orgGuid)
+		opts.Names.EqualTo(name)
+		quota, err = m.quotas.Single(ctx, opts)
+	}
+	if isNotFound(err) {
+		return v1alpha1.SpaceQuotaObservation{}, nil
+	}
+	if err != nil {
+		return v1alpha1.SpaceQuotaObservation{}, err
+	}
+	return v1alpha1.SpaceQuotaObservation{
+		ID:          internal.Ptr(quota.GUID),
+		Name:        internal.Ptr(quota.Name),
+		QuotaLimits: limitsOf(quota.Apps, quota.Services, quota.Routes),
+		SpaceGuids:  spaceGuidsOf(quota),
+	}, nil
+}
+
+// NeedsCreation checks if the quota has been found
+func (m *CfSpaceQuotaMaintainer) NeedsCreation(observation v1alpha1.SpaceQuotaObservation) bool {
+	return observation.ID == nil
+}
+
+// IsUpToDate checks name and limits of the quota and whether it is applied to exactly the given spaces
+func (m *CfSpaceQuotaMaintainer) IsUpToDate(params v1alpha1.SpaceQuotaParameters, spaceGuids []string, observation v1alpha1.SpaceQuotaObservation) bool {
+	add, remove := spaceChanges(spaceGuids, observation.SpaceGuids)
+	return internal.Val(observation.Name) == params.Name &&
+		limitsEqual(params.QuotaLimits, observation.QuotaLimits) &&
+		len(add) == 0 && len(remove) == 0
+}
+
+// Create creates the quota in the org, applies it to the spaces and returns its GUID
+func (m *CfSpaceQuotaMaintainer) Create(ctx context.Context, orgGuid string, params v1alpha1.SpaceQuotaParameters, spaceGuids []string) (string, error) {
+	create := resource.NewSpaceQuotaCreate(params.Name, orgGuid)
+	create.Apps, create.Services, create.Routes = &resource.AppsQuota{}, &resource.ServicesQuota{}, &resource.RoutesQuota{}
+	applyLimits(params.QuotaLimits, create.Apps, create.Services, create.Routes)
+	if len(spaceGuids) > 0 {
+		// WithSpaces of the client can't be combined with the org relationship set by NewSpaceQuotaCreate
+		create.Relationships.Spaces = &resource.ToManyRelationships{Data: relationships(spaceGuids)}
+	}
+
+	quota, err := m.quotas.Create(ctx, create)
+	if err != nil {
+		return "", err
+	}
+	return quota.GUID, nil
+}
+
+// Update sets name and limits of the quota, applies it to missing spaces and removes it from spaces not listed anymore
+func (m *CfSpaceQuotaMaintainer) Update(ctx context.Context, guid string, params v1alpha1.SpaceQuotaParameters, spaceGuids []string) error {
+	quota, err := m.quotas.Get(ctx, guid)
+	if err != nil {
+		return err
+	}
+	applyLimits(params.QuotaLimits, &quota.Apps, &quota.Services, &quota.Routes)
+	update := resource.NewSpaceQuotaUpdate().WithName(params.Name)
+	update.Apps, update.Services, update.Routes = &quota.Apps, &quota.Services, &quota.Routes
+	if _, err := m.quotas.Update(ctx, guid, update); err != nil {
+		return err
+	}
+
+	add, remove := spaceChanges(spaceGuids, spaceGuidsOf(quota))
+	if len(add) > 0 {
+		if _, err := m.quotas.Apply(ctx, guid, add); err != nil {
+			return err
+		}
+	}
+	for _, space := range remove {
+		if err := m.quotas.Remove(ctx, guid, space); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete removes the quota from all spaces, which cloud foundry requires before the quota itself can be deleted
+func (m *CfSpaceQuotaMaintainer) Delete(ctx context.Context, guid string) error {
+	quota, err := m.quotas.Get(ctx, guid)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, space := range spaceGuidsOf(quota) {
+		if err := m.quotas.Remove(ctx, guid, space); err != nil {
+			return err
+		}
+	}
+	_, err = m.quotas.Delete(ctx, guid)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func spaceGuidsOf(quota *resource.SpaceQuota) []string {
+	if quota.Relationships.Spaces == nil {
+		return nil
+	}
+	return guidsOf(quota.Relationships.Spaces.Data)
+}
+
+// spaceChanges returns the spaces the quota needs to be applied to and removed from
+func spaceChanges(desired []string, actual []string) (add []string, remove []string) {
+	for _, d := range desired {
+		if !contains(actual, d) {
+			add = append(add, d)
+		}
+	}
+	for _, a := range actual {
+		if !contains(desired, a) {
+			remove = append(remove, a)
+		}
+	}
+	return add, remove
+}
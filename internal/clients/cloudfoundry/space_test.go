@@ -0,0 +1,86 @@
+package cloudfoundry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudfoundry/go-cfclient/v3/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+func TestSpaceGenerateObservation(t *testing.T) {
+	type want struct {
+		obs v1alpha1.SpaceObservation
+		err error
+	}
+
+	dev := &resource.Space{Name: "dev", Resource: resource.Resource{GUID: "space-guid"}}
+
+	tests := map[string]struct {
+		apiFake *spaceApiFake
+		guid    string
+		want    want
+	}{
+		"api error": {
+			apiFake: &spaceApiFake{err: internalServerError},
+			want:    want{err: internalServerError},
+		},
+		"not existing space": {
+			apiFake: &spaceApiFake{},
+			want:    want{obs: v1alpha1.SpaceObservation{}},
+		},
+		"space deleted externally": {
+			apiFake: &spaceApiFake{},
+			guid:    "space-guid",
+			want:    want{obs: v1alpha1.SpaceObservation{}},
+		},
+		"found by name": {
+			apiFake: &spaceApiFake{space: dev},
+			want:    want{obs: v1alpha1.SpaceObservation{ID: internal.Ptr("space-guid"), Name: internal.Ptr("dev")}},
+		},
+		"found by guid": {
+			apiFake: &spaceApiFake{space: dev},
+			guid:    "space-guid",
+			want:    want{obs: v1alpha1.SpaceObservation{ID: internal.Ptr("space-guid"), Name: internal.Ptr("dev")}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := &CfSpaceMaintainer{spaces: tc.apiFake}
+			obs, err := m.GenerateObservation(context.TODO(), tc.guid, "org-guid", "dev")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\nGenerateObservation(): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\nGenerateObservation(): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSpaceCreateUpdateDelete(t *testing.T) {
+	fake := &spaceApiFake{}
+	m := &CfSpaceMaintainer{spaces: fake}
+	params := v1alpha1.SpaceParameters{Name: "dev"}
+
+	guid, err := m.Create(context.TODO(), "org-guid", params)
+	if err != nil || guid != "created-guid" {
+		t.Errorf("Create() = %v, %v", guid, err)
+	}
+	if fake.created.Name != "dev" || fake.created.Relationships.Organization.Data.GUID != "org-guid" {
+		t.Errorf("Create() sent %+v", fake.created)
+	}
+
+	if err := m.Update(context.TODO(), guid, v1alpha1.SpaceParameters{Name: "test"}); err != nil || fake.updated.Name != "test" {
+		t.Errorf("Update() = %v, sent %+v", err, fake.updated)
+	}
+
+	fake.err = notFoundError
+	if err := m.Delete(context.TODO(), guid); err != nil || fake.deleted != guid {
+		t.Errorf("Delete() of already deleted space = %v", err)
+	}
+}
@@ -0,0 +1,230 @@
+package environmentinstance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+)
+
+const (
+	errCreateFailed       = "Could not create EnvironmentInstance"
+	errUpdateFailed       = "Could not update EnvironmentInstance"
+	errUpdateLabelsFailed = "Could not update labels of EnvironmentInstance"
+	errIdNotFound         = "Could not find ID of the environment instance in .status.atProvider.id or the external name"
+	errParameterParsing   = ".spec.forProvider.parameters seem to be corrupted"
+	errServiceParsing     = "Parameters from service response seem to be corrupted"
+)
+
+type Client interface {
+	DescribeInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error)
+	CreateInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) (string, error)
+	UpdateInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) error
+	UpdateLabels(ctx context.Context, cr v1alpha1.EnvironmentInstance) error
+	DeleteInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) error
+}
+
+type EnvironmentInstances struct {
+	btp btp.Client
+}
+
+func NewEnvironmentInstances(btp btp.Client) *EnvironmentInstances {
+	return &EnvironmentInstances{btp: btp}
+}
+
+// DescribeInstance looks up the environment instance by its ID, which is used as external name
+func (c EnvironmentInstances) DescribeInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
+	return c.btp.GetEnvironmentById(ctx, meta.GetExternalName(&cr))
+}
+
+func (c EnvironmentInstances) CreateInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) (string, error) {
+	parameters, err := internal.UnmarshalRawParameters(cr.Spec.ForProvider.Parameters.Raw)
+	if err != nil {
+		return "", errors.Wrap(err, errParameterParsing)
+	}
+	id, err := c.btp.CreateEnvironment(
+		ctx,
+		environmentTypeOf(cr),
+		InstanceName(cr),
+		cr.Spec.ForProvider.PlanName,
+		cr.Spec.ForProvider.Landscape,
+		parameters,
+		c.btp.Credential.UserCredential.Email,
+	)
+	if err != nil {
+		return "", errors.Wrap(err, errCreateFailed)
+	}
+	return id, nil
+}
+
+func (c EnvironmentInstances) UpdateInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) error {
+	id := instanceID(cr)
+	if id == "" {
+		return errors.New(errIdNotFound)
+	}
+	parameters, err := internal.UnmarshalRawParameters(cr.Spec.ForProvider.Parameters.Raw)
+	if err != nil {
+		return errors.Wrap(err, errParameterParsing)
+	}
+	err = c.btp.UpdateEnvironment(ctx, id, cr.Spec.ForProvider.PlanName, parameters)
+	return errors.Wrap(err, errUpdateFailed)
+}
+
+func (c EnvironmentInstances) UpdateLabels(ctx context.Context, cr v1alpha1.EnvironmentInstance) error {
+	id := instanceID(cr)
+	if id == "" {
+		return errors.New(errIdNotFound)
+	}
+	err := c.btp.SetEnvironmentLabels(ctx, id, cr.Spec.ForProvider.Labels)
+	return errors.Wrap(err, errUpdateLabelsFailed)
+}
+
+// DeleteInstance deletes the environment instance, without any ID there is nothing left to delete
+func (c EnvironmentInstances) DeleteInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) error {
+	id := instanceID(cr)
+	if id == "" {
+		return nil
+	}
+	return c.btp.DeleteEnvironmentById(ctx, id)
+}
+
+// instanceID returns the observed ID of the environment instance, falling back to the external name it is looked up by
+func instanceID(cr v1alpha1.EnvironmentInstance) string {
+	if id := internal.Val(cr.Status.AtProvider.ID); id != "" {
+		return id
+	}
+	return meta.GetExternalName(&cr)
+}
+
+// InstanceName returns the name of the environment instance, which defaults to the name of the managed resource
+func InstanceName(cr v1alpha1.EnvironmentInstance) string {
+	if cr.Spec.ForProvider.InstanceName != "" {
+		return cr.Spec.ForProvider.InstanceName
+	}
+	return cr.Name
+}
+
+func environmentTypeOf(cr v1alpha1.EnvironmentInstance) btp.EnvironmentType {
+	return btp.EnvironmentType{
+		Identifier:  cr.Spec.ForProvider.EnvironmentType,
+		ServiceName: cr.Spec.ForProvider.ServiceName,
+	}
+}
+
+func GenerateObservation(environment *provisioningclient.BusinessEnvironmentInstanceResponseObject) v1alpha1.EnvironmentInstanceObservation {
+	observation := v1alpha1.EnvironmentInstanceObservation{}
+
+	if environment == nil {
+		return observation
+	}
+
+	observation.BrokerID = environment.BrokerId
+	observation.CommercialType = environment.CommercialType
+	if environment.CreatedDate != nil {
+		observation.CreatedDate = internal.Ptr(fmt.Sprintf("%f", *environment.CreatedDate))
+	}
+	observation.CustomLabels = environment.CustomLabels
+	observation.DashboardURL = environment.DashboardUrl
+	observation.Description = environment.Description
+	observation.EnvironmentType = environment.EnvironmentType
+	observation.GlobalAccountGUID = environment.GlobalAccountGUID
+	observation.ID = environment.Id
+	observation.Labels = environment.Labels
+	observation.LandscapeLabel = environment.LandscapeLabel
+	if environment.ModifiedDate != nil {
+		observation.ModifiedDate = internal.Ptr(fmt.Sprintf("%f", *environment.ModifiedDate))
+	}
+	observation.Name = environment.Name
+	observation.Operation = environment.Operation
+	observation.Parameters = environment.Parameters
+	observation.PlanID = environment.PlanId
+	observation.PlanName = environment.PlanName
+	observation.PlatformID = environment.PlatformId
+	observation.ServiceID = environment.ServiceId
+	observation.ServiceName = environment.ServiceName
+	observation.State = environment.State
+	observation.StateMessage = environment.StateMessage
+	observation.SubaccountGUID = environment.SubaccountGUID
+	observation.TenantID = environment.TenantId
+	observation.Type = environment.Type
+
+	return observation
+}
+
+// GetConnectionDetails publishes the broker labels of the instance, every label becomes a key of the connection
+// secret. Labels which aren't strings are stored as JSON.
+func GetConnectionDetails(instance *provisioningclient.BusinessEnvironmentInstanceResponseObject) (managed.ConnectionDetails, error) {
+	details := managed.ConnectionDetails{}
+	if instance == nil || instance.Labels == nil || *instance.Labels == "" {
+		return details, nil
+	}
+
+	labels := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(*instance.Labels), &labels); err != nil {
+		return nil, err
+	}
+	for k, v := range labels {
+		if s, ok := v.(string); ok {
+			details[k] = []byte(s)
+			continue
+		}
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		details[k] = raw
+	}
+	return details, nil
+}
+
+// ParametersDiff lists the keys of the spec parameters which differ from the observed ones. Parameters that are
+// only set on the instance, e.g. defaults added by the broker, are ignored.
+func ParametersDiff(cr v1alpha1.EnvironmentInstance) ([]string, error) {
+	desired, err := internal.UnmarshalRawParameters(cr.Spec.ForProvider.Parameters.Raw)
+	if err != nil {
+		return nil, errors.Wrap(err, errParameterParsing)
+	}
+	current := map[string]interface{}{}
+	if cr.Status.AtProvider.Parameters != nil {
+		current, err = internal.UnmarshalRawParameters([]byte(*cr.Status.AtProvider.Parameters))
+		if err != nil {
+			return nil, errors.Wrap(err, errServiceParsing)
+		}
+	}
+
+	var diff []string
+	for k, v := range desired {
+		if !reflect.DeepEqual(v, current[k]) {
+			diff = append(diff, k)
+		}
+	}
+	sort.Strings(diff)
+	return diff, nil
+}
+
+// PlanNeedsUpdate reports if the plan of the spec differs from the observed one
+func PlanNeedsUpdate(cr v1alpha1.EnvironmentInstance) bool {
+	return cr.Status.AtProvider.PlanName != nil && *cr.Status.AtProvider.PlanName != cr.Spec.ForProvider.PlanName
+}
+
+// LabelsNeedUpdate compares the custom labels of spec and status, labels are only managed if set in the spec
+func LabelsNeedUpdate(cr v1alpha1.EnvironmentInstance) bool {
+	if cr.Spec.ForProvider.Labels == nil {
+		return false
+	}
+	var observed map[string][]string
+	if cr.Status.AtProvider.CustomLabels != nil {
+		observed = *cr.Status.AtProvider.CustomLabels
+	}
+	return !internal.LabelsEqual(cr.Spec.ForProvider.Labels, observed)
+}
@@ -0,0 +1,175 @@
+package environmentinstance
+
+import (
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+)
+
+func TestGetConnectionDetails(t *testing.T) {
+	tests := map[string]struct {
+		labels *string
+
+		wantDetails managed.ConnectionDetails
+		wantErr     bool
+	}{
+		"NoLabels": {
+			wantDetails: managed.ConnectionDetails{},
+		},
+		"StringLabels": {
+			labels: internal.Ptr(`{"API Endpoint": "https://api.example.com", "Org Name": "org"}`),
+			wantDetails: managed.ConnectionDetails{
+				"API Endpoint": []byte("https://api.example.com"),
+				"Org Name":     []byte("org"),
+			},
+		},
+		"StructuredLabels": {
+			labels: internal.Ptr(`{"url": "https://abap.example.com", "ports": [443], "tls": true}`),
+			wantDetails: managed.ConnectionDetails{
+				"url":   []byte("https://abap.example.com"),
+				"ports": []byte("[443]"),
+				"tls":   []byte("true"),
+			},
+		},
+		"CorruptedLabels": {
+			labels:  internal.Ptr(`}corrupted{`),
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			details, err := GetConnectionDetails(&provisioningclient.BusinessEnvironmentInstanceResponseObject{Labels: tc.labels})
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("GetConnectionDetails(...): want error %v, got %v", tc.wantErr, err)
+			}
+			if diff := cmp.Diff(tc.wantDetails, details); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParametersDiff(t *testing.T) {
+	tests := map[string]struct {
+		desired  string
+		observed *string
+
+		wantDiff []string
+		wantErr  error
+	}{
+		"NothingSet": {},
+		"Equal": {
+			desired:  `{"size_of_runtime": 1, "admin_email": "admin@example.com"}`,
+			observed: internal.Ptr(`{"size_of_runtime": 1, "admin_email": "admin@example.com"}`),
+		},
+		"BrokerDefaultsIgnored": {
+			desired:  `size_of_runtime: 1`,
+			observed: internal.Ptr(`{"size_of_runtime": 1, "is_development_allowed": true}`),
+		},
+		"Changed": {
+			desired:  `{"size_of_runtime": 2, "sapsystemname": "H01", "admin_email": "admin@example.com"}`,
+			observed: internal.Ptr(`{"size_of_runtime": 1, "admin_email": "admin@example.com"}`),
+			wantDiff: []string{"sapsystemname", "size_of_runtime"},
+		},
+		"CorruptedResponse": {
+			desired:  `{"size_of_runtime": 1}`,
+			observed: internal.Ptr(`corrupted`),
+			wantErr: errors.Wrap(
+				errors.New("error unmarshaling JSON: while decoding JSON: json: cannot unmarshal string into Go value of type map[string]interface {}"),
+				errServiceParsing,
+			),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := v1alpha1.EnvironmentInstance{}
+			cr.Spec.ForProvider.Parameters = runtime.RawExtension{Raw: []byte(tc.desired)}
+			cr.Status.AtProvider.Parameters = tc.observed
+
+			diff, err := ParametersDiff(cr)
+			if d := cmp.Diff(tc.wantErr, err, test.EquateErrors()); d != "" {
+				t.Errorf("ParametersDiff(...): -want error, +got error:\n%s", d)
+			}
+			if d := cmp.Diff(tc.wantDiff, diff); d != "" {
+				t.Errorf("ParametersDiff(...): -want, +got:\n%s", d)
+			}
+		})
+	}
+}
+
+func TestLabelsNeedUpdate(t *testing.T) {
+	tests := map[string]struct {
+		desired  map[string][]string
+		observed *map[string][]string
+		want     bool
+	}{
+		"Unmanaged": {
+			observed: &map[string][]string{"team": {"a"}},
+		},
+		"Equal": {
+			desired:  map[string][]string{"team": {"a"}},
+			observed: &map[string][]string{"team": {"a"}},
+		},
+		"Changed": {
+			desired:  map[string][]string{"team": {"b"}},
+			observed: &map[string][]string{"team": {"a"}},
+			want:     true,
+		},
+		"RemoveAll": {
+			desired:  map[string][]string{},
+			observed: &map[string][]string{"team": {"a"}},
+			want:     true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := v1alpha1.EnvironmentInstance{}
+			cr.Spec.ForProvider.Labels = tc.desired
+			cr.Status.AtProvider.CustomLabels = tc.observed
+			if got := LabelsNeedUpdate(cr); got != tc.want {
+				t.Errorf("LabelsNeedUpdate(...): want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestInstanceID(t *testing.T) {
+	tests := map[string]struct {
+		cr v1alpha1.EnvironmentInstance
+
+		wantID string
+	}{
+		"Unknown": {},
+		"Observed": {
+			cr:     instanceWith(internal.Ptr("observed-id"), "external-id"),
+			wantID: "observed-id",
+		},
+		"ExternalName": {
+			cr:     instanceWith(nil, "external-id"),
+			wantID: "external-id",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := instanceID(tc.cr); got != tc.wantID {
+				t.Errorf("instanceID(...): want %q, got %q", tc.wantID, got)
+			}
+		})
+	}
+}
+
+func instanceWith(id *string, externalName string) v1alpha1.EnvironmentInstance {
+	cr := v1alpha1.EnvironmentInstance{}
+	cr.Status.AtProvider.ID = id
+	meta.SetExternalName(&cr, externalName)
+	return cr
+}
@@ -2,12 +2,18 @@ package environments
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	yamlv3 "gopkg.in/yaml.v3"
 
 	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
 
@@ -23,6 +29,12 @@ type Client interface {
 	)
 	CreateInstance(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, error)
 	UpdateInstance(ctx context.Context, cr v1alpha1.KymaEnvironment) error
+	UpdateLabels(ctx context.Context, cr v1alpha1.KymaEnvironment) error
+	CheckAvailability(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, error)
+	ParameterSchemas(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, string, error)
+	RevokeBindings(ctx context.Context, cr v1alpha1.KymaEnvironment) error
+	CreateClusterBinding(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, []byte, error)
+	RevokeBinding(ctx context.Context, cr v1alpha1.KymaEnvironment, bindingId string) error
 	DeleteInstance(ctx context.Context, cr v1alpha1.KymaEnvironment) error
 }
 
@@ -112,3 +124,70 @@ func readKubeconfigFromUrl(url string, httpClient *http.Client) ([]byte, error)
 
 	return data, nil
 }
+
+type kubeconfigUsers struct {
+	Users []struct {
+		User struct {
+			Token                 string `yaml:"token"`
+			ClientCertificateData string `yaml:"client-certificate-data"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// KubeconfigExpiry returns the earliest expiry of the bearer tokens and client certificates embedded into the
+// kubeconfig. It is nil if the credentials don't expire, e.g. for exec based logins, or can't be parsed.
+func KubeconfigExpiry(kubeconfig []byte) *time.Time {
+	config := kubeconfigUsers{}
+	if err := yamlv3.Unmarshal(kubeconfig, &config); err != nil {
+		return nil
+	}
+
+	var earliest *time.Time
+	for _, u := range config.Users {
+		for _, expiry := range []*time.Time{tokenExpiry(u.User.Token), certificateExpiry(u.User.ClientCertificateData)} {
+			if expiry != nil && (earliest == nil || expiry.Before(*earliest)) {
+				earliest = expiry
+			}
+		}
+	}
+	return earliest
+}
+
+// tokenExpiry reads the exp claim of a JWT, the signature isn't verified
+func tokenExpiry(token string) *time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil
+	}
+	claims := struct {
+		Exp *float64 `json:"exp"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == nil {
+		return nil
+	}
+	expiry := time.Unix(int64(*claims.Exp), 0)
+	return &expiry
+}
+
+func certificateExpiry(data string) *time.Time {
+	if data == "" {
+		return nil
+	}
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil
+	}
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil
+	}
+	return &cert.NotAfter
+}
@@ -0,0 +1,99 @@
+package environments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	errParseKubeconfig     = "Could not parse kubeconfig of kyma cluster"
+	errCreateClusterClient = "Could not create client for kyma cluster"
+	errListResources       = "Could not list %s resources in kyma cluster"
+	errDeleteResource      = "Could not delete %s in kyma cluster"
+)
+
+// operatorResources are the resources of the BTP service operator, bindings come first as the operator only deletes
+// service instances without bindings
+var operatorResources = []struct {
+	kind     string
+	resource schema.GroupVersionResource
+}{
+	{kind: "ServiceBinding", resource: schema.GroupVersionResource{Group: "services.cloud.sap.com", Version: "v1", Resource: "servicebindings"}},
+	{kind: "ServiceInstance", resource: schema.GroupVersionResource{Group: "services.cloud.sap.com", Version: "v1", Resource: "serviceinstances"}},
+}
+
+// OperatorResource identifies a resource of the BTP service operator within a kyma cluster
+type OperatorResource struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (r OperatorResource) String() string {
+	return fmt.Sprintf("%s %s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+// ClusterClient accesses the kubernetes cluster of a kyma environment
+type ClusterClient interface {
+	ListOperatorResources(ctx context.Context) ([]OperatorResource, error)
+	DeleteOperatorResource(ctx context.Context, r OperatorResource) error
+}
+
+var _ ClusterClient = &KymaCluster{}
+
+type KymaCluster struct {
+	client dynamic.Interface
+}
+
+// NewKymaCluster connects to the cluster of a kyma environment with the given kubeconfig
+func NewKymaCluster(kubeconfig []byte) (ClusterClient, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseKubeconfig)
+	}
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateClusterClient)
+	}
+	return &KymaCluster{client: client}, nil
+}
+
+// ListOperatorResources lists the ServiceBindings and ServiceInstances of all namespaces, none are found if the BTP
+// service operator isn't installed
+func (c KymaCluster) ListOperatorResources(ctx context.Context) ([]OperatorResource, error) {
+	var resources []OperatorResource
+	for _, r := range operatorResources {
+		list, err := c.client.Resource(r.resource).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, errListResources, r.kind)
+		}
+		for _, item := range list.Items {
+			resources = append(resources, OperatorResource{Kind: r.kind, Namespace: item.GetNamespace(), Name: item.GetName()})
+		}
+	}
+	return resources, nil
+}
+
+func (c KymaCluster) DeleteOperatorResource(ctx context.Context, r OperatorResource) error {
+	for _, known := range operatorResources {
+		if known.kind != r.Kind {
+			continue
+		}
+		err := c.client.Resource(known.resource).Namespace(r.Namespace).Delete(ctx, r.Name, metav1.DeleteOptions{})
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, errDeleteResource, r)
+	}
+	return errors.Errorf(errDeleteResource, r)
+}
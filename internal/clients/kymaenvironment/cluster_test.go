@@ -0,0 +1,67 @@
+package environments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func operatorObject(kind string, namespace string, name string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(schema.GroupVersionKind{Group: "services.cloud.sap.com", Version: "v1", Kind: kind})
+	u.SetNamespace(namespace)
+	u.SetName(name)
+	return u
+}
+
+func TestKymaCluster(t *testing.T) {
+	listKinds := map[schema.GroupVersionResource]string{}
+	for _, r := range operatorResources {
+		listKinds[r.resource] = r.kind + "List"
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds,
+		operatorObject("ServiceInstance", "team-a", "xsuaa"),
+		operatorObject("ServiceBinding", "team-a", "xsuaa-binding"),
+		operatorObject("ServiceInstance", "team-b", "destination"),
+	)
+	cluster := KymaCluster{client: client}
+	ctx := context.Background()
+
+	resources, err := cluster.ListOperatorResources(ctx)
+	if err != nil {
+		t.Fatalf("ListOperatorResources(...): unexpected error %v", err)
+	}
+	want := []OperatorResource{
+		{Kind: "ServiceBinding", Namespace: "team-a", Name: "xsuaa-binding"},
+		{Kind: "ServiceInstance", Namespace: "team-a", Name: "xsuaa"},
+		{Kind: "ServiceInstance", Namespace: "team-b", Name: "destination"},
+	}
+	if diff := cmp.Diff(want, resources); diff != "" {
+		t.Errorf("ListOperatorResources(...): -want, +got:\n%s", diff)
+	}
+
+	for _, r := range resources {
+		if err := cluster.DeleteOperatorResource(ctx, r); err != nil {
+			t.Fatalf("DeleteOperatorResource(%s): unexpected error %v", r, err)
+		}
+	}
+	if err := cluster.DeleteOperatorResource(ctx, resources[0]); err != nil {
+		t.Errorf("DeleteOperatorResource(...): deleting twice should be no error, got %v", err)
+	}
+	if err := cluster.DeleteOperatorResource(ctx, OperatorResource{Kind: "Secret", Namespace: "team-a", Name: "xsuaa"}); err == nil {
+		t.Errorf("DeleteOperatorResource(...): want error for unknown kind")
+	}
+
+	resources, err = cluster.ListOperatorResources(ctx)
+	if err != nil {
+		t.Fatalf("ListOperatorResources(...): unexpected error %v", err)
+	}
+	if len(resources) != 0 {
+		t.Errorf("ListOperatorResources(...): want no resources after deletion, got %v", resources)
+	}
+}
@@ -10,6 +10,10 @@ import (
 type MockProvisioningServiceClient struct {
 	err         error
 	apiResponse *client.BusinessEnvironmentInstancesResponseCollection
+
+	// for verification
+	labelsAssigned bool
+	labelsDeleted  bool
 }
 
 // CreateEnvironmentInstance implements openapi.EnvironmentsAPI.
@@ -34,12 +38,13 @@ func (m *MockProvisioningServiceClient) CreateEnvironmentInstanceExecute(r clien
 
 // CreateEnvironmentInstanceLabels implements openapi.EnvironmentsAPI.
 func (m *MockProvisioningServiceClient) CreateEnvironmentInstanceLabels(ctx context.Context, environmentInstanceId string) client.ApiCreateEnvironmentInstanceLabelsRequest {
-	panic("unimplemented")
+	return client.ApiCreateEnvironmentInstanceLabelsRequest{ApiService: m}
 }
 
 // CreateEnvironmentInstanceLabelsExecute implements openapi.EnvironmentsAPI.
 func (m *MockProvisioningServiceClient) CreateEnvironmentInstanceLabelsExecute(r client.ApiCreateEnvironmentInstanceLabelsRequest) (*client.LabelsResponseObject, *http.Response, error) {
-	panic("unimplemented")
+	m.labelsAssigned = true
+	return &client.LabelsResponseObject{}, nil, m.err
 }
 
 // DeleteEnvironmentInstance implements openapi.EnvironmentsAPI.
@@ -64,12 +69,13 @@ func (m *MockProvisioningServiceClient) DeleteEnvironmentInstanceExecute(r clien
 
 // DeleteEnvironmentInstanceLabels implements openapi.EnvironmentsAPI.
 func (m *MockProvisioningServiceClient) DeleteEnvironmentInstanceLabels(ctx context.Context, environmentInstanceId string) client.ApiDeleteEnvironmentInstanceLabelsRequest {
-	panic("unimplemented")
+	return client.ApiDeleteEnvironmentInstanceLabelsRequest{ApiService: m}
 }
 
 // DeleteEnvironmentInstanceLabelsExecute implements openapi.EnvironmentsAPI.
 func (m *MockProvisioningServiceClient) DeleteEnvironmentInstanceLabelsExecute(r client.ApiDeleteEnvironmentInstanceLabelsRequest) (*client.LabelsResponseObject, *http.Response, error) {
-	panic("unimplemented")
+	m.labelsDeleted = true
+	return &client.LabelsResponseObject{}, nil, m.err
 }
 
 // DeleteEnvironmentInstances implements openapi.EnvironmentsAPI.
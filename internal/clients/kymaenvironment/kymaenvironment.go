@@ -2,21 +2,37 @@ package environments
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
 
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
 
 	"github.com/sap/crossplane-provider-btp/internal"
 	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
 
 	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/clients/kymaenvironmentbinding"
 )
 
 const (
 	errKymaInstanceCreateFailed = "Could not create KymaEnvironment"
 	errKymaInstanceUpdateFailed = "Could not update KymaEnvironment"
+	errKymaLabelsUpdateFailed   = "Could not update labels of KymaEnvironment"
+	errKymaBindingsRevokeFailed = "Could not revoke bindings of KymaEnvironment"
 	errInstanceIdNotFound       = "Could not update kyma instance .status.AtProvider.Id is empty"
+	errClusterBindingEmpty      = "Binding of KymaEnvironment contains no kubeconfig"
+
+	// clusterBindingTTL is the lifetime in seconds of the bindings the provider accesses the cluster with, the
+	// minimum the broker accepts
+	clusterBindingTTL = 600
 )
 
 type KymaEnvironments struct {
@@ -100,6 +116,146 @@ func (c KymaEnvironments) UpdateInstance(ctx context.Context, cr v1alpha1.KymaEn
 	return errors.Wrap(err, errKymaInstanceUpdateFailed)
 }
 
+// UpdateLabels replaces the custom labels of the environment with the ones of the spec
+func (c KymaEnvironments) UpdateLabels(ctx context.Context, cr v1alpha1.KymaEnvironment) error {
+	if cr.Status.AtProvider.ID == nil {
+		return errors.New(errInstanceIdNotFound)
+	}
+	err := c.btp.SetEnvironmentLabels(ctx, *cr.Status.AtProvider.ID, internal.Val(cr.Spec.ForProvider.Labels))
+	return errors.Wrap(err, errKymaLabelsUpdateFailed)
+}
+
+// RevokeBindings deletes all bindings of the environment, also the ones not created by a KymaEnvironmentBinding
+func (c KymaEnvironments) RevokeBindings(ctx context.Context, cr v1alpha1.KymaEnvironment) error {
+	if cr.Status.AtProvider.ID == nil {
+		return errors.New(errInstanceIdNotFound)
+	}
+	bindings, _, err := c.btp.ProvisioningServiceClient.GetAllEnvironmentInstanceBindings(ctx, *cr.Status.AtProvider.ID).Execute()
+	if err != nil {
+		return errors.Wrap(err, errKymaBindingsRevokeFailed)
+	}
+	if bindings == nil {
+		return nil
+	}
+	for _, binding := range bindings.Bindings {
+		if binding.BindingId == nil {
+			continue
+		}
+		_, raw, err := c.btp.ProvisioningServiceClient.DeleteEnvironmentInstanceBinding(ctx, *cr.Status.AtProvider.ID, *binding.BindingId).Execute()
+		if err != nil && (raw == nil || raw.StatusCode != http.StatusNotFound) {
+			return errors.Wrap(err, errKymaBindingsRevokeFailed)
+		}
+	}
+	return nil
+}
+
+// CreateClusterBinding creates a short-lived binding of the environment and returns its ID and kubeconfig. Unlike the
+// kubeconfig of the connection secret, which logs in interactively via OIDC, it authenticates with a service account
+// token and can be used by the provider itself.
+func (c KymaEnvironments) CreateClusterBinding(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, []byte, error) {
+	if cr.Status.AtProvider.ID == nil {
+		return "", nil, errors.New(errInstanceIdNotFound)
+	}
+	binding, err := kymaenvironmentbinding.NewKymaBindings(c.btp).CreateInstance(ctx, *cr.Status.AtProvider.ID, clusterBindingTTL)
+	if err != nil {
+		return "", nil, err
+	}
+	if binding.Credentials == nil || binding.Credentials.Kubeconfig == "" {
+		return "", nil, errors.New(errClusterBindingEmpty)
+	}
+	id := ""
+	if binding.Metadata != nil {
+		id = binding.Metadata.Id
+	}
+	return id, []byte(binding.Credentials.Kubeconfig), nil
+}
+
+// RevokeBinding deletes a single binding of the environment, a binding which is gone already is ignored
+func (c KymaEnvironments) RevokeBinding(ctx context.Context, cr v1alpha1.KymaEnvironment, bindingId string) error {
+	if cr.Status.AtProvider.ID == nil {
+		return errors.New(errInstanceIdNotFound)
+	}
+	if bindingId == "" {
+		return nil
+	}
+	return kymaenvironmentbinding.NewKymaBindings(c.btp).DeleteInstances(ctx, []v1alpha1.Binding{{Id: bindingId}}, *cr.Status.AtProvider.ID)
+}
+
+// CheckAvailability explains why the plan of the spec can't be created in the subaccount, empty if it is available
+func (c KymaEnvironments) CheckAvailability(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, error) {
+	return c.btp.CheckEnvironmentAvailability(ctx, btp.KymaEnvironmentType(), cr.Spec.ForProvider.PlanName, "")
+}
+
+// ParameterSchemas returns the JSON schemas of the parameters on creation and on update of the plan of the spec
+func (c KymaEnvironments) ParameterSchemas(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, string, error) {
+	return c.btp.GetEnvironmentSchemas(ctx, btp.KymaEnvironmentType(), cr.Spec.ForProvider.PlanName)
+}
+
+// ValidateUpdate lists the reasons why the broker would reject updating the current parameters to the desired ones.
+// The desired parameters have to satisfy the create schema and every changed parameter has to be part of the update
+// schema. Parameters only set on the instance aren't checked, the broker keeps them anyway. Unusable schemas are not
+// enforced.
+func ValidateUpdate(createSchema string, updateSchema string, desired map[string]interface{}, current map[string]interface{}) []string {
+	var issues []string
+	if schema := parseSchema(createSchema); schema != nil {
+		result := validate.NewSchemaValidator(schema, nil, "parameters", strfmt.Default).Validate(desired)
+		for _, e := range result.Errors {
+			issues = append(issues, e.Error())
+		}
+	}
+
+	schema := parseSchema(updateSchema)
+	if schema == nil || len(schema.Properties) == 0 {
+		return issues
+	}
+	keys := make([]string, 0, len(desired))
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if _, updatable := schema.Properties[k]; updatable || reflect.DeepEqual(desired[k], current[k]) {
+			continue
+		}
+		issues = append(issues, fmt.Sprintf("parameters.%s can't be changed on an existing environment", k))
+	}
+	return issues
+}
+
+// parseSchema reads a plan schema, which the broker may wrap into a parameters object
+func parseSchema(raw string) *spec.Schema {
+	if raw == "" {
+		return nil
+	}
+	var wrapper struct {
+		Parameters json.RawMessage `json:"parameters"`
+	}
+	if err := json.Unmarshal([]byte(raw), &wrapper); err != nil {
+		return nil
+	}
+	data := []byte(raw)
+	if len(wrapper.Parameters) > 0 && wrapper.Parameters[0] == '{' {
+		data = wrapper.Parameters
+	}
+	var schema spec.Schema
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil
+	}
+	return &schema
+}
+
+// LabelsNeedUpdate compares the custom labels of spec and status, labels aren't managed if unset in the spec
+func LabelsNeedUpdate(cr v1alpha1.KymaEnvironment) bool {
+	if cr.Spec.ForProvider.Labels == nil {
+		return false
+	}
+	var observed map[string][]string
+	if cr.Status.AtProvider.CustomLabels != nil {
+		observed = *cr.Status.AtProvider.CustomLabels
+	}
+	return !internal.LabelsEqual(*cr.Spec.ForProvider.Labels, observed)
+}
+
 func AddKymaDefaultParameters(parameters btp.InstanceParameters, instanceName string, resourceUID string) btp.InstanceParameters {
 	parameters[btp.KymaenvironmentParameterInstanceName] = instanceName
 	return parameters
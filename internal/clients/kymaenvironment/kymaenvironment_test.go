@@ -2,7 +2,16 @@ package environments
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
@@ -120,3 +129,206 @@ func TestEnvironmentsApiHandler_GetEnvironments(t *testing.T) {
 		})
 	}
 }
+
+func TestLabelsNeedUpdate(t *testing.T) {
+	tests := map[string]struct {
+		spec     *map[string][]string
+		observed *map[string][]string
+		want     bool
+	}{
+		"NotManaged": {
+			observed: &map[string][]string{"costCenter": {"1"}},
+			want:     false,
+		},
+		"InSync": {
+			spec:     &map[string][]string{"costCenter": {"1"}},
+			observed: &map[string][]string{"costCenter": {"1"}},
+			want:     false,
+		},
+		"Added": {
+			spec: &map[string][]string{"costCenter": {"1"}},
+			want: true,
+		},
+		"Drifted": {
+			spec:     &map[string][]string{"costCenter": {"1"}},
+			observed: &map[string][]string{"costCenter": {"2"}},
+			want:     true,
+		},
+		"AllRemoved": {
+			spec:     &map[string][]string{},
+			observed: &map[string][]string{"costCenter": {"1"}},
+			want:     true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := v1alpha1.KymaEnvironment{
+				Spec:   v1alpha1.KymaEnvironmentSpec{ForProvider: v1alpha1.KymaEnvironmentParameters{Labels: tc.spec}},
+				Status: v1alpha1.KymaEnvironmentStatus{AtProvider: v1alpha1.KymaEnvironmentObservation{EnvironmentObservation: v1alpha1.EnvironmentObservation{CustomLabels: tc.observed}}},
+			}
+			if got := LabelsNeedUpdate(cr); got != tc.want {
+				t.Errorf("LabelsNeedUpdate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUpdateLabels(t *testing.T) {
+	tests := map[string]struct {
+		labels      *map[string][]string
+		id          *string
+		wantErr     bool
+		wantAssign  bool
+		wantDeleted bool
+	}{
+		"NoID": {
+			labels:  &map[string][]string{"costCenter": {"1"}},
+			wantErr: true,
+		},
+		"Assign": {
+			labels:     &map[string][]string{"costCenter": {"1"}},
+			id:         internal.Ptr("1234"),
+			wantAssign: true,
+		},
+		"RemoveAll": {
+			labels:      &map[string][]string{},
+			id:          internal.Ptr("1234"),
+			wantDeleted: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			api := &MockProvisioningServiceClient{}
+			uut := KymaEnvironments{btp: btp.Client{ProvisioningServiceClient: api}}
+			cr := v1alpha1.KymaEnvironment{
+				Spec:   v1alpha1.KymaEnvironmentSpec{ForProvider: v1alpha1.KymaEnvironmentParameters{Labels: tc.labels}},
+				Status: v1alpha1.KymaEnvironmentStatus{AtProvider: v1alpha1.KymaEnvironmentObservation{EnvironmentObservation: v1alpha1.EnvironmentObservation{ID: tc.id}}},
+			}
+			err := uut.UpdateLabels(context.TODO(), cr)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("UpdateLabels() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if api.labelsAssigned != tc.wantAssign || api.labelsDeleted != tc.wantDeleted {
+				t.Errorf("UpdateLabels() assigned %v deleted %v, want %v %v", api.labelsAssigned, api.labelsDeleted, tc.wantAssign, tc.wantDeleted)
+			}
+		})
+	}
+}
+
+func TestValidateUpdate(t *testing.T) {
+	const createSchema = `{"parameters": {"type": "object", "required": ["name", "region"], "properties": {
+		"name": {"type": "string"},
+		"region": {"type": "string", "enum": ["eu-central-1", "us-east-1"]},
+		"machineType": {"type": "string"},
+		"autoScalerMax": {"type": "integer", "minimum": 3, "maximum": 300}
+	}}}`
+	const updateSchema = `{"parameters": {"type": "object", "properties": {
+		"name": {"type": "string"},
+		"machineType": {"type": "string"},
+		"autoScalerMax": {"type": "integer", "minimum": 3, "maximum": 300}
+	}}}`
+	current := map[string]interface{}{"name": "kyma", "region": "eu-central-1", "autoScalerMax": float64(5)}
+
+	tests := map[string]struct {
+		createSchema string
+		updateSchema string
+		desired      map[string]interface{}
+		want         []string
+	}{
+		"Updatable": {
+			createSchema: createSchema,
+			updateSchema: updateSchema,
+			desired:      map[string]interface{}{"name": "kyma", "region": "eu-central-1", "autoScalerMax": float64(10), "machineType": "m6i.xlarge"},
+		},
+		"NotUpdatable": {
+			createSchema: createSchema,
+			updateSchema: updateSchema,
+			desired:      map[string]interface{}{"name": "kyma", "region": "us-east-1", "autoScalerMax": float64(5)},
+			want:         []string{"parameters.region can't be changed on an existing environment"},
+		},
+		"Invalid": {
+			createSchema: createSchema,
+			updateSchema: updateSchema,
+			desired:      map[string]interface{}{"name": "kyma", "region": "eu-central-1", "autoScalerMax": float64(500)},
+			want:         []string{"parameters.autoScalerMax in body should be less than or equal to 300"},
+		},
+		"UnwrappedSchema": {
+			updateSchema: `{"type": "object", "properties": {"autoScalerMax": {"type": "integer"}}}`,
+			desired:      map[string]interface{}{"name": "kyma2", "region": "eu-central-1", "autoScalerMax": float64(5)},
+			want:         []string{"parameters.name can't be changed on an existing environment"},
+		},
+		"NoSchemas": {
+			desired: map[string]interface{}{"name": "kyma", "region": "us-east-1"},
+		},
+		"UnusableSchemas": {
+			createSchema: `}corrupted{`,
+			updateSchema: `}corrupted{`,
+			desired:      map[string]interface{}{"name": "kyma", "region": "us-east-1"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ValidateUpdate(tc.createSchema, tc.updateSchema, tc.desired, current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ValidateUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKubeconfigExpiry(t *testing.T) {
+	tokenExpiry := time.Unix(2000000000, 0)
+	certExpiry := time.Unix(1900000000, 0)
+
+	tests := map[string]struct {
+		kubeconfig string
+		want       *time.Time
+	}{
+		"ExecLogin": {
+			kubeconfig: "users:\n- name: oidc\n  user:\n    exec:\n      command: kubectl-oidc_login\n",
+		},
+		"Token": {
+			kubeconfig: fmt.Sprintf("users:\n- name: sa\n  user:\n    token: %s\n", testToken(t, tokenExpiry)),
+			want:       &tokenExpiry,
+		},
+		"TokenWithoutExpiry": {
+			kubeconfig: fmt.Sprintf("users:\n- name: sa\n  user:\n    token: %s\n", "header."+base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"sa"}`))+".signature"),
+		},
+		"EarliestOfTokenAndCertificate": {
+			kubeconfig: fmt.Sprintf("users:\n- name: sa\n  user:\n    token: %s\n- name: cert\n  user:\n    client-certificate-data: %s\n",
+				testToken(t, tokenExpiry), testCertificate(t, certExpiry)),
+			want: &certExpiry,
+		},
+		"Corrupted": {
+			kubeconfig: "}corrupted{",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := KubeconfigExpiry([]byte(tc.kubeconfig))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("KubeconfigExpiry(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func testToken(t *testing.T, expiry time.Time) string {
+	t.Helper()
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"sa","exp":%d}`, expiry.Unix())))
+	return "eyJhbGciOiJSUzI1NiJ9." + payload + ".signature"
+}
+
+func testCertificate(t *testing.T, expiry time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), NotBefore: expiry.Add(-time.Hour), NotAfter: expiry}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
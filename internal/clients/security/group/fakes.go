@@ -0,0 +1,44 @@
+package group
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/sap/crossplane-provider-btp/internal"
+	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
+)
+
+var (
+	internalServerError = errors.New("internal server error")
+)
+
+// scimGroupApiFake returns the stubbed groups on search
+type scimGroupApiFake struct {
+	groups []map[string]interface{}
+	err    error
+}
+
+var _ xsuaa.ScimGroupEndpointsAPI = &scimGroupApiFake{}
+
+func (s *scimGroupApiFake) CreateGroup(ctx context.Context) xsuaa.ScimGroupEndpointsAPICreateGroupRequest {
+	return xsuaa.ScimGroupEndpointsAPICreateGroupRequest{ApiService: s}
+}
+
+func (s *scimGroupApiFake) CreateGroupExecute(r xsuaa.ScimGroupEndpointsAPICreateGroupRequest) (*xsuaa.ScimGroup, *http.Response, error) {
+	if s.err != nil {
+		return nil, &http.Response{StatusCode: http.StatusInternalServerError}, s.err
+	}
+	return &xsuaa.ScimGroup{Id: internal.Ptr("created-id")}, &http.Response{StatusCode: http.StatusCreated}, nil
+}
+
+func (s *scimGroupApiFake) ListGroups(ctx context.Context) xsuaa.ScimGroupEndpointsAPIListGroupsRequest {
+	return xsuaa.ScimGroupEndpointsAPIListGroupsRequest{ApiService: s}
+}
+
+func (s *scimGroupApiFake) ListGroupsExecute(r xsuaa.ScimGroupEndpointsAPIListGroupsRequest) (*xsuaa.SearchResultsObject, *http.Response, error) {
+	if s.err != nil {
+		return nil, &http.Response{StatusCode: http.StatusInternalServerError}, s.err
+	}
+	return &xsuaa.SearchResultsObject{Resources: s.groups}, &http.Response{StatusCode: http.StatusOK}, nil
+}
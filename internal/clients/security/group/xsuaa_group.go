@@ -0,0 +1,110 @@
+package group
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const errMultipleGroups = "found more than one group for displayName"
+
+// NewXsuaaGroupMaintainer initializes new XsuaaGroupMaintainer with auth configuration
+func NewXsuaaGroupMaintainer(ctx context.Context, clientId, clientSecret, tokenUrl, apiUrl string) *XsuaaGroupMaintainer {
+	config := clientcredentials.Config{
+		ClientID:     clientId,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenUrl,
+	}
+
+	smURL, _ := url.Parse(apiUrl)
+
+	apiClientConfig := xsuaa.NewConfiguration()
+	apiClientConfig.Host = smURL.Host
+	apiClientConfig.Scheme = smURL.Scheme
+	apiClientConfig.HTTPClient = config.Client(ctx)
+
+	return &XsuaaGroupMaintainer{
+		scimApi: xsuaa.NewAPIClient(apiClientConfig).ScimGroupEndpointsAPI,
+	}
+}
+
+// XsuaaGroupMaintainer manages groups within XSUAA using the SCIM endpoints
+type XsuaaGroupMaintainer struct {
+	scimApi xsuaa.ScimGroupEndpointsAPI
+}
+
+// GenerateObservation looks up the group by its displayName, returns an empty observation if it does not exist
+func (x *XsuaaGroupMaintainer) GenerateObservation(ctx context.Context, displayName string) (v1alpha1.GroupObservation, error) {
+	res, _, err := x.scimApi.ListGroups(ctx).Filter(groupFilter(displayName)).Execute()
+	if err != nil {
+		return v1alpha1.GroupObservation{}, err
+	}
+
+	groups, err := mapSearchResults(res)
+	if err != nil {
+		return v1alpha1.GroupObservation{}, err
+	}
+	switch len(groups) {
+	case 0:
+		return v1alpha1.GroupObservation{}, nil
+	case 1:
+		return mapObservation(groups[0]), nil
+	default:
+		return v1alpha1.GroupObservation{}, errors.New(errMultipleGroups)
+	}
+}
+
+// NeedsCreation checks if the group has been found in the external system
+func (x *XsuaaGroupMaintainer) NeedsCreation(observation v1alpha1.GroupObservation) bool {
+	return observation.ID == nil
+}
+
+// Create creates the group and returns its ID
+func (x *XsuaaGroupMaintainer) Create(ctx context.Context, params v1alpha1.GroupParameters) (string, error) {
+	group, _, err := x.scimApi.CreateGroup(ctx).ScimGroup(xsuaa.ScimGroup{
+		DisplayName: internal.Ptr(params.DisplayName),
+		Description: params.Description,
+	}).Execute()
+	if err != nil {
+		return "", err
+	}
+	return internal.Val(group.Id), nil
+}
+
+// groupFilter builds the SCIM filter expression to find a group by its displayName
+func groupFilter(displayName string) string {
+	return fmt.Sprintf("displayName eq %q", displayName)
+}
+
+// mapSearchResults converts the untyped SCIM search results into groups
+func mapSearchResults(res *xsuaa.SearchResultsObject) ([]xsuaa.ScimGroup, error) {
+	if res == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(res.Resources)
+	if err != nil {
+		return nil, err
+	}
+	var groups []xsuaa.ScimGroup
+	if err := json.Unmarshal(raw, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// mapObservation maps the API model to the CRD observation, just a simple type mapping
+func mapObservation(group xsuaa.ScimGroup) v1alpha1.GroupObservation {
+	return v1alpha1.GroupObservation{
+		ID:          group.Id,
+		DisplayName: group.DisplayName,
+		Description: group.Description,
+		ZoneID:      group.ZoneId,
+	}
+}
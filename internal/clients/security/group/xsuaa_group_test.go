@@ -0,0 +1,103 @@
+package group
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+func TestGenerateObservation(t *testing.T) {
+	type want struct {
+		obs v1alpha1.GroupObservation
+		err error
+	}
+
+	apiGroup := map[string]interface{}{
+		"id":          "group-id",
+		"displayName": "developers",
+		"description": "all developers",
+		"zoneId":      "zone-id",
+	}
+
+	tests := map[string]struct {
+		apiFake *scimGroupApiFake
+		want    want
+	}{
+		"api error": {
+			apiFake: &scimGroupApiFake{err: internalServerError},
+			want: want{
+				err: internalServerError,
+			},
+		},
+		"not existing group": {
+			apiFake: &scimGroupApiFake{},
+			want: want{
+				obs: v1alpha1.GroupObservation{},
+			},
+		},
+		"ambiguous group": {
+			apiFake: &scimGroupApiFake{groups: []map[string]interface{}{apiGroup, apiGroup}},
+			want: want{
+				err: errors.New(errMultipleGroups),
+			},
+		},
+		"existing group": {
+			apiFake: &scimGroupApiFake{groups: []map[string]interface{}{apiGroup}},
+			want: want{
+				obs: v1alpha1.GroupObservation{
+					ID:          internal.Ptr("group-id"),
+					DisplayName: internal.Ptr("developers"),
+					Description: internal.Ptr("all developers"),
+					ZoneID:      internal.Ptr("zone-id"),
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			maintainer := &XsuaaGroupMaintainer{scimApi: tc.apiFake}
+			obs, err := maintainer.GenerateObservation(context.Background(), "developers")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGenerateObservation(...): -want error, +got error:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\n%s\nGenerateObservation(...): -want, +got:\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	tests := map[string]struct {
+		apiFake *scimGroupApiFake
+		wantID  string
+		wantErr error
+	}{
+		"api error": {
+			apiFake: &scimGroupApiFake{err: internalServerError},
+			wantErr: internalServerError,
+		},
+		"created": {
+			apiFake: &scimGroupApiFake{},
+			wantID:  "created-id",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			maintainer := &XsuaaGroupMaintainer{scimApi: tc.apiFake}
+			id, err := maintainer.Create(context.Background(), v1alpha1.GroupParameters{DisplayName: "developers"})
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCreate(...): -want error, +got error:\n", diff)
+			}
+			if id != tc.wantID {
+				t.Errorf("Create() = %v, want %v", id, tc.wantID)
+			}
+		})
+	}
+}
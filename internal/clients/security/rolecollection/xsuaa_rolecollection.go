@@ -55,9 +55,23 @@ func (x *XsuaaRoleCollectionMaintainer) NeedsCreation(observation v1alpha1.RoleC
 }
 
 func (x *XsuaaRoleCollectionMaintainer) NeedsUpdate(params v1alpha1.RoleCollectionParameters, obs v1alpha1.RoleCollectionObservation) bool {
+	return x.DetectDrift(params, obs) != nil
+}
+
+// DetectDrift returns a structured diff between spec and external role collection, nil if there is none
+func (x *XsuaaRoleCollectionMaintainer) DetectDrift(params v1alpha1.RoleCollectionParameters, obs v1alpha1.RoleCollectionObservation) *v1alpha1.RoleCollectionDrift {
 	toAdd, toRemove := roleDiff(params.RoleReferences, internal.Val(obs.RoleReferences))
+	changed := descriptionChanged(params, obs)
 
-	return toAdd != nil || toRemove != nil || descriptionChanged(params, obs)
+	if toAdd == nil && toRemove == nil && !changed {
+		return nil
+	}
+	// roles we would need to add are missing externally, roles we would need to remove have been added externally
+	return &v1alpha1.RoleCollectionDrift{
+		RolesAdded:         toRemove,
+		RolesRemoved:       toAdd,
+		DescriptionChanged: changed,
+	}
 }
 
 func (x *XsuaaRoleCollectionMaintainer) Create(ctx context.Context, params v1alpha1.RoleCollectionParameters) (string, error) {
@@ -207,6 +207,52 @@ func TestNeedsUpdate(t *testing.T) {
 	}
 }
 
+func TestDetectDrift(t *testing.T) {
+	viewer := v1alpha1.RoleReference{Name: "viewer", RoleTemplateAppId: "app-id-viewer", RoleTemplateName: "name-viewer"}
+	admin := v1alpha1.RoleReference{Name: "admin", RoleTemplateAppId: "app-id-admin", RoleTemplateName: "name-admin"}
+
+	tests := map[string]struct {
+		params v1alpha1.RoleCollectionParameters
+		obs    v1alpha1.RoleCollectionObservation
+
+		want *v1alpha1.RoleCollectionDrift
+	}{
+		"in sync": {
+			params: v1alpha1.RoleCollectionParameters{Description: internal.Ptr("desc"), RoleReferences: []v1alpha1.RoleReference{viewer}},
+			obs:    v1alpha1.RoleCollectionObservation{Description: internal.Ptr("desc"), RoleReferences: &[]v1alpha1.RoleReference{viewer}},
+			want:   nil,
+		},
+		"role added externally": {
+			params: v1alpha1.RoleCollectionParameters{RoleReferences: []v1alpha1.RoleReference{viewer}},
+			obs:    v1alpha1.RoleCollectionObservation{RoleReferences: &[]v1alpha1.RoleReference{viewer, admin}},
+			want:   &v1alpha1.RoleCollectionDrift{RolesAdded: []v1alpha1.RoleReference{admin}},
+		},
+		"role removed externally": {
+			params: v1alpha1.RoleCollectionParameters{RoleReferences: []v1alpha1.RoleReference{viewer, admin}},
+			obs:    v1alpha1.RoleCollectionObservation{RoleReferences: &[]v1alpha1.RoleReference{viewer}},
+			want:   &v1alpha1.RoleCollectionDrift{RolesRemoved: []v1alpha1.RoleReference{admin}},
+		},
+		"description changed and role swapped": {
+			params: v1alpha1.RoleCollectionParameters{Description: internal.Ptr("desc"), RoleReferences: []v1alpha1.RoleReference{viewer}},
+			obs:    v1alpha1.RoleCollectionObservation{Description: internal.Ptr("changed"), RoleReferences: &[]v1alpha1.RoleReference{admin}},
+			want: &v1alpha1.RoleCollectionDrift{
+				RolesAdded:         []v1alpha1.RoleReference{admin},
+				RolesRemoved:       []v1alpha1.RoleReference{viewer},
+				DescriptionChanged: true,
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			maintainer := &XsuaaRoleCollectionMaintainer{}
+			got := maintainer.DetectDrift(tc.params, tc.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("DetectDrift() -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestRoleDiff(t *testing.T) {
 	apiRole := func(name string) v1alpha1.RoleReference {
 		return v1alpha1.RoleReference{
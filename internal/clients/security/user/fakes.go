@@ -0,0 +1,47 @@
+package user
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/sap/crossplane-provider-btp/internal"
+	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
+)
+
+var (
+	internalServerError = errors.New("internal server error")
+)
+
+// scimUserApiFake returns the stubbed users on search and records the created user
+type scimUserApiFake struct {
+	users []map[string]interface{}
+	err   error
+
+	created *xsuaa.ScimUser
+}
+
+var _ xsuaa.ScimUserEndpointsAPI = &scimUserApiFake{}
+
+func (s *scimUserApiFake) CreateUser1(ctx context.Context) xsuaa.ScimUserEndpointsAPICreateUser1Request {
+	return xsuaa.ScimUserEndpointsAPICreateUser1Request{ApiService: s}
+}
+
+func (s *scimUserApiFake) CreateUser1Execute(r xsuaa.ScimUserEndpointsAPICreateUser1Request) (*xsuaa.ScimUser, *http.Response, error) {
+	if s.err != nil {
+		return nil, &http.Response{StatusCode: http.StatusInternalServerError}, s.err
+	}
+	s.created = &xsuaa.ScimUser{Id: internal.Ptr("created-id")}
+	return s.created, &http.Response{StatusCode: http.StatusCreated}, nil
+}
+
+func (s *scimUserApiFake) FindUsers(ctx context.Context) xsuaa.ScimUserEndpointsAPIFindUsersRequest {
+	return xsuaa.ScimUserEndpointsAPIFindUsersRequest{ApiService: s}
+}
+
+func (s *scimUserApiFake) FindUsersExecute(r xsuaa.ScimUserEndpointsAPIFindUsersRequest) (*xsuaa.SearchResultsObject, *http.Response, error) {
+	if s.err != nil {
+		return nil, &http.Response{StatusCode: http.StatusInternalServerError}, s.err
+	}
+	return &xsuaa.SearchResultsObject{Resources: s.users}, &http.Response{StatusCode: http.StatusOK}, nil
+}
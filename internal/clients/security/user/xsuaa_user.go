@@ -0,0 +1,144 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+const errMultipleUsers = "found more than one user for username and origin"
+
+// NewXsuaaUserMaintainer initializes new XsuaaUserMaintainer with auth configuration
+func NewXsuaaUserMaintainer(ctx context.Context, clientId, clientSecret, tokenUrl, apiUrl string) *XsuaaUserMaintainer {
+	config := clientcredentials.Config{
+		ClientID:     clientId,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenUrl,
+	}
+
+	smURL, _ := url.Parse(apiUrl)
+
+	apiClientConfig := xsuaa.NewConfiguration()
+	apiClientConfig.Host = smURL.Host
+	apiClientConfig.Scheme = smURL.Scheme
+	apiClientConfig.HTTPClient = config.Client(ctx)
+
+	apiClient := xsuaa.NewAPIClient(apiClientConfig)
+
+	return &XsuaaUserMaintainer{
+		scimApi: apiClient.ScimUserEndpointsAPI,
+		userApi: apiClient.UsercontrollerAPI,
+	}
+}
+
+// XsuaaUserMaintainer manages shadow users within XSUAA using the SCIM endpoints
+type XsuaaUserMaintainer struct {
+	scimApi xsuaa.ScimUserEndpointsAPI
+	userApi xsuaa.UsercontrollerAPI
+}
+
+// GenerateObservation looks up the shadow user by username and origin, returns an empty observation if it does not exist
+func (x *XsuaaUserMaintainer) GenerateObservation(ctx context.Context, origin, userName string) (v1alpha1.UserObservation, error) {
+	res, _, err := x.scimApi.FindUsers(ctx).Filter(userFilter(origin, userName)).Execute()
+	if err != nil {
+		return v1alpha1.UserObservation{}, err
+	}
+
+	users, err := mapSearchResults(res)
+	if err != nil {
+		return v1alpha1.UserObservation{}, err
+	}
+	switch len(users) {
+	case 0:
+		return v1alpha1.UserObservation{}, nil
+	case 1:
+		return mapObservation(users[0]), nil
+	default:
+		return v1alpha1.UserObservation{}, errors.New(errMultipleUsers)
+	}
+}
+
+// NeedsCreation checks if the shadow user has been found in the external system
+func (x *XsuaaUserMaintainer) NeedsCreation(observation v1alpha1.UserObservation) bool {
+	return observation.ID == nil
+}
+
+// Create creates the shadow user and returns its ID
+func (x *XsuaaUserMaintainer) Create(ctx context.Context, params v1alpha1.UserParameters) (string, error) {
+	user, _, err := x.scimApi.CreateUser1(ctx).ScimUser(mapApiPayload(params)).Execute()
+	if err != nil {
+		return "", err
+	}
+	return internal.Val(user.Id), nil
+}
+
+// Delete deletes the shadow user by username and origin
+func (x *XsuaaUserMaintainer) Delete(ctx context.Context, origin, userName string) error {
+	_, h, err := x.userApi.DeleteUserByName(ctx, origin, userName).Execute()
+
+	// gracefully ignore errors in case of not found
+	if h != nil && h.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+// userFilter builds the SCIM filter expression to find a user by username and origin
+func userFilter(origin, userName string) string {
+	return fmt.Sprintf("userName eq %q and origin eq %q", userName, origin)
+}
+
+// mapSearchResults converts the untyped SCIM search results into users
+func mapSearchResults(res *xsuaa.SearchResultsObject) ([]xsuaa.ScimUser, error) {
+	if res == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(res.Resources)
+	if err != nil {
+		return nil, err
+	}
+	var users []xsuaa.ScimUser
+	if err := json.Unmarshal(raw, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// mapApiPayload maps the CRD spec to api payload
+func mapApiPayload(params v1alpha1.UserParameters) xsuaa.ScimUser {
+	email := internal.Default(params.Email, params.UserName)
+	user := xsuaa.ScimUser{
+		UserName: internal.Ptr(params.UserName),
+		Origin:   internal.Ptr(params.Origin),
+		Emails: []xsuaa.Email{
+			{Value: internal.Ptr(email), Primary: internal.Ptr(true)},
+		},
+	}
+	if params.GivenName != nil || params.FamilyName != nil {
+		user.Name = &xsuaa.Name{
+			GivenName:  params.GivenName,
+			FamilyName: params.FamilyName,
+		}
+	}
+	return user
+}
+
+// mapObservation maps the API model to the CRD observation, just a simple type mapping
+func mapObservation(user xsuaa.ScimUser) v1alpha1.UserObservation {
+	return v1alpha1.UserObservation{
+		ID:       user.Id,
+		UserName: user.UserName,
+		Origin:   user.Origin,
+		ZoneID:   user.ZoneId,
+		Active:   user.Active,
+		Verified: user.Verified,
+	}
+}
@@ -0,0 +1,124 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	xsuaa "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-xsuaa-service-api-go/pkg"
+)
+
+func TestGenerateObservation(t *testing.T) {
+	type want struct {
+		obs v1alpha1.UserObservation
+		err error
+	}
+
+	apiUser := map[string]interface{}{
+		"id":       "user-id",
+		"userName": "someone@sap.com",
+		"origin":   "sap.default",
+		"zoneId":   "zone-id",
+		"active":   true,
+		"verified": false,
+	}
+
+	tests := map[string]struct {
+		apiFake *scimUserApiFake
+		want    want
+	}{
+		"api error": {
+			apiFake: &scimUserApiFake{err: internalServerError},
+			want: want{
+				err: internalServerError,
+			},
+		},
+		"not existing user": {
+			apiFake: &scimUserApiFake{},
+			want: want{
+				obs: v1alpha1.UserObservation{},
+			},
+		},
+		"ambiguous user": {
+			apiFake: &scimUserApiFake{users: []map[string]interface{}{apiUser, apiUser}},
+			want: want{
+				err: errors.New(errMultipleUsers),
+			},
+		},
+		"existing user": {
+			apiFake: &scimUserApiFake{users: []map[string]interface{}{apiUser}},
+			want: want{
+				obs: v1alpha1.UserObservation{
+					ID:       internal.Ptr("user-id"),
+					UserName: internal.Ptr("someone@sap.com"),
+					Origin:   internal.Ptr("sap.default"),
+					ZoneID:   internal.Ptr("zone-id"),
+					Active:   internal.Ptr(true),
+					Verified: internal.Ptr(false),
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			maintainer := &XsuaaUserMaintainer{scimApi: tc.apiFake}
+			obs, err := maintainer.GenerateObservation(context.Background(), "sap.default", "someone@sap.com")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGenerateObservation(...): -want error, +got error:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\n%s\nGenerateObservation(...): -want, +got:\n", diff)
+			}
+		})
+	}
+}
+
+func TestMapApiPayload(t *testing.T) {
+	tests := map[string]struct {
+		params v1alpha1.UserParameters
+		want   xsuaa.ScimUser
+	}{
+		"email defaults to username": {
+			params: v1alpha1.UserParameters{Origin: "sap.default", UserName: "someone@sap.com"},
+			want: xsuaa.ScimUser{
+				UserName: internal.Ptr("someone@sap.com"),
+				Origin:   internal.Ptr("sap.default"),
+				Emails:   []xsuaa.Email{{Value: internal.Ptr("someone@sap.com"), Primary: internal.Ptr(true)}},
+			},
+		},
+		"all fields": {
+			params: v1alpha1.UserParameters{
+				Origin:     "custom-idp",
+				UserName:   "someone",
+				Email:      internal.Ptr("someone@sap.com"),
+				GivenName:  internal.Ptr("Some"),
+				FamilyName: internal.Ptr("One"),
+			},
+			want: xsuaa.ScimUser{
+				UserName: internal.Ptr("someone"),
+				Origin:   internal.Ptr("custom-idp"),
+				Emails:   []xsuaa.Email{{Value: internal.Ptr("someone@sap.com"), Primary: internal.Ptr(true)}},
+				Name:     &xsuaa.Name{GivenName: internal.Ptr("Some"), FamilyName: internal.Ptr("One")},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, mapApiPayload(tc.params)); diff != "" {
+				t.Errorf("\n%s\nmapApiPayload(...): -want, +got:\n", diff)
+			}
+		})
+	}
+}
+
+func TestUserFilter(t *testing.T) {
+	want := `userName eq "someone@sap.com" and origin eq "sap.default"`
+	if got := userFilter("sap.default", "someone@sap.com"); got != want {
+		t.Errorf("userFilter() = %v, want %v", got, want)
+	}
+}
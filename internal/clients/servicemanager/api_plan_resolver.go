@@ -27,6 +27,18 @@ type PlanIdResolver interface {
 	PlanIDByName(ctx context.Context, offeringName string, servicePlanName string) (string, error)
 }
 
+// PlanUpdateResolver resolves plan IDs and whether instances of an offering may change their plan
+type PlanUpdateResolver interface {
+	PlanIdResolver
+	PlanUpdateable(ctx context.Context, offeringName string) (bool, error)
+}
+
+// CatalogReader lists the complete service catalog of a subaccount
+type CatalogReader interface {
+	ListOfferings(ctx context.Context) ([]servicemanager.ServiceOfferingResponseObject, error)
+	ListPlans(ctx context.Context) ([]servicemanager.ServicePlanResponseObject, error)
+}
+
 // NewCredsFromOperatorSecret creates a new BindingCredentials from a secret data
 // of a btp service operator secret, which is slightly different in structure then
 // the creds of a regular servicebinding
@@ -86,6 +98,19 @@ type ServiceManagerClient struct {
 }
 
 func NewServiceManagerClient(ctx context.Context, creds *BindingCredentials) (*ServiceManagerClient, error) {
+	apiClient, err := newAPIClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceManagerClient{
+		apiClient.ServiceOfferingsAPI,
+		apiClient.ServicePlansAPI,
+	}, nil
+}
+
+// newAPIClient configures a service manager API client authenticating with the client credentials of the given binding
+func newAPIClient(ctx context.Context, creds *BindingCredentials) (*servicemanager.APIClient, error) {
 	const oauthTokenUrlPath = "/oauth/token"
 
 	log := log.FromContext(ctx)
@@ -111,25 +136,16 @@ func NewServiceManagerClient(ctx context.Context, creds *BindingCredentials) (*S
 	apiClientConfig.Scheme = smURL.Scheme
 	apiClientConfig.HTTPClient = config.Client(ctx)
 
-	apiClient := servicemanager.NewAPIClient(apiClientConfig)
-
-	return &ServiceManagerClient{
-		apiClient.ServiceOfferingsAPI,
-		apiClient.ServicePlansAPI,
-	}, nil
+	return servicemanager.NewAPIClient(apiClientConfig), nil
 }
 
 func (sm *ServiceManagerClient) PlanIDByName(ctx context.Context, offeringName, planName string) (string, error) {
-	offeringQuery := fmt.Sprintf("catalog_name eq '%s'", offeringName)
-	execute, _, err := sm.GetServiceOfferings(ctx).FieldQuery(offeringQuery).Execute()
+	offering, err := sm.offering(ctx, offeringName)
 	if err != nil {
 		return "", err
 	}
-	if len(execute.Items) == 0 {
-		return "", errors.Errorf("API returned no service plan for offering %s", offeringName)
-	}
 
-	planQuery := fmt.Sprintf("catalog_name eq '%s' and service_offering_id eq '%s'", planName, *execute.Items[0].Id)
+	planQuery := fmt.Sprintf("catalog_name eq '%s' and service_offering_id eq '%s'", planName, *offering.Id)
 	object, _, err := sm.GetAllServicePlans(ctx).FieldQuery(planQuery).Execute()
 	if err != nil {
 		return "", err
@@ -142,3 +158,58 @@ func (sm *ServiceManagerClient) PlanIDByName(ctx context.Context, offeringName,
 	servicePlanID := *object.Items[0].Id
 	return servicePlanID, nil
 }
+
+// PlanUpdateable returns whether the offering allows to change the plan of existing instances
+func (sm *ServiceManagerClient) PlanUpdateable(ctx context.Context, offeringName string) (bool, error) {
+	offering, err := sm.offering(ctx, offeringName)
+	if err != nil {
+		return false, err
+	}
+	return internal.Val(offering.PlanUpdateable), nil
+}
+
+func (sm *ServiceManagerClient) offering(ctx context.Context, offeringName string) (*servicemanager.ServiceOfferingResponseObject, error) {
+	offeringQuery := fmt.Sprintf("catalog_name eq '%s'", offeringName)
+	execute, _, err := sm.GetServiceOfferings(ctx).FieldQuery(offeringQuery).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if len(execute.Items) == 0 {
+		return nil, errors.Errorf("API returned no service plan for offering %s", offeringName)
+	}
+	return &execute.Items[0], nil
+}
+
+// ListOfferings returns all offerings of the subaccount, following the pagination of the API
+func (sm *ServiceManagerClient) ListOfferings(ctx context.Context) ([]servicemanager.ServiceOfferingResponseObject, error) {
+	offerings := []servicemanager.ServiceOfferingResponseObject{}
+	req := sm.GetServiceOfferings(ctx)
+	for {
+		list, _, err := req.Execute()
+		if err != nil {
+			return nil, err
+		}
+		offerings = append(offerings, list.Items...)
+		if internal.Val(list.Token) == "" {
+			return offerings, nil
+		}
+		req = req.Token(*list.Token)
+	}
+}
+
+// ListPlans returns the plans of all offerings of the subaccount, following the pagination of the API
+func (sm *ServiceManagerClient) ListPlans(ctx context.Context) ([]servicemanager.ServicePlanResponseObject, error) {
+	plans := []servicemanager.ServicePlanResponseObject{}
+	req := sm.GetAllServicePlans(ctx)
+	for {
+		list, _, err := req.Execute()
+		if err != nil {
+			return nil, err
+		}
+		plans = append(plans, list.Items...)
+		if internal.Val(list.Token) == "" {
+			return plans, nil
+		}
+		req = req.Token(*list.Token)
+	}
+}
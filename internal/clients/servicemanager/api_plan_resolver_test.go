@@ -165,6 +165,98 @@ func TestPlanIDByName(t *testing.T) {
 	}
 }
 
+func TestPlanUpdateable(t *testing.T) {
+	tests := []struct {
+		name            string
+		listOfferingsFn func() (*servicemanager.ServiceOfferingResponseList, *http.Response, error)
+
+		wantErr bool
+		want    bool
+	}{
+		{
+			name: "offeringError",
+			listOfferingsFn: func() (*servicemanager.ServiceOfferingResponseList, *http.Response, error) {
+				return nil, nil, errors.New("offeringApiError")
+			},
+			wantErr: true,
+		},
+		{
+			name: "notUpdateable",
+			listOfferingsFn: func() (*servicemanager.ServiceOfferingResponseList, *http.Response, error) {
+				return &servicemanager.ServiceOfferingResponseList{
+					Items: []servicemanager.ServiceOfferingResponseObject{{Id: internal.Ptr("someID")}},
+				}, nil, nil
+			},
+			want: false,
+		},
+		{
+			name: "updateable",
+			listOfferingsFn: func() (*servicemanager.ServiceOfferingResponseList, *http.Response, error) {
+				return &servicemanager.ServiceOfferingResponseList{
+					Items: []servicemanager.ServiceOfferingResponseObject{{Id: internal.Ptr("someID"), PlanUpdateable: internal.Ptr(true)}},
+				}, nil, nil
+			},
+			want: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			smClient := &ServiceManagerClient{
+				OfferingServiceFake{tc.listOfferingsFn},
+				PlansServiceFake{},
+			}
+			updateable, err := smClient.PlanUpdateable(context.TODO(), "Not relevant, since mocked")
+
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Unexpected error return; Expected error: %v, Returned: %v", tc.wantErr, err)
+			}
+			if tc.want != updateable {
+				t.Errorf("Unexpected PlanUpdateable; Expected: %v, Returned: %v", tc.want, updateable)
+			}
+		})
+	}
+}
+
+func TestListCatalog(t *testing.T) {
+	offeringPages := []*servicemanager.ServiceOfferingResponseList{
+		{Items: []servicemanager.ServiceOfferingResponseObject{{Id: internal.Ptr("o1")}}, Token: internal.Ptr("next")},
+		{Items: []servicemanager.ServiceOfferingResponseObject{{Id: internal.Ptr("o2")}}},
+	}
+	planPages := []*servicemanager.ServicePlanResponseList{
+		{Items: []servicemanager.ServicePlanResponseObject{{Id: internal.Ptr("p1")}}},
+	}
+	offeringCalls, planCalls := 0, 0
+	smClient := &ServiceManagerClient{
+		OfferingServiceFake{func() (*servicemanager.ServiceOfferingResponseList, *http.Response, error) {
+			offeringCalls++
+			return offeringPages[offeringCalls-1], nil, nil
+		}},
+		PlansServiceFake{listPlansMockFn: func() (*servicemanager.ServicePlanResponseList, *http.Response, error) {
+			planCalls++
+			return planPages[planCalls-1], nil, nil
+		}},
+	}
+
+	offerings, err := smClient.ListOfferings(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []servicemanager.ServiceOfferingResponseObject{{Id: internal.Ptr("o1")}, {Id: internal.Ptr("o2")}}, offerings)
+
+	plans, err := smClient.ListPlans(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, []servicemanager.ServicePlanResponseObject{{Id: internal.Ptr("p1")}}, plans)
+}
+
+func TestListOfferingsError(t *testing.T) {
+	smClient := &ServiceManagerClient{
+		OfferingServiceFake{func() (*servicemanager.ServiceOfferingResponseList, *http.Response, error) {
+			return nil, nil, errors.New("offeringApiError")
+		}},
+		PlansServiceFake{},
+	}
+	_, err := smClient.ListOfferings(context.TODO())
+	assert.Error(t, err)
+}
+
 func TestNewCredsFromOperatorSecret(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -0,0 +1,343 @@
+package servicemanager
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	servicemanager "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+)
+
+const (
+	ResourceTypeServiceInstances = "service_instances"
+	ResourceTypeServiceBindings  = "service_bindings"
+
+	OperationStateInProgress = "in progress"
+	OperationStateSucceeded  = "succeeded"
+	OperationStateFailed     = "failed"
+
+	OperationTypeCreate = "create"
+	OperationTypeUpdate = "update"
+	OperationTypeDelete = "delete"
+)
+
+const (
+	errRequestFailed      = "service manager request %s %s failed with status %d: %s"
+	errNoResourceID       = "cannot determine id of %s from service manager response"
+	errGetOperation       = "cannot get operation %s of %s %s"
+	errMarshalPayload     = "cannot marshal service manager request payload"
+	errUnmarshalParameter = "cannot use parameters, they need to be a json object"
+	errGetParameters      = "cannot unmarshal parameters of %s %s"
+)
+
+// NativeClientI manages service instances and bindings directly via the service manager API, without going through terraform
+type NativeClientI interface {
+	CreateInstance(ctx context.Context, payload InstancePayload) (string, error)
+	UpdateInstance(ctx context.Context, id string, payload InstancePayload) error
+	DeleteInstance(ctx context.Context, id string) error
+	// GetInstance returns nil if no instance with that id exists
+	GetInstance(ctx context.Context, id string) (*servicemanager.ServiceInstanceResponseObject, error)
+	// FindInstance returns nil if no instance with that name exists
+	FindInstance(ctx context.Context, name string) (*servicemanager.ServiceInstanceResponseObject, error)
+	// GetParameters returns the parameters of an instance or binding, nil if the offering doesn't support retrieving them
+	GetParameters(ctx context.Context, resourceType string, id string) (map[string]interface{}, error)
+
+	CreateBinding(ctx context.Context, payload BindingPayload) (string, error)
+	DeleteBinding(ctx context.Context, id string) error
+	// GetBinding returns nil if no binding with that id exists
+	GetBinding(ctx context.Context, id string) (*servicemanager.ServiceBindingResponseObject, error)
+	// FindBinding returns nil if the instance has no binding with that name
+	FindBinding(ctx context.Context, name string, instanceID string) (*servicemanager.ServiceBindingResponseObject, error)
+
+	// CurrentOperation returns the latest state of an operation, it polls the operations API as long as the operation is in progress
+	CurrentOperation(ctx context.Context, resourceType string, resourceID string, op *servicemanager.OperationResponseObject) (*servicemanager.OperationResponseObject, error)
+}
+
+// InstancePayload is the request body for creating and updating service instances.
+// The generated API models only allow flat string maps as parameters, so we send raw json instead.
+// Shared can only be changed on its own, without any other field set.
+type InstancePayload struct {
+	Name          string          `json:"name,omitempty"`
+	ServicePlanID string          `json:"service_plan_id,omitempty"`
+	Parameters    json.RawMessage `json:"parameters,omitempty"`
+	Shared        *bool           `json:"shared,omitempty"`
+}
+
+// BindingPayload is the request body for creating service bindings
+type BindingPayload struct {
+	Name              string          `json:"name"`
+	ServiceInstanceID string          `json:"service_instance_id"`
+	Parameters        json.RawMessage `json:"parameters,omitempty"`
+}
+
+var _ NativeClientI = &NativeClient{}
+
+// NativeClient is the NativeClientI implementation on top of the generated service manager API client
+type NativeClient struct {
+	instances  servicemanager.ServiceInstancesAPI
+	bindings   servicemanager.ServiceBindingsAPI
+	operations servicemanager.OperationsAPI
+
+	// used for requests carrying parameters, see InstancePayload and GetParameters
+	jsonClient
+}
+
+// jsonClient sends plain json requests to the service manager, for requests the generated API doesn't support
+type jsonClient struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// newJsonClient creates a jsonClient sharing the authenticated http client of the generated API client
+func newJsonClient(apiClient *servicemanager.APIClient) jsonClient {
+	cfg := apiClient.GetConfig()
+	return jsonClient{httpClient: cfg.HTTPClient, baseURL: cfg.Scheme + "://" + cfg.Host}
+}
+
+// NewNativeClient creates a NativeClient authenticating with the given service manager binding credentials
+func NewNativeClient(ctx context.Context, creds *BindingCredentials) (*NativeClient, error) {
+	apiClient, err := newAPIClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeClient{
+		instances:  apiClient.ServiceInstancesAPI,
+		bindings:   apiClient.ServiceBindingsAPI,
+		operations: apiClient.OperationsAPI,
+		jsonClient: newJsonClient(apiClient),
+	}, nil
+}
+
+// UseNativeBackend tells whether service instances and bindings of the given ProviderConfig are managed natively,
+// the ProviderConfig setting takes precedence over the provider wide default
+func UseNativeBackend(pc *providerv1alpha1.ProviderConfig, nativeByDefault bool) bool {
+	switch pc.Spec.ServiceManagerBackend {
+	case providerv1alpha1.ServiceManagerBackendNative:
+		return true
+	case providerv1alpha1.ServiceManagerBackendTerraform:
+		return false
+	}
+	return nativeByDefault
+}
+
+func (c *NativeClient) CreateInstance(ctx context.Context, payload InstancePayload) (string, error) {
+	return c.send(ctx, http.MethodPost, "/v1/"+ResourceTypeServiceInstances, payload)
+}
+
+func (c *NativeClient) UpdateInstance(ctx context.Context, id string, payload InstancePayload) error {
+	_, err := c.send(ctx, http.MethodPatch, "/v1/"+ResourceTypeServiceInstances+"/"+id, payload)
+	return err
+}
+
+func (c *NativeClient) DeleteInstance(ctx context.Context, id string) error {
+	_, raw, err := c.instances.DeleteServiceInstance(ctx, id).Async(true).Execute()
+	if isNotFound(raw) {
+		return nil
+	}
+	return err
+}
+
+func (c *NativeClient) GetInstance(ctx context.Context, id string) (*servicemanager.ServiceInstanceResponseObject, error) {
+	instance, raw, err := c.instances.GetServiceInstanceById(ctx, id).Execute()
+	if isNotFound(raw) {
+		return nil, nil
+	}
+	return instance, err
+}
+
+func (c *NativeClient) FindInstance(ctx context.Context, name string) (*servicemanager.ServiceInstanceResponseObject, error) {
+	list, _, err := c.instances.GetAllServiceInstances(ctx).FieldQuery(fmt.Sprintf("name eq '%s'", name)).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if len(list.Items) == 0 || list.Items[0].Id == nil {
+		return nil, nil
+	}
+	return c.GetInstance(ctx, *list.Items[0].Id)
+}
+
+func (c *NativeClient) GetParameters(ctx context.Context, resourceType string, id string) (map[string]interface{}, error) {
+	// the generated API only supports flat string maps, parameters are usually nested
+	path := "/v1/" + resourceType + "/" + id + "/parameters"
+	status, _, body, err := c.do(ctx, http.MethodGet, path, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case status == http.StatusBadRequest || status == http.StatusNotFound || status == http.StatusNotImplemented:
+		// offerings that aren't instances_retrievable or bindings_retrievable reject the request
+		return nil, nil
+	case status >= 300:
+		return nil, errors.Errorf(errRequestFailed, http.MethodGet, path, status, string(body))
+	}
+	parameters := map[string]interface{}{}
+	if err := json.Unmarshal(body, &parameters); err != nil {
+		return nil, errors.Wrapf(err, errGetParameters, resourceType, id)
+	}
+	return parameters, nil
+}
+
+func (c *NativeClient) CreateBinding(ctx context.Context, payload BindingPayload) (string, error) {
+	return c.send(ctx, http.MethodPost, "/v1/"+ResourceTypeServiceBindings, payload)
+}
+
+func (c *NativeClient) DeleteBinding(ctx context.Context, id string) error {
+	_, raw, err := c.bindings.DeleteServiceBinding(ctx, id).Async(true).Execute()
+	if isNotFound(raw) {
+		return nil
+	}
+	return err
+}
+
+func (c *NativeClient) GetBinding(ctx context.Context, id string) (*servicemanager.ServiceBindingResponseObject, error) {
+	binding, raw, err := c.bindings.GetServiceBindingById(ctx, id).Execute()
+	if isNotFound(raw) {
+		return nil, nil
+	}
+	return binding, err
+}
+
+func (c *NativeClient) FindBinding(ctx context.Context, name string, instanceID string) (*servicemanager.ServiceBindingResponseObject, error) {
+	query := fmt.Sprintf("name eq '%s' and service_instance_id eq '%s'", name, instanceID)
+	list, _, err := c.bindings.GetAllServiceBindings(ctx).FieldQuery(query).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if len(list.Items) == 0 || list.Items[0].Id == nil {
+		return nil, nil
+	}
+	return c.GetBinding(ctx, *list.Items[0].Id)
+}
+
+func (c *NativeClient) CurrentOperation(ctx context.Context, resourceType string, resourceID string, op *servicemanager.OperationResponseObject) (*servicemanager.OperationResponseObject, error) {
+	if op == nil || op.Id == nil || op.State == nil || *op.State != OperationStateInProgress {
+		return op, nil
+	}
+	current, _, err := c.operations.GetSingleOperation(ctx, resourceType, resourceID, *op.Id).Execute()
+	if err != nil {
+		return nil, errors.Wrapf(err, errGetOperation, *op.Id, resourceType, resourceID)
+	}
+	return current, nil
+}
+
+// send executes an asynchronous request and returns the id of the affected resource
+func (c *NativeClient) send(ctx context.Context, method string, path string, payload interface{}) (string, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", errors.Wrap(err, errMarshalPayload)
+	}
+	status, header, respBody, err := c.do(ctx, method, path, "async=true", body)
+	if err != nil {
+		return "", err
+	}
+	if status >= 300 {
+		return "", errors.Errorf(errRequestFailed, method, path, status, string(respBody))
+	}
+
+	id := resourceID(header.Get("Location"), respBody)
+	if id == "" {
+		return "", errors.Errorf(errNoResourceID, path)
+	}
+	return id, nil
+}
+
+// do executes a plain json request and returns status, header and body of the response
+func (c *jsonClient) do(ctx context.Context, method string, path string, query string, body []byte) (int, http.Header, []byte, error) {
+	target := c.baseURL + path
+	if query != "" {
+		target += "?" + query
+	}
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, reqBody)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return resp.StatusCode, resp.Header, respBody, nil
+}
+
+// resourceID extracts the id of the affected resource, either from the body of a synchronous response or from the
+// operation location of an asynchronous one, which has the form /v1/<resourceType>/<resourceID>/operations/<operationID>
+func resourceID(location string, body []byte) string {
+	var created struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &created); err == nil && created.ID != "" {
+		return created.ID
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return ""
+	}
+	fragments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(fragments) >= 3 {
+		return fragments[2]
+	}
+	return ""
+}
+
+// RawParameters converts parameters as built by BuildComplexParameterJson into a request payload, dropping empty ones
+func RawParameters(parameterJson []byte) (json.RawMessage, error) {
+	var parameters map[string]interface{}
+	if err := json.Unmarshal(parameterJson, &parameters); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalParameter)
+	}
+	if len(parameters) == 0 {
+		return nil, nil
+	}
+	return parameterJson, nil
+}
+
+// OperationState returns the state of an operation, empty if there is none
+func OperationState(op *servicemanager.OperationResponseObject) string {
+	if op == nil {
+		return ""
+	}
+	return internal.Val(op.State)
+}
+
+// OperationError summarizes the errors of a failed operation
+func OperationError(op *servicemanager.OperationResponseObject) error {
+	messages := []string{}
+	if op.Description != nil && *op.Description != "" {
+		messages = append(messages, *op.Description)
+	}
+	for _, e := range op.Errors {
+		if e.Description != nil {
+			messages = append(messages, *e.Description)
+		}
+	}
+	if len(messages) == 0 {
+		return errors.Errorf("%s operation failed", internal.Val(op.Type))
+	}
+	return errors.Errorf("%s operation failed: %s", internal.Val(op.Type), strings.Join(messages, "; "))
+}
+
+func isNotFound(raw *http.Response) bool {
+	return raw != nil && raw.StatusCode == http.StatusNotFound
+}
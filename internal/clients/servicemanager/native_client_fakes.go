@@ -0,0 +1,85 @@
+package servicemanager
+
+import (
+	"context"
+
+	servicemanager "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+)
+
+var _ NativeClientI = &NativeClientFake{}
+
+// NativeClientFake is a configurable NativeClientI for tests, it records the payloads and ids it has been called with
+type NativeClientFake struct {
+	Instance   *servicemanager.ServiceInstanceResponseObject
+	Binding    *servicemanager.ServiceBindingResponseObject
+	Operation  *servicemanager.OperationResponseObject
+	Parameters map[string]interface{}
+	CreatedID  string
+	Err        error
+
+	InstancePayload *InstancePayload
+	BindingPayload  *BindingPayload
+	UpdatedID       string
+	DeletedID       string
+	DeletedIDs      []string
+	LookedUpID      string
+	LookedUpName    string
+}
+
+func (f *NativeClientFake) CreateInstance(ctx context.Context, payload InstancePayload) (string, error) {
+	f.InstancePayload = &payload
+	return f.CreatedID, f.Err
+}
+
+func (f *NativeClientFake) UpdateInstance(ctx context.Context, id string, payload InstancePayload) error {
+	f.UpdatedID, f.InstancePayload = id, &payload
+	return f.Err
+}
+
+func (f *NativeClientFake) DeleteInstance(ctx context.Context, id string) error {
+	f.DeletedID = id
+	return f.Err
+}
+
+func (f *NativeClientFake) GetInstance(ctx context.Context, id string) (*servicemanager.ServiceInstanceResponseObject, error) {
+	f.LookedUpID = id
+	return f.Instance, f.Err
+}
+
+func (f *NativeClientFake) FindInstance(ctx context.Context, name string) (*servicemanager.ServiceInstanceResponseObject, error) {
+	f.LookedUpName = name
+	return f.Instance, f.Err
+}
+
+func (f *NativeClientFake) GetParameters(ctx context.Context, resourceType string, id string) (map[string]interface{}, error) {
+	return f.Parameters, f.Err
+}
+
+func (f *NativeClientFake) CreateBinding(ctx context.Context, payload BindingPayload) (string, error) {
+	f.BindingPayload = &payload
+	return f.CreatedID, f.Err
+}
+
+func (f *NativeClientFake) DeleteBinding(ctx context.Context, id string) error {
+	f.DeletedID = id
+	f.DeletedIDs = append(f.DeletedIDs, id)
+	return f.Err
+}
+
+func (f *NativeClientFake) GetBinding(ctx context.Context, id string) (*servicemanager.ServiceBindingResponseObject, error) {
+	f.LookedUpID = id
+	return f.Binding, f.Err
+}
+
+func (f *NativeClientFake) FindBinding(ctx context.Context, name string, instanceID string) (*servicemanager.ServiceBindingResponseObject, error) {
+	f.LookedUpName = name
+	return f.Binding, f.Err
+}
+
+// CurrentOperation returns the configured Operation if set, otherwise the given one
+func (f *NativeClientFake) CurrentOperation(ctx context.Context, resourceType string, resourceID string, op *servicemanager.OperationResponseObject) (*servicemanager.OperationResponseObject, error) {
+	if f.Operation != nil {
+		return f.Operation, f.Err
+	}
+	return op, f.Err
+}
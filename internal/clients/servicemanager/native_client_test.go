@@ -0,0 +1,344 @@
+package servicemanager
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	servicemanager "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestClient creates a NativeClient talking to a test server with the given handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *NativeClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	serverURL, _ := url.Parse(server.URL)
+	cfg := servicemanager.NewConfiguration()
+	cfg.Host = serverURL.Host
+	cfg.Scheme = serverURL.Scheme
+	cfg.HTTPClient = server.Client()
+	apiClient := servicemanager.NewAPIClient(cfg)
+
+	return &NativeClient{
+		instances:  apiClient.ServiceInstancesAPI,
+		bindings:   apiClient.ServiceBindingsAPI,
+		operations: apiClient.OperationsAPI,
+		jsonClient: newJsonClient(apiClient),
+	}
+}
+
+func writeJson(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestCreateInstance(t *testing.T) {
+	tests := map[string]struct {
+		handler http.HandlerFunc
+		wantID  string
+		wantErr bool
+	}{
+		"Async": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Location", "/v1/service_instances/instance-id/operations/operation-id")
+				w.WriteHeader(http.StatusAccepted)
+			},
+			wantID: "instance-id",
+		},
+		"Sync": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusCreated, map[string]string{"id": "instance-id"})
+			},
+			wantID: "instance-id",
+		},
+		"NoID": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+			},
+			wantErr: true,
+		},
+		"Conflict": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusConflict, map[string]string{"description": "already exists"})
+			},
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, tc.handler)
+			id, err := c.CreateInstance(context.TODO(), InstancePayload{Name: "instance"})
+			if tc.wantErr != (err != nil) {
+				t.Errorf("CreateInstance() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if id != tc.wantID {
+				t.Errorf("CreateInstance() = %v, want %v", id, tc.wantID)
+			}
+		})
+	}
+}
+
+func TestCreateInstancePayload(t *testing.T) {
+	var body map[string]interface{}
+	var query url.Values
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		raw, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(raw, &body)
+		writeJson(w, http.StatusCreated, map[string]string{"id": "instance-id"})
+	})
+
+	_, err := c.CreateInstance(context.TODO(), InstancePayload{
+		Name:          "instance",
+		ServicePlanID: "plan-id",
+		Parameters:    json.RawMessage(`{"nested": {"key": "value"}}`),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "true", query.Get("async"))
+	want := map[string]interface{}{
+		"name":            "instance",
+		"service_plan_id": "plan-id",
+		"parameters":      map[string]interface{}{"nested": map[string]interface{}{"key": "value"}},
+	}
+	if diff := cmp.Diff(want, body); diff != "" {
+		t.Errorf("\n%s\nCreateInstance(...): -want body, +got body:\n", diff)
+	}
+}
+
+func TestGetInstance(t *testing.T) {
+	tests := map[string]struct {
+		handler http.HandlerFunc
+		want    *servicemanager.ServiceInstanceResponseObject
+		wantErr bool
+	}{
+		"NotFound": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusNotFound, map[string]string{})
+			},
+		},
+		"Error": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusInternalServerError, map[string]string{})
+			},
+			wantErr: true,
+		},
+		"Found": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusOK, servicemanager.ServiceInstanceResponseObject{Id: internal.Ptr("instance-id")})
+			},
+			want: &servicemanager.ServiceInstanceResponseObject{Id: internal.Ptr("instance-id")},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, tc.handler)
+			got, err := c.GetInstance(context.TODO(), "instance-id")
+			if tc.wantErr != (err != nil) {
+				t.Errorf("GetInstance() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !tc.wantErr {
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGetParameters(t *testing.T) {
+	tests := map[string]struct {
+		resourceType string
+		handler      http.HandlerFunc
+		want         map[string]interface{}
+		wantPath     string
+		wantErr      bool
+	}{
+		"Nested": {
+			resourceType: ResourceTypeServiceInstances,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusOK, map[string]interface{}{"db": map[string]interface{}{"size": 1}})
+			},
+			want:     map[string]interface{}{"db": map[string]interface{}{"size": float64(1)}},
+			wantPath: "/v1/service_instances/resource-id/parameters",
+		},
+		"Binding": {
+			resourceType: ResourceTypeServiceBindings,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusOK, map[string]interface{}{"role": "viewer"})
+			},
+			want:     map[string]interface{}{"role": "viewer"},
+			wantPath: "/v1/service_bindings/resource-id/parameters",
+		},
+		"NotRetrievable": {
+			resourceType: ResourceTypeServiceInstances,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusBadRequest, map[string]string{"description": "not retrievable"})
+			},
+			wantPath: "/v1/service_instances/resource-id/parameters",
+		},
+		"Error": {
+			resourceType: ResourceTypeServiceInstances,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusInternalServerError, map[string]string{})
+			},
+			wantPath: "/v1/service_instances/resource-id/parameters",
+			wantErr:  true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var path string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				tc.handler(w, r)
+			})
+			got, err := c.GetParameters(context.TODO(), tc.resourceType, "resource-id")
+			if tc.wantErr != (err != nil) {
+				t.Errorf("GetParameters() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			assert.Equal(t, tc.wantPath, path)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestFindBinding(t *testing.T) {
+	var fieldQuery string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/service_bindings" {
+			fieldQuery = r.URL.Query().Get("fieldQuery")
+			writeJson(w, http.StatusOK, servicemanager.ServiceBindingResponseList{
+				Items: []servicemanager.ListedServiceBindingResponseObject{{Id: internal.Ptr("binding-id")}},
+			})
+			return
+		}
+		writeJson(w, http.StatusOK, servicemanager.ServiceBindingResponseObject{Id: internal.Ptr("binding-id"), Name: internal.Ptr("binding")})
+	})
+
+	got, err := c.FindBinding(context.TODO(), "binding", "instance-id")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "name eq 'binding' and service_instance_id eq 'instance-id'", fieldQuery)
+	assert.Equal(t, &servicemanager.ServiceBindingResponseObject{Id: internal.Ptr("binding-id"), Name: internal.Ptr("binding")}, got)
+}
+
+func TestCurrentOperation(t *testing.T) {
+	inProgress := &servicemanager.OperationResponseObject{Id: internal.Ptr("operation-id"), State: internal.Ptr(OperationStateInProgress)}
+	succeeded := &servicemanager.OperationResponseObject{Id: internal.Ptr("operation-id"), State: internal.Ptr(OperationStateSucceeded)}
+
+	tests := map[string]struct {
+		op       *servicemanager.OperationResponseObject
+		handler  http.HandlerFunc
+		want     *servicemanager.OperationResponseObject
+		wantPath string
+		wantErr  bool
+	}{
+		"NoOperation": {},
+		"Finished": {
+			op:   succeeded,
+			want: succeeded,
+		},
+		"Polled": {
+			op: inProgress,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusOK, succeeded)
+			},
+			want:     succeeded,
+			wantPath: "/v1/service_instances/instance-id/operations/operation-id",
+		},
+		"PollError": {
+			op: inProgress,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusInternalServerError, map[string]string{})
+			},
+			wantPath: "/v1/service_instances/instance-id/operations/operation-id",
+			wantErr:  true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var path string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				tc.handler(w, r)
+			})
+			got, err := c.CurrentOperation(context.TODO(), ResourceTypeServiceInstances, "instance-id", tc.op)
+			if tc.wantErr != (err != nil) {
+				t.Errorf("CurrentOperation() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			assert.Equal(t, tc.wantPath, path)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestResourceID(t *testing.T) {
+	tests := map[string]struct {
+		location string
+		body     []byte
+		want     string
+	}{
+		"FromBody":             {body: []byte(`{"id": "body-id"}`), location: "/v1/service_instances/location-id/operations/op", want: "body-id"},
+		"FromLocation":         {location: "/v1/service_instances/location-id/operations/op", want: "location-id"},
+		"FromAbsoluteLocation": {location: "https://sm.example.com/v1/service_bindings/location-id/operations/op", want: "location-id"},
+		"None":                 {body: []byte(`{}`)},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := resourceID(tc.location, tc.body); got != tc.want {
+				t.Errorf("resourceID() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUseNativeBackend(t *testing.T) {
+	tests := map[string]struct {
+		backend         string
+		nativeByDefault bool
+		want            bool
+	}{
+		"DefaultTerraform":    {},
+		"DefaultNative":       {nativeByDefault: true, want: true},
+		"ConfiguredNative":    {backend: providerv1alpha1.ServiceManagerBackendNative, want: true},
+		"ConfiguredTerraform": {backend: providerv1alpha1.ServiceManagerBackendTerraform, nativeByDefault: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pc := &providerv1alpha1.ProviderConfig{Spec: providerv1alpha1.ProviderConfigSpec{ServiceManagerBackend: tc.backend}}
+			if got := UseNativeBackend(pc, tc.nativeByDefault); got != tc.want {
+				t.Errorf("UseNativeBackend() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRawParameters(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		want    json.RawMessage
+		wantErr bool
+	}{
+		"Empty":    {in: `{}`},
+		"Object":   {in: `{"key": 1}`, want: json.RawMessage(`{"key": 1}`)},
+		"NoObject": {in: `[1]`, wantErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := RawParameters([]byte(tc.in))
+			if tc.wantErr != (err != nil) {
+				t.Errorf("RawParameters() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
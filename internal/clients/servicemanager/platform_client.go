@@ -0,0 +1,107 @@
+package servicemanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+	servicemanager "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+)
+
+const (
+	LabelOpAdd    = "add"
+	LabelOpRemove = "remove"
+)
+
+const errRegenerateCredentials = "cannot unmarshal regenerated credentials of platform %s"
+
+// PlatformClientI registers platforms with the service manager, it requires credentials of the subaccount-admin plan
+type PlatformClientI interface {
+	// RegisterPlatform returns the registered platform including its credentials, which are not returned afterwards
+	RegisterPlatform(ctx context.Context, payload servicemanager.RegisterPlatformRequestPayload) (*servicemanager.RegisteredPlatformResponseObject, error)
+	UpdatePlatform(ctx context.Context, id string, payload servicemanager.UpdatePlatformRequestPayload) error
+	// RegenerateCredentials issues new credentials for the platform, the previous ones are invalidated
+	RegenerateCredentials(ctx context.Context, id string) (*servicemanager.Credentials, error)
+	UnregisterPlatform(ctx context.Context, id string) error
+	// GetPlatform returns nil if no platform with that id exists
+	GetPlatform(ctx context.Context, id string) (*servicemanager.PlatformResponseObject, error)
+	// FindPlatform returns nil if no platform with that name exists
+	FindPlatform(ctx context.Context, name string) (*servicemanager.PlatformResponseObject, error)
+}
+
+var _ PlatformClientI = &PlatformClient{}
+
+// PlatformClient is the PlatformClientI implementation on top of the generated service manager API client
+type PlatformClient struct {
+	platforms servicemanager.PlatformsAPI
+
+	// used for regenerating credentials, see RegenerateCredentials
+	jsonClient
+}
+
+// NewPlatformClient creates a PlatformClient authenticating with the given service manager binding credentials
+func NewPlatformClient(ctx context.Context, creds *BindingCredentials) (*PlatformClient, error) {
+	apiClient, err := newAPIClient(ctx, creds)
+	if err != nil {
+		return nil, err
+	}
+	return &PlatformClient{platforms: apiClient.PlatformsAPI, jsonClient: newJsonClient(apiClient)}, nil
+}
+
+func (c *PlatformClient) RegisterPlatform(ctx context.Context, payload servicemanager.RegisterPlatformRequestPayload) (*servicemanager.RegisteredPlatformResponseObject, error) {
+	platform, _, err := c.platforms.RegisterPlatfrom(ctx).RegisterPlatformRequestPayload(payload).Execute()
+	return platform, err
+}
+
+func (c *PlatformClient) UpdatePlatform(ctx context.Context, id string, payload servicemanager.UpdatePlatformRequestPayload) error {
+	_, _, err := c.platforms.PatchPlatfrom(ctx, id).UpdatePlatformRequestPayload(payload).Execute()
+	return err
+}
+
+func (c *PlatformClient) RegenerateCredentials(ctx context.Context, id string) (*servicemanager.Credentials, error) {
+	// the generated API neither supports the query parameter nor returns the credentials of the update
+	path := "/v1/platforms/" + id
+	status, _, body, err := c.do(ctx, http.MethodPatch, path, "regenerateCredentials=true", []byte("{}"))
+	if err != nil {
+		return nil, err
+	}
+	if status >= 300 {
+		return nil, errors.Errorf(errRequestFailed, http.MethodPatch, path, status, string(body))
+	}
+	platform := struct {
+		Credentials *servicemanager.Credentials `json:"credentials"`
+	}{}
+	if err := json.Unmarshal(body, &platform); err != nil {
+		return nil, errors.Wrapf(err, errRegenerateCredentials, id)
+	}
+	return platform.Credentials, nil
+}
+
+func (c *PlatformClient) UnregisterPlatform(ctx context.Context, id string) error {
+	_, raw, err := c.platforms.UnregisterPlatform(ctx, id).Execute()
+	if isNotFound(raw) {
+		return nil
+	}
+	return err
+}
+
+func (c *PlatformClient) GetPlatform(ctx context.Context, id string) (*servicemanager.PlatformResponseObject, error) {
+	platform, raw, err := c.platforms.GetPlatformById(ctx, id).Execute()
+	if isNotFound(raw) {
+		return nil, nil
+	}
+	return platform, err
+}
+
+func (c *PlatformClient) FindPlatform(ctx context.Context, name string) (*servicemanager.PlatformResponseObject, error) {
+	list, _, err := c.platforms.GetAllPlatforms(ctx).FieldQuery(fmt.Sprintf("name eq '%s'", name)).Execute()
+	if err != nil {
+		return nil, err
+	}
+	if len(list.Items) == 0 || list.Items[0].Id == nil {
+		return nil, nil
+	}
+	return c.GetPlatform(ctx, *list.Items[0].Id)
+}
@@ -0,0 +1,56 @@
+package servicemanager
+
+import (
+	"context"
+
+	servicemanager "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+)
+
+var _ PlatformClientI = &PlatformClientFake{}
+
+// PlatformClientFake is a configurable PlatformClientI for tests, it records the payloads and ids it has been called with
+type PlatformClientFake struct {
+	Platform   *servicemanager.PlatformResponseObject
+	Registered *servicemanager.RegisteredPlatformResponseObject
+	// Credentials are returned by RegenerateCredentials
+	Credentials *servicemanager.Credentials
+	Err         error
+
+	RegisterPayload *servicemanager.RegisterPlatformRequestPayload
+	UpdatePayload   *servicemanager.UpdatePlatformRequestPayload
+	UpdatedID       string
+	RegeneratedID   string
+	DeletedID       string
+	LookedUpID      string
+	LookedUpName    string
+}
+
+func (f *PlatformClientFake) RegisterPlatform(ctx context.Context, payload servicemanager.RegisterPlatformRequestPayload) (*servicemanager.RegisteredPlatformResponseObject, error) {
+	f.RegisterPayload = &payload
+	return f.Registered, f.Err
+}
+
+func (f *PlatformClientFake) UpdatePlatform(ctx context.Context, id string, payload servicemanager.UpdatePlatformRequestPayload) error {
+	f.UpdatedID, f.UpdatePayload = id, &payload
+	return f.Err
+}
+
+func (f *PlatformClientFake) RegenerateCredentials(ctx context.Context, id string) (*servicemanager.Credentials, error) {
+	f.RegeneratedID = id
+	return f.Credentials, f.Err
+}
+
+func (f *PlatformClientFake) UnregisterPlatform(ctx context.Context, id string) error {
+	f.DeletedID = id
+	return f.Err
+}
+
+func (f *PlatformClientFake) GetPlatform(ctx context.Context, id string) (*servicemanager.PlatformResponseObject, error) {
+	f.LookedUpID = id
+	return f.Platform, f.Err
+}
+
+func (f *PlatformClientFake) FindPlatform(ctx context.Context, name string) (*servicemanager.PlatformResponseObject, error) {
+	f.LookedUpName = name
+	return f.Platform, f.Err
+}
@@ -0,0 +1,109 @@
+package servicemanager
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/sap/crossplane-provider-btp/internal"
+	servicemanager "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestPlatformClient creates a PlatformClient talking to a test server with the given handler
+func newTestPlatformClient(t *testing.T, handler http.HandlerFunc) *PlatformClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	serverURL, _ := url.Parse(server.URL)
+	cfg := servicemanager.NewConfiguration()
+	cfg.Host = serverURL.Host
+	cfg.Scheme = serverURL.Scheme
+	cfg.HTTPClient = server.Client()
+
+	apiClient := servicemanager.NewAPIClient(cfg)
+	return &PlatformClient{platforms: apiClient.PlatformsAPI, jsonClient: newJsonClient(apiClient)}
+}
+
+func TestGetPlatform(t *testing.T) {
+	tests := map[string]struct {
+		handler http.HandlerFunc
+		want    *servicemanager.PlatformResponseObject
+		wantErr bool
+	}{
+		"NotFound": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusNotFound, map[string]string{})
+			},
+		},
+		"Error": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusInternalServerError, map[string]string{})
+			},
+			wantErr: true,
+		},
+		"Found": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				writeJson(w, http.StatusOK, servicemanager.PlatformResponseObject{Id: internal.Ptr("platform-id")})
+			},
+			want: &servicemanager.PlatformResponseObject{Id: internal.Ptr("platform-id")},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := newTestPlatformClient(t, tc.handler)
+			got, err := c.GetPlatform(context.TODO(), "platform-id")
+			if tc.wantErr != (err != nil) {
+				t.Errorf("GetPlatform() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !tc.wantErr {
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFindPlatform(t *testing.T) {
+	var fieldQuery string
+	c := newTestPlatformClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/platforms" {
+			fieldQuery = r.URL.Query().Get("fieldQuery")
+			writeJson(w, http.StatusOK, servicemanager.PlatformResponseList{
+				Items: []servicemanager.ListedPlatformResponseObject{{Id: internal.Ptr("platform-id")}},
+			})
+			return
+		}
+		writeJson(w, http.StatusOK, servicemanager.PlatformResponseObject{Id: internal.Ptr("platform-id"), Name: internal.Ptr("platform")})
+	})
+
+	got, err := c.FindPlatform(context.TODO(), "platform")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "name eq 'platform'", fieldQuery)
+	assert.Equal(t, &servicemanager.PlatformResponseObject{Id: internal.Ptr("platform-id"), Name: internal.Ptr("platform")}, got)
+}
+
+func TestUnregisterPlatformNotFound(t *testing.T) {
+	c := newTestPlatformClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJson(w, http.StatusNotFound, map[string]string{})
+	})
+	assert.NoError(t, c.UnregisterPlatform(context.TODO(), "platform-id"))
+}
+
+func TestRegenerateCredentials(t *testing.T) {
+	var method, query string
+	c := newTestPlatformClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method, query = r.Method+" "+r.URL.Path, r.URL.RawQuery
+		writeJson(w, http.StatusOK, map[string]interface{}{
+			"id":          "platform-id",
+			"credentials": map[string]interface{}{"basic": map[string]string{"username": "user", "password": "pass"}},
+		})
+	})
+	got, err := c.RegenerateCredentials(context.TODO(), "platform-id")
+	assert.NoError(t, err)
+	assert.Equal(t, "PATCH /v1/platforms/platform-id", method)
+	assert.Equal(t, "regenerateCredentials=true", query)
+	assert.Equal(t, &servicemanager.Credentials{Basic: &servicemanager.CredentialsBasic{Username: internal.Ptr("user"), Password: internal.Ptr("pass")}}, got)
+}
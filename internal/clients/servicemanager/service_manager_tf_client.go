@@ -14,6 +14,7 @@ import (
 
 	"github.com/sap/crossplane-provider-btp/internal"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // ResourcesStatus contains a summary of the status of the tf resources managed by the ITfClient
@@ -35,6 +36,13 @@ type ITfClient interface {
 	CreateResources(ctx context.Context, cr *apisv1beta1.ServiceManager) (string, string, error)
 	UpdateResources(ctx context.Context, cr *apisv1beta1.ServiceManager) error
 	DeleteResources(ctx context.Context, cr *apisv1beta1.ServiceManager) error
+
+	// RotateBinding creates a new binding with the given name next to the current one, returns its id and the binding it replaces
+	RotateBinding(ctx context.Context, cr *apisv1beta1.ServiceManager, bindingName string) (string, apisv1beta1.RetiredResources, error)
+	// MigratePlan creates a new instance of the given plan and a binding for it next to the current ones, returns their ids and the resources they replace
+	MigratePlan(ctx context.Context, cr *apisv1beta1.ServiceManager, instanceName string, bindingName string, planID string) (string, string, apisv1beta1.RetiredResources, error)
+	// DeleteRetired deletes replaced resources
+	DeleteRetired(ctx context.Context, cr *apisv1beta1.ServiceManager, retired apisv1beta1.RetiredResources) error
 }
 
 type Defaults struct {
@@ -79,13 +87,48 @@ func (tfI *TfClientInitializer) ConnectResources(ctx context.Context, cr *apisv1
 		sInstance:  siInstance,
 		sbExternal: sbExternal,
 		sBinding:   siBinding,
+
+		initializer: tfI,
 	}, nil
 }
 
 func (tfI *TfClientInitializer) serviceInstanceCr(sm *apisv1beta1.ServiceManager) *apisv1alpha1.SubaccountServiceInstance {
-	name := sm.Spec.ForProvider.ServiceInstanceName
+	name := sm.Status.AtProvider.ServiceInstanceName
 	if name == "" {
-		name = tfI.defaults.InstanceName
+		name = tfI.instanceName(sm)
+	}
+	planID := sm.Status.AtProvider.ServiceInstancePlanID
+	if planID == "" {
+		planID = sm.Status.AtProvider.DataSourceLookup.ServiceManagerPlanID
+	}
+	sInstanceId, _ := splitExternalName(meta.GetExternalName(sm))
+	sInstance := tfI.instanceCr(sm, name, planID, sInstanceId)
+	sInstance.DeletionTimestamp = sm.DeletionTimestamp
+	return sInstance
+}
+
+// instanceName returns the name of the instance as configured in the spec
+func (tfI *TfClientInitializer) instanceName(sm *apisv1beta1.ServiceManager) string {
+	if sm.Spec.ForProvider.ServiceInstanceName != "" {
+		return sm.Spec.ForProvider.ServiceInstanceName
+	}
+	return tfI.defaults.InstanceName
+}
+
+// bindingName returns the name of the binding as configured in the spec
+func (tfI *TfClientInitializer) bindingName(sm *apisv1beta1.ServiceManager) string {
+	if sm.Spec.ForProvider.ServiceBindingName != "" {
+		return sm.Spec.ForProvider.ServiceBindingName
+	}
+	return tfI.defaults.BindingName
+}
+
+// instanceCr builds the tf resource of an instance, each instance name gets its own UID and thereby its own tf workspace,
+// the instance named as in the spec keeps the UID used before rotations were supported
+func (tfI *TfClientInitializer) instanceCr(sm *apisv1beta1.ServiceManager, name string, planID string, instanceID string) *apisv1alpha1.SubaccountServiceInstance {
+	uid := sm.UID + "-service-instance"
+	if name != tfI.instanceName(sm) {
+		uid += types.UID("-" + name)
 	}
 
 	sInstance := &apisv1alpha1.SubaccountServiceInstance{
@@ -94,9 +137,8 @@ func (tfI *TfClientInitializer) serviceInstanceCr(sm *apisv1beta1.ServiceManager
 			APIVersion: apisv1alpha1.CRDGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:              "SERVICE_MANAGER_INSTANCE",
-			UID:               sm.UID + "-service-instance",
-			DeletionTimestamp: sm.DeletionTimestamp,
+			Name: "SERVICE_MANAGER_INSTANCE",
+			UID:  uid,
 		},
 		Spec: apisv1alpha1.SubaccountServiceInstanceSpec{
 			ResourceSpec: xpv1.ResourceSpec{
@@ -107,22 +149,33 @@ func (tfI *TfClientInitializer) serviceInstanceCr(sm *apisv1beta1.ServiceManager
 			},
 			ForProvider: apisv1alpha1.SubaccountServiceInstanceParameters{
 				Name:          &name,
-				ServiceplanID: &sm.Status.AtProvider.DataSourceLookup.ServiceManagerPlanID,
+				ServiceplanID: &planID,
 				SubaccountID:  internal.Ptr(sm.Spec.ForProvider.SubaccountGuid),
 			},
 			InitProvider: apisv1alpha1.SubaccountServiceInstanceInitParameters{},
 		},
 		Status: apisv1alpha1.SubaccountServiceInstanceStatus{},
 	}
-	sInstanceId, _ := splitExternalName(meta.GetExternalName(sm))
-	meta.SetExternalName(sInstance, sInstanceId)
+	meta.SetExternalName(sInstance, instanceID)
 	return sInstance
 }
 
 func (tfI *TfClientInitializer) serviceBindingCr(sm *apisv1beta1.ServiceManager) *apisv1alpha1.SubaccountServiceBinding {
-	name := sm.Spec.ForProvider.ServiceBindingName
+	name := sm.Status.AtProvider.ServiceBindingName
 	if name == "" {
-		name = tfI.defaults.BindingName
+		name = tfI.bindingName(sm)
+	}
+	sInstanceId, sBindingId := splitExternalName(meta.GetExternalName(sm))
+	sBinding := tfI.bindingCr(sm, name, sInstanceId, sBindingId)
+	sBinding.DeletionTimestamp = sm.DeletionTimestamp
+	return sBinding
+}
+
+// bindingCr builds the tf resource of a binding, UIDs are assigned like for instances
+func (tfI *TfClientInitializer) bindingCr(sm *apisv1beta1.ServiceManager, name string, instanceID string, bindingID string) *apisv1alpha1.SubaccountServiceBinding {
+	uid := sm.UID + "-service-binding"
+	if name != tfI.bindingName(sm) {
+		uid += types.UID("-" + name)
 	}
 
 	sBinding := &apisv1alpha1.SubaccountServiceBinding{
@@ -131,9 +184,8 @@ func (tfI *TfClientInitializer) serviceBindingCr(sm *apisv1beta1.ServiceManager)
 			APIVersion: apisv1alpha1.CRDGroupVersion.String(),
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:              "SERVICE_MANAGER_INSTANCE",
-			UID:               sm.UID + "-service-binding",
-			DeletionTimestamp: sm.DeletionTimestamp,
+			Name: "SERVICE_MANAGER_INSTANCE",
+			UID:  uid,
 		},
 		Spec: apisv1alpha1.SubaccountServiceBindingSpec{
 			ResourceSpec: xpv1.ResourceSpec{
@@ -144,14 +196,13 @@ func (tfI *TfClientInitializer) serviceBindingCr(sm *apisv1beta1.ServiceManager)
 			},
 			ForProvider: apisv1alpha1.SubaccountServiceBindingParameters{
 				Name:              &name,
-				ServiceInstanceID: internal.Ptr(meta.GetExternalName(sm)),
+				ServiceInstanceID: internal.Ptr(instanceID),
 				SubaccountID:      internal.Ptr(sm.Spec.ForProvider.SubaccountGuid),
 			},
 		},
 		Status: apisv1alpha1.SubaccountServiceBindingStatus{},
 	}
-	_, sBindingId := splitExternalName(meta.GetExternalName(sm))
-	meta.SetExternalName(sBinding, sBindingId)
+	meta.SetExternalName(sBinding, bindingID)
 	return sBinding
 }
 
@@ -163,6 +214,9 @@ type TfClient struct {
 
 	sInstance *apisv1alpha1.SubaccountServiceInstance
 	sBinding  *apisv1alpha1.SubaccountServiceBinding
+
+	// initializer connects the additional resources needed for rotations and plan migrations
+	initializer *TfClientInitializer
 }
 
 func (tf *TfClient) DeleteResources(ctx context.Context, cr *apisv1beta1.ServiceManager) error {
@@ -245,6 +299,88 @@ func (tf *TfClient) resourcesUpToDate(ctx context.Context) bool {
 	return err != nil || siObs.ResourceUpToDate
 }
 
+func (tf *TfClient) RotateBinding(ctx context.Context, cr *apisv1beta1.ServiceManager, bindingName string) (string, apisv1beta1.RetiredResources, error) {
+	bID, err := tf.createAdditionalBinding(ctx, cr, meta.GetExternalName(tf.sInstance), bindingName)
+	if err != nil {
+		return "", apisv1beta1.RetiredResources{}, err
+	}
+	return bID, apisv1beta1.RetiredResources{
+		ServiceInstanceID:  meta.GetExternalName(tf.sInstance),
+		ServiceBindingID:   meta.GetExternalName(tf.sBinding),
+		ServiceBindingName: internal.Val(tf.sBinding.Spec.ForProvider.Name),
+	}, nil
+}
+
+func (tf *TfClient) MigratePlan(ctx context.Context, cr *apisv1beta1.ServiceManager, instanceName string, bindingName string, planID string) (string, string, apisv1beta1.RetiredResources, error) {
+	sID, err := tf.createAdditionalInstance(ctx, cr, instanceName, planID)
+	if err != nil {
+		return "", "", apisv1beta1.RetiredResources{}, err
+	}
+	bID, err := tf.createAdditionalBinding(ctx, cr, sID, bindingName)
+	if err != nil {
+		// the new instance isn't referenced anywhere yet, so it is removed right away to allow a clean retry
+		if delErr := tf.DeleteRetired(ctx, cr, apisv1beta1.RetiredResources{ServiceInstanceID: sID, ServiceInstanceName: instanceName}); delErr != nil {
+			return "", "", apisv1beta1.RetiredResources{}, errors.Wrapf(delErr, "binding creation failed (%s), cannot delete new instance %s", err, sID)
+		}
+		return "", "", apisv1beta1.RetiredResources{}, err
+	}
+	return sID, bID, apisv1beta1.RetiredResources{
+		ServiceInstanceID:   meta.GetExternalName(tf.sInstance),
+		ServiceInstanceName: internal.Val(tf.sInstance.Spec.ForProvider.Name),
+		ServiceBindingID:    meta.GetExternalName(tf.sBinding),
+		ServiceBindingName:  internal.Val(tf.sBinding.Spec.ForProvider.Name),
+	}, nil
+}
+
+func (tf *TfClient) createAdditionalInstance(ctx context.Context, cr *apisv1beta1.ServiceManager, name string, planID string) (string, error) {
+	sInstance := tf.initializer.instanceCr(cr, name, planID, "")
+	siExternal, err := tf.initializer.siConnector.Connect(ctx, sInstance)
+	if err != nil {
+		return "", err
+	}
+	if _, err := siExternal.Create(ctx, sInstance); err != nil {
+		return "", err
+	}
+	return meta.GetExternalName(sInstance), nil
+}
+
+func (tf *TfClient) createAdditionalBinding(ctx context.Context, cr *apisv1beta1.ServiceManager, instanceID string, name string) (string, error) {
+	sBinding := tf.initializer.bindingCr(cr, name, instanceID, "")
+	sbExternal, err := tf.initializer.sbConnector.Connect(ctx, sBinding)
+	if err != nil {
+		return "", err
+	}
+	if _, err := sbExternal.Create(ctx, sBinding); err != nil {
+		return "", err
+	}
+	return meta.GetExternalName(sBinding), nil
+}
+
+// DeleteRetired deletes the binding before the instance, the instance is only deleted if it has been replaced as well
+func (tf *TfClient) DeleteRetired(ctx context.Context, cr *apisv1beta1.ServiceManager, retired apisv1beta1.RetiredResources) error {
+	if retired.ServiceBindingID != "" {
+		sBinding := tf.initializer.bindingCr(cr, retired.ServiceBindingName, retired.ServiceInstanceID, retired.ServiceBindingID)
+		sbExternal, err := tf.initializer.sbConnector.Connect(ctx, sBinding)
+		if err != nil {
+			return err
+		}
+		if err := sbExternal.Delete(ctx, sBinding); err != nil {
+			return err
+		}
+	}
+	if retired.ServiceInstanceID != "" && retired.ServiceInstanceName != "" {
+		sInstance := tf.initializer.instanceCr(cr, retired.ServiceInstanceName, "", retired.ServiceInstanceID)
+		siExternal, err := tf.initializer.siConnector.Connect(ctx, sInstance)
+		if err != nil {
+			return err
+		}
+		if err := siExternal.Delete(ctx, sInstance); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (tf *TfClient) createInstance(ctx context.Context) (string, error) {
 	if _, err := tf.siExternal.Create(ctx, tf.sInstance); err != nil {
 		return "", err
@@ -108,6 +108,34 @@ func TestConnectResources(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "RotatedResources",
+			cr: func() *v1beta1.ServiceManager {
+				cr := testSMCr("subaccountId", "newPlanId", "instanceID/bindingID", "instanceID", "custom-name", "another-custom-name")
+				cr.Status.AtProvider.ServiceInstanceName = "custom-name-1"
+				cr.Status.AtProvider.ServiceInstancePlanID = "planId"
+				cr.Status.AtProvider.ServiceBindingName = "another-custom-name-1"
+				return cr
+			}(),
+			instanceConnectorMock: func() (managed.ExternalClient, error) {
+				return ExternalClientFake{}, nil
+			},
+			bindingConnectorMock: func() (managed.ExternalClient, error) {
+				return ExternalClientFake{}, nil
+			},
+			want: want{
+				instanceSpec: v1alpha1.SubaccountServiceInstanceParameters{
+					Name:          internal.Ptr("custom-name-1"),
+					ServiceplanID: internal.Ptr("planId"),
+					SubaccountID:  internal.Ptr("subaccountId"),
+				},
+				bindingSpec: v1alpha1.SubaccountServiceBindingParameters{
+					SubaccountID:      internal.Ptr("subaccountId"),
+					Name:              internal.Ptr("another-custom-name-1"),
+					ServiceInstanceID: internal.Ptr("instanceID"),
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -653,6 +681,277 @@ func TestDeleteResources(t *testing.T) {
 	}
 }
 
+func TestResourceUIDs(t *testing.T) {
+	tfI := NewServiceManagerTfClient(nil, nil, Defaults{DefaultServiceName, DefaultBindingName})
+	cr := testSMCr("subaccountId", "planId", "", "", "", "")
+	cr.UID = "uid"
+
+	// resources named as in the spec keep the workspaces used before rotations were supported
+	if got := tfI.instanceCr(cr, DefaultServiceName, "planId", "").UID; got != "uid-service-instance" {
+		t.Errorf("instanceCr() UID = %s, want uid-service-instance", got)
+	}
+	if got := tfI.bindingCr(cr, DefaultBindingName, "", "").UID; got != "uid-service-binding" {
+		t.Errorf("bindingCr() UID = %s, want uid-service-binding", got)
+	}
+	if got := tfI.instanceCr(cr, "rotated", "planId", "").UID; got != "uid-service-instance-rotated" {
+		t.Errorf("instanceCr() UID = %s, want uid-service-instance-rotated", got)
+	}
+	if got := tfI.bindingCr(cr, "rotated", "", "").UID; got != "uid-service-binding-rotated" {
+		t.Errorf("bindingCr() UID = %s, want uid-service-binding-rotated", got)
+	}
+}
+
+func TestRotateBinding(t *testing.T) {
+	type want struct {
+		err     error
+		bID     string
+		retired v1beta1.RetiredResources
+	}
+	tests := []struct {
+		name       string
+		sbExternal ExternalClientFake
+		want       want
+	}{
+		{
+			name: "CreateError",
+			sbExternal: ExternalClientFake{
+				createFn: func(mg resource.Managed) (managed.ExternalCreation, error) {
+					return managed.ExternalCreation{}, errors.New("bindingCreateError")
+				},
+			},
+			want: want{
+				err: errors.New("bindingCreateError"),
+			},
+		},
+		{
+			name: "Success",
+			sbExternal: ExternalClientFake{
+				createFn: func(mg resource.Managed) (managed.ExternalCreation, error) {
+					binding := mg.(*v1alpha1.SubaccountServiceBinding)
+					if internal.Val(binding.Spec.ForProvider.Name) != "binding-2" || internal.Val(binding.Spec.ForProvider.ServiceInstanceID) != "someID" {
+						return managed.ExternalCreation{}, errors.New("unexpected binding")
+					}
+					setExternalName(mg, "newID")
+					return managed.ExternalCreation{}, nil
+				},
+			},
+			want: want{
+				bID:     "newID",
+				retired: v1beta1.RetiredResources{ServiceInstanceID: "someID", ServiceBindingID: "anotherID", ServiceBindingName: "binding-1"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sBinding := testServiceBinding("anotherID")
+			sBinding.Spec.ForProvider.Name = internal.Ptr("binding-1")
+			uua := &TfClient{
+				sInstance: testServiceInstance("someID"),
+				sBinding:  sBinding,
+				initializer: NewServiceManagerTfClient(
+					nil,
+					&ExternalConnectorFake{func() (managed.ExternalClient, error) { return tc.sbExternal, nil }},
+					Defaults{DefaultServiceName, DefaultBindingName},
+				),
+			}
+			bID, retired, err := uua.RotateBinding(context.TODO(), testSMCr("subaccountId", "planId", "someID/anotherID", "someID", "", ""), "binding-2")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.RotateBinding(): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.bID, bID); diff != "" {
+				t.Errorf("\ne.RotateBinding(): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.retired, retired); diff != "" {
+				t.Errorf("\ne.RotateBinding(): -want retired, +got retired:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestMigratePlan(t *testing.T) {
+	type want struct {
+		err              error
+		sID              string
+		bID              string
+		retired          v1beta1.RetiredResources
+		instanceDeletion bool
+	}
+	tests := []struct {
+		name       string
+		siExternal ExternalClientFake
+		sbExternal ExternalClientFake
+		want       want
+	}{
+		{
+			name: "InstanceCreateError",
+			siExternal: ExternalClientFake{
+				createFn: func(mg resource.Managed) (managed.ExternalCreation, error) {
+					return managed.ExternalCreation{}, errors.New("instanceCreateError")
+				},
+			},
+			want: want{
+				err: errors.New("instanceCreateError"),
+			},
+		},
+		{
+			// the new instance isn't needed without binding and is deleted right away
+			name: "BindingCreateError",
+			siExternal: ExternalClientFake{
+				createFn: func(mg resource.Managed) (managed.ExternalCreation, error) {
+					setExternalName(mg, "newInstanceID")
+					return managed.ExternalCreation{}, nil
+				},
+			},
+			sbExternal: ExternalClientFake{
+				createFn: func(mg resource.Managed) (managed.ExternalCreation, error) {
+					return managed.ExternalCreation{}, errors.New("bindingCreateError")
+				},
+			},
+			want: want{
+				err:              errors.New("bindingCreateError"),
+				instanceDeletion: true,
+			},
+		},
+		{
+			name: "Success",
+			siExternal: ExternalClientFake{
+				createFn: func(mg resource.Managed) (managed.ExternalCreation, error) {
+					instance := mg.(*v1alpha1.SubaccountServiceInstance)
+					if internal.Val(instance.Spec.ForProvider.Name) != "instance-2" || internal.Val(instance.Spec.ForProvider.ServiceplanID) != "newPlanId" {
+						return managed.ExternalCreation{}, errors.New("unexpected instance")
+					}
+					setExternalName(mg, "newInstanceID")
+					return managed.ExternalCreation{}, nil
+				},
+			},
+			sbExternal: ExternalClientFake{
+				createFn: func(mg resource.Managed) (managed.ExternalCreation, error) {
+					binding := mg.(*v1alpha1.SubaccountServiceBinding)
+					if internal.Val(binding.Spec.ForProvider.ServiceInstanceID) != "newInstanceID" {
+						return managed.ExternalCreation{}, errors.New("unexpected binding")
+					}
+					setExternalName(mg, "newBindingID")
+					return managed.ExternalCreation{}, nil
+				},
+			},
+			want: want{
+				sID: "newInstanceID",
+				bID: "newBindingID",
+				retired: v1beta1.RetiredResources{
+					ServiceInstanceID:   "someID",
+					ServiceInstanceName: "instance-1",
+					ServiceBindingID:    "anotherID",
+					ServiceBindingName:  "binding-1",
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			instanceDeletion := false
+			tc.siExternal.deleteFn = func() error {
+				instanceDeletion = true
+				return nil
+			}
+			sInstance := testServiceInstance("someID")
+			sInstance.Spec.ForProvider.Name = internal.Ptr("instance-1")
+			sBinding := testServiceBinding("anotherID")
+			sBinding.Spec.ForProvider.Name = internal.Ptr("binding-1")
+			uua := &TfClient{
+				sInstance: sInstance,
+				sBinding:  sBinding,
+				initializer: NewServiceManagerTfClient(
+					&ExternalConnectorFake{func() (managed.ExternalClient, error) { return tc.siExternal, nil }},
+					&ExternalConnectorFake{func() (managed.ExternalClient, error) { return tc.sbExternal, nil }},
+					Defaults{DefaultServiceName, DefaultBindingName},
+				),
+			}
+			sID, bID, retired, err := uua.MigratePlan(context.TODO(), testSMCr("subaccountId", "newPlanId", "someID/anotherID", "someID", "", ""), "instance-2", "binding-2", "newPlanId")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.MigratePlan(): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.sID, sID); diff != "" {
+				t.Errorf("\ne.MigratePlan(): -want instance, +got instance:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.bID, bID); diff != "" {
+				t.Errorf("\ne.MigratePlan(): -want binding, +got binding:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.retired, retired); diff != "" {
+				t.Errorf("\ne.MigratePlan(): -want retired, +got retired:\n%s\n", diff)
+			}
+			if instanceDeletion != tc.want.instanceDeletion {
+				t.Errorf("e.MigratePlan() deleted instance: %t, want %t", instanceDeletion, tc.want.instanceDeletion)
+			}
+		})
+	}
+}
+
+func TestDeleteRetired(t *testing.T) {
+	type want struct {
+		err              error
+		bindingDeletion  bool
+		instanceDeletion bool
+	}
+	tests := []struct {
+		name          string
+		retired       v1beta1.RetiredResources
+		bindingDelErr error
+		want          want
+	}{
+		{
+			name:    "BindingOnly",
+			retired: v1beta1.RetiredResources{ServiceInstanceID: "someID", ServiceBindingID: "anotherID", ServiceBindingName: "binding-1"},
+			want: want{
+				bindingDeletion: true,
+			},
+		},
+		{
+			name:    "BindingAndInstance",
+			retired: v1beta1.RetiredResources{ServiceInstanceID: "someID", ServiceInstanceName: "instance-1", ServiceBindingID: "anotherID", ServiceBindingName: "binding-1"},
+			want: want{
+				bindingDeletion:  true,
+				instanceDeletion: true,
+			},
+		},
+		{
+			name:          "BindingDeleteError",
+			retired:       v1beta1.RetiredResources{ServiceInstanceID: "someID", ServiceInstanceName: "instance-1", ServiceBindingID: "anotherID", ServiceBindingName: "binding-1"},
+			bindingDelErr: errors.New("bindingDeleteError"),
+			want: want{
+				err:             errors.New("bindingDeleteError"),
+				bindingDeletion: true,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bindingDeletion, instanceDeletion := false, false
+			siExternal := ExternalClientFake{deleteFn: func() error {
+				instanceDeletion = true
+				return nil
+			}}
+			sbExternal := ExternalClientFake{deleteFn: func() error {
+				bindingDeletion = true
+				return tc.bindingDelErr
+			}}
+			uua := &TfClient{
+				initializer: NewServiceManagerTfClient(
+					&ExternalConnectorFake{func() (managed.ExternalClient, error) { return siExternal, nil }},
+					&ExternalConnectorFake{func() (managed.ExternalClient, error) { return sbExternal, nil }},
+					Defaults{DefaultServiceName, DefaultBindingName},
+				),
+			}
+			err := uua.DeleteRetired(context.TODO(), testSMCr("subaccountId", "planId", "someID/newID", "someID", "", ""), tc.retired)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.DeleteRetired(): -want error, +got error:\n%s\n", diff)
+			}
+			if bindingDeletion != tc.want.bindingDeletion || instanceDeletion != tc.want.instanceDeletion {
+				t.Errorf("e.DeleteRetired() deleted binding: %t, instance: %t, want %t, %t", bindingDeletion, instanceDeletion, tc.want.bindingDeletion, tc.want.instanceDeletion)
+			}
+		})
+	}
+}
+
 // Utils
 func testSMCr(saId, planId, extName, statusInstanceID, serviceInstanceName, serviceBindingName string) *v1beta1.ServiceManager {
 	sm := &v1beta1.ServiceManager{
@@ -2,9 +2,15 @@ package subscription
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
 
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
 	"github.com/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
@@ -13,8 +19,14 @@ import (
 	"golang.org/x/oauth2/clientcredentials"
 )
 
-// SubscriptionGet generic Get type that could be autogenerated, can be alias of existing client implementations value object
-type SubscriptionGet = saas_client.EntitledApplicationsResponseObject
+const errGetApplicationStatus = "getting entitled application failed with status %d"
+
+// SubscriptionGet generic Get type that could be autogenerated, here encapsulate existing api client type along with the dependencies of the application, which the client does not expose
+type SubscriptionGet struct {
+	saas_client.EntitledApplicationsResponseObject
+	// Dependencies names of the applications the subscribed application depends on
+	Dependencies []string
+}
 
 // SubscriptionPost generic Post type that could be autogenerated, here encapsulate existing api client type along with additional appName that is required for POST operation
 type SubscriptionPost struct {
@@ -28,6 +40,14 @@ type SubscriptionPut struct {
 	saas_client.UpdateSubscriptionRequestPayload
 }
 
+// ApplicationMetadata describes what an entitled SaaS application expects from a subscription to one of its plans
+type ApplicationMetadata struct {
+	// ParamsSchema JSON schema of the subscription parameters, nil if the application does not define one
+	ParamsSchema map[string]interface{}
+	// Dependencies names of the applications the application depends on
+	Dependencies []string
+}
+
 // SubscriptionApiHandlerI interface that abstracts all API client operations that have to be exposed towards controller
 // represents basic Rest CRUD operations
 type SubscriptionApiHandlerI interface {
@@ -35,6 +55,8 @@ type SubscriptionApiHandlerI interface {
 	UpdateSubscription(ctx context.Context, externalName string, payload SubscriptionPut) error
 	DeleteSubscription(ctx context.Context, externalName string) error
 	GetSubscription(ctx context.Context, externalName string) (*SubscriptionGet, error)
+	// GetApplicationMetadata returns the metadata of an entitled application plan, nil if the subaccount is not entitled to it
+	GetApplicationMetadata(ctx context.Context, appName string, planName string) (*ApplicationMetadata, error)
 }
 
 // SubscriptionTypeMapperI interface to encapsulate all domain logic for making the controller work with otherwise unknown API and its types
@@ -51,6 +73,8 @@ type SubscriptionTypeMapperI interface {
 	IsDeletable(cr *v1alpha1.Subscription) bool
 	// SyncStatus allows to pull some data from external API resource towards the CR status
 	SyncStatus(get *SubscriptionGet, crStatus *v1alpha1.SubscriptionObservation)
+	// Validate checks the CR against the metadata of the application it subscribes to, returns a list of issues or nil if valid
+	Validate(cr *v1alpha1.Subscription, metadata *ApplicationMetadata) []string
 }
 
 var _ SubscriptionApiHandlerI = &SubscriptionApiHandler{}
@@ -129,11 +153,39 @@ func (s *SubscriptionApiHandler) GetSubscription(ctx context.Context, externalNa
 	}
 
 	// if an app has been subscribed once in an subaccount it will be present in the api, but with a Not subscribed state
-	if res != nil && res.State != nil && *res.State == v1alpha1.SubscriptionStateNotSubscribed {
+	if res == nil || (res.State != nil && *res.State == v1alpha1.SubscriptionStateNotSubscribed) {
 		return nil, nil
 	}
 
-	return res, nil
+	return &SubscriptionGet{EntitledApplicationsResponseObject: *res, Dependencies: readDependencies(raw)}, nil
+}
+
+func (s *SubscriptionApiHandler) GetApplicationMetadata(ctx context.Context, appName string, planName string) (*ApplicationMetadata, error) {
+	res, raw, err := s.client.SubscriptionOperationsForAppConsumersAPI.
+		GetEntitledApplication(ctx, appName).
+		PlanName(planName).
+		Execute()
+	if raw != nil && raw.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	// any other failure (e.g. 429 when being throttled) is returned to be retried instead of being taken as not entitled
+	if err != nil {
+		if raw != nil {
+			return nil, errors.Wrapf(specifyAPIError(err), errGetApplicationStatus, raw.StatusCode)
+		}
+		return nil, specifyAPIError(err)
+	}
+	if res == nil {
+		return nil, nil
+	}
+
+	metadata := &ApplicationMetadata{}
+	if res.ParamsSchema != nil && !internal.Val(res.ParamsSchema.Empty) && len(res.ParamsSchema.AdditionalProperties) > 0 {
+		metadata.ParamsSchema = res.ParamsSchema.AdditionalProperties
+	}
+	metadata.Dependencies = readDependencies(raw)
+
+	return metadata, nil
 }
 
 var _ SubscriptionTypeMapperI = &SubscriptionTypeMapper{}
@@ -162,6 +214,25 @@ func (s *SubscriptionTypeMapper) IsDeletable(cr *v1alpha1.Subscription) bool {
 
 func (s *SubscriptionTypeMapper) SyncStatus(get *SubscriptionGet, crStatus *v1alpha1.SubscriptionObservation) {
 	crStatus.State = get.State
+	crStatus.AppId = get.AppId
+	crStatus.SubscriptionUrl = get.SubscriptionUrl
+	crStatus.SubscribedTenantId = get.SubscribedTenantId
+	crStatus.SubscriptionGUID = get.SubscriptionGUID
+	crStatus.FailureReason = failureReason(get.SubscriptionError)
+	crStatus.Dependencies = get.Dependencies
+}
+
+func (s *SubscriptionTypeMapper) Validate(cr *v1alpha1.Subscription, metadata *ApplicationMetadata) []string {
+	if metadata == nil {
+		return []string{fmt.Sprintf("subaccount is not entitled to application %q with plan %q", cr.Spec.ForProvider.AppName, cr.Spec.ForProvider.PlanName)}
+	}
+
+	params, err := internal.UnmarshalRawParameters(cr.Spec.ForProvider.SubscriptionParameters.DeepCopy().Raw)
+	if err != nil {
+		return []string{fmt.Sprintf("parameters can not be parsed: %s", err)}
+	}
+
+	return validateParameters(metadata.ParamsSchema, params)
 }
 
 func (s *SubscriptionTypeMapper) ConvertToCreatePayload(cr *v1alpha1.Subscription) SubscriptionPost {
@@ -192,6 +263,74 @@ func (s *SubscriptionTypeMapper) IsUpToDate(cr *v1alpha1.Subscription, get *Subs
 	return true
 }
 
+// failureReason combines the error details of a failed subscription operation into a single message, nil if there is none
+func failureReason(subErr *saas_client.EntitledApplicationsErrorResponseObject) *string {
+	if subErr == nil {
+		return nil
+	}
+	var reasons []string
+	for _, reason := range []*string{subErr.ErrorMessage, subErr.AppError} {
+		if internal.Val(reason) != "" {
+			reasons = append(reasons, *reason)
+		}
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	return internal.Ptr(strings.Join(reasons, ": "))
+}
+
+// validateParameters validates subscription parameters against the JSON schema published by the application, an unusable schema is not enforced
+func validateParameters(schema map[string]interface{}, params map[string]interface{}) []string {
+	if schema == nil {
+		return nil
+	}
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return nil
+	}
+	var paramsSchema spec.Schema
+	if err := json.Unmarshal(raw, &paramsSchema); err != nil {
+		return nil
+	}
+
+	var issues []string
+	result := validate.NewSchemaValidator(&paramsSchema, nil, "parameters", strfmt.Default).Validate(params)
+	for _, e := range result.Errors {
+		issues = append(issues, e.Error())
+	}
+	return issues
+}
+
+// readDependencies extracts the names of dependent applications from the raw api response, the generated client does not expose them
+func readDependencies(raw *http.Response) []string {
+	if raw == nil || raw.Body == nil {
+		return nil
+	}
+	body, err := io.ReadAll(raw.Body)
+	if err != nil {
+		return nil
+	}
+	var app struct {
+		Dependencies []saas_client.DependenciesResponseObject `json:"dependencies"`
+	}
+	if err := json.Unmarshal(body, &app); err != nil {
+		return nil
+	}
+
+	var dependencies []string
+	for _, dep := range app.Dependencies {
+		name := internal.Val(dep.AppName)
+		if name == "" {
+			name = internal.Val(dep.Xsappname)
+		}
+		if name != "" {
+			dependencies = append(dependencies, name)
+		}
+	}
+	return dependencies
+}
+
 // splitExternalName splits an externalName into its to part, requires form <appName>/<planName>, returns segments as empty strings, does not protect against misusage
 func splitExternalName(externalName string) (string, string) {
 	fragments := strings.Split(externalName, "/")
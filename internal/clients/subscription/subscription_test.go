@@ -2,7 +2,9 @@ package subscription
 
 import (
 	"context"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -24,7 +26,7 @@ func TestSubscriptionApiHandler_GetSubscription(t *testing.T) {
 		mockSubscriptionApi *MockSubscriptionOperationsConsumer
 
 		wantErr      error
-		wantResponse *saas_client.EntitledApplicationsResponseObject
+		wantResponse *SubscriptionGet
 	}{
 		{
 			name:         "APIerror",
@@ -55,7 +57,7 @@ func TestSubscriptionApiHandler_GetSubscription(t *testing.T) {
 				200,
 				nil,
 			),
-			wantResponse: &saas_client.EntitledApplicationsResponseObject{State: internal.Ptr("UNSUBSCRIBED")},
+			wantResponse: &SubscriptionGet{EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{State: internal.Ptr("UNSUBSCRIBED")}},
 			wantErr:      nil,
 		},
 		{
@@ -67,7 +69,19 @@ func TestSubscriptionApiHandler_GetSubscription(t *testing.T) {
 				nil,
 			),
 			wantErr:      nil,
-			wantResponse: &saas_client.EntitledApplicationsResponseObject{},
+			wantResponse: &SubscriptionGet{},
+		},
+		{
+			name:         "WithDependencies",
+			externalName: "name1/plan2",
+			mockSubscriptionApi: apiMockGETWithBody(
+				&saas_client.EntitledApplicationsResponseObject{State: internal.Ptr(v1alpha1.SubscriptionStateSubscribed)},
+				`{"state": "SUBSCRIBED", "dependencies": [{"appName": "dep-app"}]}`,
+			),
+			wantResponse: &SubscriptionGet{
+				EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{State: internal.Ptr(v1alpha1.SubscriptionStateSubscribed)},
+				Dependencies:                       []string{"dep-app"},
+			},
 		},
 	}
 	for _, tc := range tests {
@@ -290,13 +304,133 @@ age: 30`)
 	assert.Equal(t, float64(30), mapped.SubscriptionParams["age"])
 }
 
+func TestSubscriptionApiHandler_GetApplicationMetadata(t *testing.T) {
+	schema := map[string]interface{}{"type": "object", "required": []interface{}{"tenantAdmin"}}
+	tests := []struct {
+		name         string
+		response     *saas_client.EntitledApplicationsResponseObject
+		raw          *http.Response
+		apiErr       error
+		wantErr      error
+		wantMetadata *ApplicationMetadata
+	}{
+		{
+			name:    "APIerror",
+			raw:     &http.Response{StatusCode: 500},
+			apiErr:  errors.New("apiError"),
+			wantErr: errors.Wrapf(errors.New("apiError"), errGetApplicationStatus, 500),
+		},
+		{
+			name:    "TooManyRequests",
+			raw:     &http.Response{StatusCode: 429},
+			apiErr:  errors.New("tooManyRequests"),
+			wantErr: errors.Wrapf(errors.New("tooManyRequests"), errGetApplicationStatus, 429),
+		},
+		{
+			name:   "NotEntitled",
+			raw:    &http.Response{StatusCode: 404},
+			apiErr: errors.New("notFoundError"),
+		},
+		{
+			name:         "WithoutSchema",
+			response:     &saas_client.EntitledApplicationsResponseObject{ParamsSchema: &saas_client.EntitledApplicationsResponseObjectParamsSchema{Empty: internal.Ptr(true)}},
+			raw:          &http.Response{StatusCode: 200},
+			wantMetadata: &ApplicationMetadata{},
+		},
+		{
+			name:     "WithSchemaAndDependencies",
+			response: &saas_client.EntitledApplicationsResponseObject{ParamsSchema: &saas_client.EntitledApplicationsResponseObjectParamsSchema{AdditionalProperties: schema}},
+			raw: &http.Response{
+				StatusCode: 200,
+				Body:       io.NopCloser(strings.NewReader(`{"dependencies": [{"appName": "dep-app"}, {"xsappname": "dep-xsapp"}]}`)),
+			},
+			wantMetadata: &ApplicationMetadata{ParamsSchema: schema, Dependencies: []string{"dep-app", "dep-xsapp"}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			apiMock := &MockSubscriptionOperationsConsumer{}
+			apiMock.On("GetEntitledApplicationExecute", mock.Anything).Return(tc.response, tc.raw, tc.apiErr)
+			uut := SubscriptionApiHandler{
+				client: &saas_client.APIClient{
+					SubscriptionOperationsForAppConsumersAPI: apiMock,
+				},
+			}
+			metadata, err := uut.GetApplicationMetadata(context.TODO(), "name1", "plan2")
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.GetApplicationMetadata(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.wantMetadata, metadata); diff != "" {
+				t.Errorf("\ne.GetApplicationMetadata(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSubscriptionTypeMapper_Validate(t *testing.T) {
+	schema := map[string]interface{}{
+		"type":     "object",
+		"required": []interface{}{"tenantAdmin"},
+		"properties": map[string]interface{}{
+			"tenantAdmin": map[string]interface{}{"type": "string"},
+		},
+	}
+	tests := []struct {
+		name       string
+		params     string
+		metadata   *ApplicationMetadata
+		wantIssues int
+	}{
+		{
+			name:       "NotEntitled",
+			params:     `{}`,
+			wantIssues: 1,
+		},
+		{
+			name:     "NoSchema",
+			params:   `{"anything": true}`,
+			metadata: &ApplicationMetadata{},
+		},
+		{
+			name:     "ValidParameters",
+			params:   `{"tenantAdmin": "admin@example.com"}`,
+			metadata: &ApplicationMetadata{ParamsSchema: schema},
+		},
+		{
+			name:       "MissingRequiredParameter",
+			params:     ``,
+			metadata:   &ApplicationMetadata{ParamsSchema: schema},
+			wantIssues: 1,
+		},
+		{
+			name:       "WrongParameterType",
+			params:     `{"tenantAdmin": 42}`,
+			metadata:   &ApplicationMetadata{ParamsSchema: schema},
+			wantIssues: 1,
+		},
+		{
+			name:       "UnparsableParameters",
+			params:     `{"tenantAdmin": `,
+			metadata:   &ApplicationMetadata{ParamsSchema: schema},
+			wantIssues: 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cr := NewSubscription("someName", "name1", "plan2", rawExtension(tc.params))
+			issues := NewSubscriptionTypeMapper().Validate(cr, tc.metadata)
+			assert.Len(t, issues, tc.wantIssues, "issues: %v", issues)
+		})
+	}
+}
+
 func TestSubscriptionTypeMapper_IsSynced(t *testing.T) {
 	raw := rawExtension(`{"name": "John", "age": 30}`)
 	cr := NewSubscription("someName", "name1", "plan2", raw)
-	get := &SubscriptionGet{
+	get := &SubscriptionGet{EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{
 		AppName:  internal.Ptr("anotherName"),
 		PlanName: internal.Ptr("anotherPlan"),
-	}
+	}}
 
 	uut := NewSubscriptionTypeMapper()
 	synced := uut.IsUpToDate(cr, get)
@@ -352,17 +486,67 @@ func TestSubscriptionTypeMapper_SyncStatus(t *testing.T) {
 	}{
 		"SetState": {
 			cr: NewSubscription("someName", "name1", "plan2", raw),
-			apiRes: &SubscriptionGet{
+			apiRes: &SubscriptionGet{EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{
 				AppName:  internal.Ptr("name1"),
 				PlanName: internal.Ptr("plan2"),
 				State:    internal.Ptr(v1alpha1.SubscriptionStateInProcess),
-			},
+			}},
 			expectedCr: NewSubscriptionWithStatus("someName", "name1", "plan2",
 				v1alpha1.SubscriptionObservation{
 					State: internal.Ptr(v1alpha1.SubscriptionStateInProcess),
 				},
 			),
 		},
+		"SetSubscriptionDetails": {
+			cr: NewSubscription("someName", "name1", "plan2", raw),
+			apiRes: &SubscriptionGet{EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{
+				AppName:            internal.Ptr("name1"),
+				PlanName:           internal.Ptr("plan2"),
+				State:              internal.Ptr(v1alpha1.SubscriptionStateSubscribed),
+				AppId:              internal.Ptr("app-id"),
+				SubscriptionUrl:    internal.Ptr("https://tenant.example.com"),
+				SubscribedTenantId: internal.Ptr("tenant-id"),
+				SubscriptionGUID:   internal.Ptr("subscription-guid"),
+			}},
+			expectedCr: NewSubscriptionWithStatus("someName", "name1", "plan2",
+				v1alpha1.SubscriptionObservation{
+					State:              internal.Ptr(v1alpha1.SubscriptionStateSubscribed),
+					AppId:              internal.Ptr("app-id"),
+					SubscriptionUrl:    internal.Ptr("https://tenant.example.com"),
+					SubscribedTenantId: internal.Ptr("tenant-id"),
+					SubscriptionGUID:   internal.Ptr("subscription-guid"),
+				},
+			),
+		},
+		"SetDependencies": {
+			cr: NewSubscription("someName", "name1", "plan2", raw),
+			apiRes: &SubscriptionGet{
+				EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{State: internal.Ptr(v1alpha1.SubscriptionStateSubscribed)},
+				Dependencies:                       []string{"dep-app", "dep-xsapp"},
+			},
+			expectedCr: NewSubscriptionWithStatus("someName", "name1", "plan2",
+				v1alpha1.SubscriptionObservation{
+					State:        internal.Ptr(v1alpha1.SubscriptionStateSubscribed),
+					Dependencies: []string{"dep-app", "dep-xsapp"},
+				},
+			),
+		},
+		"SetFailureReason": {
+			cr: NewSubscription("someName", "name1", "plan2", raw),
+			apiRes: &SubscriptionGet{EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{
+				State: internal.Ptr(v1alpha1.SubscriptionStateSubscribeFailed),
+				SubscriptionError: &saas_client.EntitledApplicationsErrorResponseObject{
+					ErrorMessage: internal.Ptr("dependency failed"),
+					AppError:     internal.Ptr("missing tenant admin"),
+				},
+			}},
+			expectedCr: NewSubscriptionWithStatus("someName", "name1", "plan2",
+				v1alpha1.SubscriptionObservation{
+					State:         internal.Ptr(v1alpha1.SubscriptionStateSubscribeFailed),
+					FailureReason: internal.Ptr("dependency failed: missing tenant admin"),
+				},
+			),
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -384,6 +568,14 @@ func apiMockGET(response *saas_client.EntitledApplicationsResponseObject, status
 	return apiMock
 }
 
+func apiMockGETWithBody(response *saas_client.EntitledApplicationsResponseObject, body string) *MockSubscriptionOperationsConsumer {
+	apiMock := &MockSubscriptionOperationsConsumer{}
+	apiMock.
+		On("GetEntitledApplicationExecute", mock.Anything).
+		Return(response, &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body))}, nil)
+	return apiMock
+}
+
 func apiMockPOST(statusCode int, apiError error) *MockSubscriptionOperationsConsumer {
 	apiMock := &MockSubscriptionOperationsConsumer{}
 	apiMock.
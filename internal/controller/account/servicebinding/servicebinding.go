@@ -13,7 +13,11 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	sbClient "github.com/sap/crossplane-provider-btp/internal/clients/account/servicebinding"
+	smClient "github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
 	tfClient "github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
 )
 
 const (
@@ -21,11 +25,14 @@ const (
 	errTrackPCUsage      = "cannot track ProviderConfig usage"
 	errGetPC             = "cannot get ProviderConfig"
 	errGetCreds          = "cannot get credentials"
+	errRotationBackend   = "binding rotation requires the Native service manager backend"
 
 	errObserveBinding = "cannot observe servicebinding"
 	errCreateBinding  = "cannot create servicebinding"
 	errSaveData       = "cannot update cr data"
 	errGetBinding     = "cannot get servicebinding"
+	errGetInstance    = "cannot get serviceinstance of servicebinding"
+	errFormatSecret   = "cannot format connection details"
 )
 
 // SaveConditionsFn Callback for persisting conditions in the CR
@@ -50,6 +57,9 @@ type connector struct {
 	usage resource.Tracker
 
 	clientConnector tfClient.TfProxyConnectorI[*v1alpha1.ServiceBinding]
+	// nativeConnector manages the resource via the service manager API, see backend()
+	nativeConnector tfClient.TfProxyConnectorI[*v1alpha1.ServiceBinding]
+	nativeByDefault bool
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -60,7 +70,15 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 
 	// when working with tf proxy resources we want to keep the Connect() logic as part of the delgating Connect calls of the native resources to
 	// deal with errors in the part of process that they belong to
-	client, err := c.clientConnector.Connect(ctx, mg.(*v1alpha1.ServiceBinding))
+	clientConnector, err := c.backend(ctx, mg.(*v1alpha1.ServiceBinding))
+	if err != nil {
+		return nil, err
+	}
+	if mg.(*v1alpha1.ServiceBinding).Spec.ForProvider.RotationInterval != nil && clientConnector != c.nativeConnector {
+		return nil, errors.New(errRotationBackend)
+	}
+	observeParameterDrift(mg.(*v1alpha1.ServiceBinding), clientConnector == c.nativeConnector)
+	client, err := clientConnector.Connect(ctx, mg.(*v1alpha1.ServiceBinding))
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +86,33 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	return &external{tfClient: client, kube: c.kube}, nil
 }
 
+// observeParameterDrift reflects whether the backend observes the parameters, a drift reported by a previous backend is dropped otherwise
+func observeParameterDrift(cr *v1alpha1.ServiceBinding, native bool) {
+	if !native {
+		cr.Status.AtProvider.ParameterDrift = nil
+	}
+	cr.SetConditions(v1alpha1.ParameterDriftObservation(native))
+}
+
+// backend selects the terraform or the native service manager connector, as configured in the ProviderConfig or by provider flag
+func (c *connector) backend(ctx context.Context, cr *v1alpha1.ServiceBinding) (tfClient.TfProxyConnectorI[*v1alpha1.ServiceBinding], error) {
+	if cr.GetProviderConfigReference() == nil {
+		return c.selectBackend(c.nativeByDefault), nil
+	}
+	pc, err := providerconfig.ResolveProviderConfig(ctx, cr, c.kube)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+	return c.selectBackend(smClient.UseNativeBackend(pc, c.nativeByDefault)), nil
+}
+
+func (c *connector) selectBackend(native bool) tfClient.TfProxyConnectorI[*v1alpha1.ServiceBinding] {
+	if native {
+		return c.nativeConnector
+	}
+	return c.clientConnector
+}
+
 type external struct {
 	tfClient tfClient.TfProxyControllerI
 	kube     client.Client
@@ -102,6 +147,10 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 			cr.SetConditions(xpv1.Available())
 		}
 
+		details, err := e.connectionDetails(ctx, cr, details)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errFormatSecret)
+		}
 		return managed.ExternalObservation{
 			ResourceExists:    true,
 			ResourceUpToDate:  true,
@@ -164,3 +213,22 @@ func (e *external) saveBindingData(ctx context.Context, cr *v1alpha1.ServiceBind
 	cr.Status.AtProvider.ID = sid.ID
 	return nil
 }
+
+// connectionDetails brings the credentials of the binding into the configured secret format
+func (e *external) connectionDetails(ctx context.Context, cr *v1alpha1.ServiceBinding, details map[string][]byte) (managed.ConnectionDetails, error) {
+	md := sbClient.BindingMetadata{
+		BindingName: cr.Spec.ForProvider.Name,
+		BindingID:   cr.Status.AtProvider.ID,
+		InstanceID:  internal.Val(cr.Spec.ForProvider.ServiceInstanceID),
+	}
+	if ref := cr.Spec.ForProvider.ServiceInstanceRef; ref != nil && sbClient.NeedsInstanceMetadata(cr.Spec.ForProvider.SecretFormat) {
+		si := &v1alpha1.ServiceInstance{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, si); err != nil {
+			return nil, errors.Wrap(err, errGetInstance)
+		}
+		md.InstanceName = si.Spec.ForProvider.Name
+		md.Offering = si.Spec.ForProvider.OfferingName
+		md.Plan = si.Spec.ForProvider.PlanName
+	}
+	return sbClient.FormatConnectionDetails(cr.Spec.ForProvider.SecretFormat, details, md)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
@@ -11,6 +12,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
 	tfClient "github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -316,6 +318,112 @@ func TestConnect(t *testing.T) {
 		})
 	}
 }
+
+func TestConnectionDetails(t *testing.T) {
+	si := &v1alpha1.ServiceInstance{}
+	si.Spec.ForProvider.Name = "instance"
+	si.Spec.ForProvider.OfferingName = "destination"
+	si.Spec.ForProvider.PlanName = "lite"
+
+	cases := map[string]struct {
+		format  *v1alpha1.SecretFormat
+		getErr  error
+		want    managed.ConnectionDetails
+		wantErr error
+	}{
+		"NoInstanceLookup": {
+			format: &v1alpha1.SecretFormat{Type: v1alpha1.SecretFormatJSON},
+			getErr: errKube,
+			want:   managed.ConnectionDetails{"credentials": []byte(`{"clientid":"id"}`)},
+		},
+		"InstanceMetadata": {
+			format: &v1alpha1.SecretFormat{Type: v1alpha1.SecretFormatTemplate, Templates: map[string]string{"service": "{{ .binding.offering }}/{{ .binding.plan }}/{{ .binding.instanceName }}"}},
+			want:   managed.ConnectionDetails{"service": []byte("destination/lite/instance")},
+		},
+		"InstanceLookupError": {
+			format:  &v1alpha1.SecretFormat{Type: v1alpha1.SecretFormatVCAP},
+			getErr:  errKube,
+			wantErr: errKube,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.ServiceBinding{}
+			cr.Spec.ForProvider.ServiceInstanceRef = &xpv1.Reference{Name: "si"}
+			cr.Spec.ForProvider.SecretFormat = tc.format
+			e := external{kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					if tc.getErr != nil {
+						return tc.getErr
+					}
+					*obj.(*v1alpha1.ServiceInstance) = *si
+					return nil
+				},
+			}}
+
+			got, err := e.connectionDetails(context.Background(), cr, map[string][]byte{"clientid": []byte("id")})
+			expectedErrorBehaviour(t, tc.wantErr, err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.connectionDetails(...): -want, +got:\n", diff)
+			}
+		})
+	}
+}
+
+func TestConnectRotationBackend(t *testing.T) {
+	cr := &v1alpha1.ServiceBinding{}
+	cr.Spec.ForProvider.RotationInterval = &metav1.Duration{Duration: time.Hour}
+
+	cases := map[string]struct {
+		native  bool
+		wantErr bool
+	}{
+		"Terraform": {wantErr: true},
+		"Native":    {native: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := connector{
+				clientConnector: &TfProxyClientCreatorMock{},
+				nativeConnector: &TfProxyClientCreatorMock{},
+				nativeByDefault: tc.native,
+			}
+			_, err := c.Connect(context.Background(), cr)
+			if tc.wantErr {
+				assert.EqualError(t, err, errRotationBackend)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestConnectParameterDriftCondition(t *testing.T) {
+	cases := map[string]struct {
+		native     bool
+		wantReason xpv1.ConditionReason
+		wantDrift  []string
+	}{
+		"Terraform": {wantReason: v1alpha1.ParametersNotObserved},
+		"Native":    {native: true, wantReason: v1alpha1.ParametersObserved, wantDrift: []string{"size: changed"}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.ServiceBinding{}
+			cr.Status.AtProvider.ParameterDrift = []string{"size: changed"}
+			c := connector{
+				clientConnector: &TfProxyClientCreatorMock{},
+				nativeConnector: &TfProxyClientCreatorMock{},
+				nativeByDefault: tc.native,
+			}
+			_, err := c.Connect(context.Background(), cr)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantReason, cr.GetCondition(v1alpha1.ParameterDriftCondition).Reason)
+			assert.Equal(t, tc.wantDrift, cr.Status.AtProvider.ParameterDrift)
+		})
+	}
+}
+
 func TestSaveCallback(t *testing.T) {
 	type args struct {
 		kube       client.Client
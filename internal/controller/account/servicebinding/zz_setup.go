@@ -8,6 +8,8 @@ import (
 	"github.com/sap/crossplane-provider-btp/btp"
 	sbClient "github.com/sap/crossplane-provider-btp/internal/clients/account/servicebinding"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/di"
+	"github.com/sap/crossplane-provider-btp/internal/features"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -24,6 +26,8 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
 
 			clientConnector: sbClient.NewServiceBindingConnector(saveCallback, kube),
+			nativeConnector: sbClient.NewNativeServiceBindingConnector(kube, di.NewNativeClientFn, di.LoadSecretData),
+			nativeByDefault: o.Features.Enabled(features.EnableNativeServiceManager),
 		}
 	})
 }
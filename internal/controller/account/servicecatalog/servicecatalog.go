@@ -0,0 +1,158 @@
+package servicecatalog
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
+	smapi "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errNotServiceCatalog = "managed resource is not a ServiceCatalog custom resource"
+	errTrackPCUsage      = "cannot track ProviderConfig usage"
+	errTrackRUsage       = "cannot track ResourceUsage"
+	errLoadSmSecret      = "cannot load service manager secret"
+	errInitSmClient      = "cannot initialize service manager client"
+	errListOfferings     = "cannot list service offerings"
+	errListPlans         = "cannot list service plans"
+
+	// defaultRefreshInterval applies if the interval is not set in the spec
+	defaultRefreshInterval = time.Hour
+)
+
+type connector struct {
+	kube            client.Client
+	usage           resource.Tracker
+	resourcetracker tracking.ReferenceResolverTracker
+
+	newCatalogReaderFn func(ctx context.Context, secretData map[string][]byte) (servicemanager.CatalogReader, error)
+	loadSecretFn       func(kube client.Client, ctx context.Context, secretName, secretNamespace string) (map[string][]byte, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ServiceCatalog)
+	if !ok {
+		return nil, errors.New(errNotServiceCatalog)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+	if err := c.resourcetracker.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackRUsage)
+	}
+
+	secretData, err := c.loadSecretFn(c.kube, ctx, cr.Spec.ForProvider.ServiceManagerSecret, cr.Spec.ForProvider.ServiceManagerSecretNamespace)
+	if err != nil {
+		return nil, errors.Wrap(err, errLoadSmSecret)
+	}
+	reader, err := c.newCatalogReaderFn(ctx, secretData)
+	if err != nil {
+		return nil, errors.Wrap(err, errInitSmClient)
+	}
+	return &external{reader: reader, now: time.Now}, nil
+}
+
+// external only observes, the catalog exists as long as the service manager does and is never created, updated or deleted
+type external struct {
+	reader servicemanager.CatalogReader
+	now    func() time.Time
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceCatalog)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotServiceCatalog)
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	if e.refreshDue(cr) {
+		offerings, err := e.reader.ListOfferings(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errListOfferings)
+		}
+		plans, err := e.reader.ListPlans(ctx)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errListPlans)
+		}
+		cr.Status.AtProvider.Offerings = buildCatalog(offerings, plans)
+		cr.Status.AtProvider.LastRefreshTime = &metav1.Time{Time: e.now()}
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	mg.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+// refreshDue reports whether the catalog has never been fetched or the refresh interval has passed since
+func (e *external) refreshDue(cr *v1alpha1.ServiceCatalog) bool {
+	last := cr.Status.AtProvider.LastRefreshTime
+	if last == nil {
+		return true
+	}
+	interval := defaultRefreshInterval
+	if cr.Spec.ForProvider.RefreshInterval != nil {
+		interval = cr.Spec.ForProvider.RefreshInterval.Duration
+	}
+	return !e.now().Before(last.Add(interval))
+}
+
+// buildCatalog assigns the plans to their offerings, both sorted by name to keep the status stable across refreshes
+func buildCatalog(offerings []smapi.ServiceOfferingResponseObject, plans []smapi.ServicePlanResponseObject) []v1alpha1.CatalogOffering {
+	plansByOffering := map[string][]v1alpha1.CatalogPlan{}
+	for _, p := range plans {
+		offeringID := internal.Val(p.ServiceOfferingId)
+		plansByOffering[offeringID] = append(plansByOffering[offeringID], v1alpha1.CatalogPlan{
+			Name:        internal.Val(p.CatalogName),
+			ID:          internal.Val(p.Id),
+			Description: internal.Val(p.Description),
+			Free:        internal.Val(p.Free),
+		})
+	}
+
+	catalog := make([]v1alpha1.CatalogOffering, 0, len(offerings))
+	for _, o := range offerings {
+		offeringPlans := plansByOffering[internal.Val(o.Id)]
+		sort.Slice(offeringPlans, func(i, j int) bool { return offeringPlans[i].Name < offeringPlans[j].Name })
+		catalog = append(catalog, v1alpha1.CatalogOffering{
+			Name:           internal.Val(o.CatalogName),
+			ID:             internal.Val(o.Id),
+			Description:    internal.Val(o.Description),
+			Bindable:       internal.Val(o.Bindable),
+			PlanUpdateable: internal.Val(o.PlanUpdateable),
+			Plans:          offeringPlans,
+		})
+	}
+	sort.Slice(catalog, func(i, j int) bool { return catalog[i].Name < catalog[j].Name })
+	return catalog
+}
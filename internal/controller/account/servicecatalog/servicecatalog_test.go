@@ -0,0 +1,128 @@
+package servicecatalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	smapi "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+)
+
+var now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type catalogReaderFake struct {
+	offerings []smapi.ServiceOfferingResponseObject
+	plans     []smapi.ServicePlanResponseObject
+	err       error
+	calls     int
+}
+
+func (f *catalogReaderFake) ListOfferings(ctx context.Context) ([]smapi.ServiceOfferingResponseObject, error) {
+	f.calls++
+	return f.offerings, f.err
+}
+
+func (f *catalogReaderFake) ListPlans(ctx context.Context) ([]smapi.ServicePlanResponseObject, error) {
+	return f.plans, f.err
+}
+
+func TestObserve(t *testing.T) {
+	reader := func() *catalogReaderFake {
+		return &catalogReaderFake{
+			offerings: []smapi.ServiceOfferingResponseObject{
+				{Id: internal.Ptr("o2"), CatalogName: internal.Ptr("xsuaa"), Bindable: internal.Ptr(true)},
+				{Id: internal.Ptr("o1"), CatalogName: internal.Ptr("destination"), PlanUpdateable: internal.Ptr(true)},
+			},
+			plans: []smapi.ServicePlanResponseObject{
+				{Id: internal.Ptr("p2"), CatalogName: internal.Ptr("lite"), ServiceOfferingId: internal.Ptr("o1"), Free: internal.Ptr(true)},
+				{Id: internal.Ptr("p1"), CatalogName: internal.Ptr("application"), ServiceOfferingId: internal.Ptr("o2")},
+				{Id: internal.Ptr("p3"), CatalogName: internal.Ptr("broker"), ServiceOfferingId: internal.Ptr("o2")},
+			},
+		}
+	}
+	refreshed := v1alpha1.ServiceCatalogObservation{
+		Offerings: []v1alpha1.CatalogOffering{
+			{Name: "destination", ID: "o1", PlanUpdateable: true, Plans: []v1alpha1.CatalogPlan{{Name: "lite", ID: "p2", Free: true}}},
+			{Name: "xsuaa", ID: "o2", Bindable: true, Plans: []v1alpha1.CatalogPlan{{Name: "application", ID: "p1"}, {Name: "broker", ID: "p3"}}},
+		},
+		LastRefreshTime: &metav1.Time{Time: now},
+	}
+	recent := &metav1.Time{Time: now.Add(-30 * time.Minute)}
+
+	tests := map[string]struct {
+		cr         *v1alpha1.ServiceCatalog
+		reader     *catalogReaderFake
+		want       managed.ExternalObservation
+		wantErr    bool
+		wantStatus v1alpha1.ServiceCatalogObservation
+		wantCalls  int
+	}{
+		"ApiError": {
+			cr:        &v1alpha1.ServiceCatalog{},
+			reader:    &catalogReaderFake{err: errors.New("api error")},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+		"InitialRefresh": {
+			cr:         &v1alpha1.ServiceCatalog{},
+			reader:     reader(),
+			want:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			wantStatus: refreshed,
+			wantCalls:  1,
+		},
+		"NotDue": {
+			cr:         catalog(recent, nil),
+			reader:     reader(),
+			want:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			wantStatus: v1alpha1.ServiceCatalogObservation{LastRefreshTime: recent},
+		},
+		"DueByInterval": {
+			cr:         catalog(recent, &metav1.Duration{Duration: 10 * time.Minute}),
+			reader:     reader(),
+			want:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			wantStatus: refreshed,
+			wantCalls:  1,
+		},
+		"Deleted": {
+			cr: func() *v1alpha1.ServiceCatalog {
+				cr := &v1alpha1.ServiceCatalog{}
+				cr.SetDeletionTimestamp(&metav1.Time{Time: now})
+				return cr
+			}(),
+			reader: reader(),
+			want:   managed.ExternalObservation{ResourceExists: false},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := external{reader: tc.reader, now: func() time.Time { return now }}
+			got, err := e.Observe(context.TODO(), tc.cr)
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Observe() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nObserve(): -want, +got:\n", diff)
+			}
+			if diff := cmp.Diff(tc.wantStatus, tc.cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\nObserve(): -want status, +got status:\n", diff)
+			}
+			if tc.reader.calls != tc.wantCalls {
+				t.Errorf("Observe() listed catalog %d times, want %d", tc.reader.calls, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func catalog(lastRefresh *metav1.Time, interval *metav1.Duration) *v1alpha1.ServiceCatalog {
+	cr := &v1alpha1.ServiceCatalog{}
+	cr.Spec.ForProvider.RefreshInterval = interval
+	cr.Status.AtProvider.LastRefreshTime = lastRefresh
+	return cr
+}
@@ -2,6 +2,7 @@ package serviceinstance
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
@@ -14,6 +15,8 @@ var (
 	errInitialize       = "cannot resolve plan ID"
 	errLoadSmBinding    = "cannot load service manager binding secret"
 	errInitPlanResolver = "cannot initialize plan ID resolver"
+	errPlanUpdateable   = "cannot check whether the plan of the instance can be updated"
+	errPlanNotUpdatable = "cannot change plan from %s/%s to %s/%s, the offering does not support plan updates"
 )
 
 type Initializer interface {
@@ -23,11 +26,12 @@ type Initializer interface {
 var _ Initializer = &servicePlanInitializer{}
 
 type servicePlanInitializer struct {
-	newIdResolverFn func(ctx context.Context, secretData map[string][]byte) (smClient.PlanIdResolver, error)
+	newIdResolverFn func(ctx context.Context, secretData map[string][]byte) (smClient.PlanUpdateResolver, error)
 	loadSecretFn    func(kube client.Client, ctx context.Context, secretName, secretNamespace string) (map[string][]byte, error)
 }
 
-// Initialize implements managed.Initializer, initializes an implementation of IdResolver and uses it to resolve the plan ID
+// Initialize implements managed.Initializer, initializes an implementation of IdResolver and uses it to resolve the plan ID.
+// The plan ID is resolved again whenever offering or plan change, the new plan is only applied if the offering supports plan updates.
 func (s *servicePlanInitializer) Initialize(kube client.Client, ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.ServiceInstance)
 	if !ok {
@@ -35,6 +39,19 @@ func (s *servicePlanInitializer) Initialize(kube client.Client, ctx context.Cont
 	}
 
 	if isInitialized(cr) {
+		if cr.Status.AtProvider.OfferingName == "" && cr.Status.AtProvider.PlanName == "" {
+			// plan IDs resolved before offering and plan have been tracked in the status, persisted along with the rest of the status
+			cr.Status.AtProvider.OfferingName = cr.Spec.ForProvider.OfferingName
+			cr.Status.AtProvider.PlanName = cr.Spec.ForProvider.ResolvedPlanName()
+		}
+		if cr.GetCondition(v1alpha1.PlanUpdateCondition).Reason == v1alpha1.PlanNotUpdateable {
+			cr.SetConditions(v1alpha1.PlanUpdated())
+		}
+		return nil
+	}
+
+	// a rejected plan change is only checked again against the service manager once offering or plan in the spec change
+	if rejected := cr.GetCondition(v1alpha1.PlanUpdateCondition); rejected.Reason == v1alpha1.PlanNotUpdateable && rejected.Message == planNotUpdatableMessage(cr) {
 		return nil
 	}
 
@@ -48,17 +65,50 @@ func (s *servicePlanInitializer) Initialize(kube client.Client, ctx context.Cont
 		return errors.Wrap(err, errInitPlanResolver)
 	}
 
-	planID, err := idResolver.PlanIDByName(ctx, cr.Spec.ForProvider.OfferingName, cr.Spec.ForProvider.PlanName)
+	planID, err := idResolver.PlanIDByName(ctx, cr.Spec.ForProvider.OfferingName, cr.Spec.ForProvider.ResolvedPlanName())
 	if err != nil {
 		return errors.Wrap(err, errInitialize)
 	}
+
+	if previousID := cr.Status.AtProvider.ServiceplanID; previousID != "" && previousID != planID {
+		// instances can't move to another offering, only the plan within the offering may change
+		updateable := false
+		if cr.Status.AtProvider.OfferingName == cr.Spec.ForProvider.OfferingName {
+			if updateable, err = idResolver.PlanUpdateable(ctx, cr.Spec.ForProvider.OfferingName); err != nil {
+				return errors.Wrap(err, errPlanUpdateable)
+			}
+		}
+		if !updateable {
+			// we keep managing the instance with its current plan, the condition is persisted along with the rest of the status
+			cr.SetConditions(v1alpha1.PlanUpdateRejected(planNotUpdatableMessage(cr)))
+			return nil
+		}
+		cr.SetConditions(v1alpha1.PlanUpdated())
+	}
+
 	cr.Status.AtProvider.ServiceplanID = planID
+	cr.Status.AtProvider.OfferingName = cr.Spec.ForProvider.OfferingName
+	cr.Status.AtProvider.PlanName = cr.Spec.ForProvider.ResolvedPlanName()
 	if err := kube.Status().Update(ctx, cr); err != nil {
 		return errors.Wrap(err, errSaveData)
 	}
 	return nil
 }
 
+// isInitialized reports whether the plan ID has been resolved for the offering and plan in the spec.
+// Plan IDs resolved before offering and plan have been tracked in the status are assumed to match the spec.
 func isInitialized(cr *v1alpha1.ServiceInstance) bool {
-	return cr.Status.AtProvider.ServiceplanID != ""
+	atProvider := cr.Status.AtProvider
+	if atProvider.ServiceplanID == "" {
+		return false
+	}
+	if atProvider.OfferingName == "" && atProvider.PlanName == "" {
+		return true
+	}
+	return atProvider.OfferingName == cr.Spec.ForProvider.OfferingName && atProvider.PlanName == cr.Spec.ForProvider.ResolvedPlanName()
+}
+
+// planNotUpdatableMessage describes the rejected change from the applied plan to the one in the spec
+func planNotUpdatableMessage(cr *v1alpha1.ServiceInstance) string {
+	return fmt.Sprintf(errPlanNotUpdatable, cr.Status.AtProvider.OfferingName, cr.Status.AtProvider.PlanName, cr.Spec.ForProvider.OfferingName, cr.Spec.ForProvider.ResolvedPlanName())
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
@@ -32,7 +33,7 @@ func TestServicePlanInitializer_Initialize(t *testing.T) {
 		mg              resource.Managed
 		kube            client.Client
 		loadSecretFn    func(client.Client, context.Context, string, string) (map[string][]byte, error)
-		newIdResolverFn func(context.Context, map[string][]byte) (smClient.PlanIdResolver, error)
+		newIdResolverFn func(context.Context, map[string][]byte) (smClient.PlanUpdateResolver, error)
 		want            want
 	}{
 		"already initialized": {
@@ -58,7 +59,7 @@ func TestServicePlanInitializer_Initialize(t *testing.T) {
 			loadSecretFn: func(kube client.Client, ctx context.Context, name, ns string) (map[string][]byte, error) {
 				return map[string][]byte{}, nil
 			},
-			newIdResolverFn: func(context.Context, map[string][]byte) (smClient.PlanIdResolver, error) {
+			newIdResolverFn: func(context.Context, map[string][]byte) (smClient.PlanUpdateResolver, error) {
 				return nil, errNewResolver
 			},
 			want: want{
@@ -74,8 +75,8 @@ func TestServicePlanInitializer_Initialize(t *testing.T) {
 			loadSecretFn: func(kube client.Client, ctx context.Context, name, ns string) (map[string][]byte, error) {
 				return map[string][]byte{}, nil
 			},
-			newIdResolverFn: func(context.Context, map[string][]byte) (smClient.PlanIdResolver, error) {
-				return &mockPlanIdResolver{"", errApi}, nil
+			newIdResolverFn: func(context.Context, map[string][]byte) (smClient.PlanUpdateResolver, error) {
+				return &mockPlanIdResolver{err: errApi}, nil
 			},
 			want: want{
 				err: errApi,
@@ -90,8 +91,8 @@ func TestServicePlanInitializer_Initialize(t *testing.T) {
 			loadSecretFn: func(kube client.Client, ctx context.Context, name, ns string) (map[string][]byte, error) {
 				return map[string][]byte{}, nil
 			},
-			newIdResolverFn: func(context.Context, map[string][]byte) (smClient.PlanIdResolver, error) {
-				return &mockPlanIdResolver{testPlanID, nil}, nil
+			newIdResolverFn: func(context.Context, map[string][]byte) (smClient.PlanUpdateResolver, error) {
+				return &mockPlanIdResolver{planID: testPlanID}, nil
 			},
 			kube: &test.MockClient{
 				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
@@ -112,8 +113,8 @@ func TestServicePlanInitializer_Initialize(t *testing.T) {
 			loadSecretFn: func(kube client.Client, ctx context.Context, name, ns string) (map[string][]byte, error) {
 				return map[string][]byte{}, nil
 			},
-			newIdResolverFn: func(context.Context, map[string][]byte) (smClient.PlanIdResolver, error) {
-				return &mockPlanIdResolver{testPlanID, nil}, nil
+			newIdResolverFn: func(context.Context, map[string][]byte) (smClient.PlanUpdateResolver, error) {
+				return &mockPlanIdResolver{planID: testPlanID}, nil
 			},
 			kube: &test.MockClient{
 				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
@@ -136,11 +137,11 @@ func TestServicePlanInitializer_Initialize(t *testing.T) {
 					}
 					return map[string][]byte{}, nil
 				},
-				newIdResolverFn: func(ctx context.Context, secretData map[string][]byte) (smClient.PlanIdResolver, error) {
+				newIdResolverFn: func(ctx context.Context, secretData map[string][]byte) (smClient.PlanUpdateResolver, error) {
 					if tc.newIdResolverFn != nil {
 						return tc.newIdResolverFn(ctx, secretData)
 					}
-					return &mockPlanIdResolver{testPlanID, nil}, nil
+					return &mockPlanIdResolver{planID: testPlanID}, nil
 				},
 			}
 
@@ -161,11 +162,133 @@ func TestServicePlanInitializer_Initialize(t *testing.T) {
 	}
 }
 
+func TestServicePlanInitializer_PlanChange(t *testing.T) {
+	resolved := func(offering, plan, planID string) func(*v1alpha1.ServiceInstance) {
+		return func(cr *v1alpha1.ServiceInstance) {
+			cr.Status.AtProvider.OfferingName = offering
+			cr.Status.AtProvider.PlanName = plan
+			cr.Status.AtProvider.ServiceplanID = planID
+		}
+	}
+	spec := func(offering, plan string) func(*v1alpha1.ServiceInstance) {
+		return func(cr *v1alpha1.ServiceInstance) {
+			cr.Spec.ForProvider.OfferingName = offering
+			cr.Spec.ForProvider.PlanName = plan
+		}
+	}
+
+	type want struct {
+		planID    string
+		plan      string
+		reason    xpv1.ConditionReason
+		persisted bool
+	}
+
+	tests := map[string]struct {
+		cr       *v1alpha1.ServiceInstance
+		resolver *mockPlanIdResolver
+		want     want
+	}{
+		"LegacyStatus": {
+			cr:       instance(spec("destination", "lite"), resolved("", "", "lite-id")),
+			resolver: &mockPlanIdResolver{planID: "other-id"},
+			want:     want{planID: "lite-id", plan: "lite"},
+		},
+		"Unchanged": {
+			cr:       instance(spec("destination", "lite"), resolved("destination", "lite", "lite-id")),
+			resolver: &mockPlanIdResolver{planID: "other-id"},
+			want:     want{planID: "lite-id", plan: "lite"},
+		},
+		"Updateable": {
+			cr:       instance(spec("destination", "standard"), resolved("destination", "lite", "lite-id")),
+			resolver: &mockPlanIdResolver{planID: "standard-id", updateable: true},
+			want:     want{planID: "standard-id", plan: "standard", reason: v1alpha1.PlanUpToDate, persisted: true},
+		},
+		"NotUpdateable": {
+			cr:       instance(spec("destination", "standard"), resolved("destination", "lite", "lite-id")),
+			resolver: &mockPlanIdResolver{planID: "standard-id"},
+			want:     want{planID: "lite-id", plan: "lite", reason: v1alpha1.PlanNotUpdateable},
+		},
+		"RejectionCached": {
+			cr: instance(spec("destination", "standard"), resolved("destination", "lite", "lite-id"), func(cr *v1alpha1.ServiceInstance) {
+				cr.SetConditions(v1alpha1.PlanUpdateRejected(planNotUpdatableMessage(cr)))
+			}),
+			resolver: &mockPlanIdResolver{err: errApi},
+			want:     want{planID: "lite-id", plan: "lite", reason: v1alpha1.PlanNotUpdateable},
+		},
+		"RejectedPlanChanged": {
+			cr: instance(spec("destination", "premium"), resolved("destination", "lite", "lite-id"), func(cr *v1alpha1.ServiceInstance) {
+				cr.SetConditions(v1alpha1.PlanUpdateRejected("cannot change plan from destination/lite to destination/standard"))
+			}),
+			resolver: &mockPlanIdResolver{planID: "premium-id", updateable: true},
+			want:     want{planID: "premium-id", plan: "premium", reason: v1alpha1.PlanUpToDate, persisted: true},
+		},
+		"OfferingChanged": {
+			cr:       instance(spec("xsuaa", "lite"), resolved("destination", "lite", "lite-id")),
+			resolver: &mockPlanIdResolver{planID: "xsuaa-lite-id", updateable: true},
+			want:     want{planID: "lite-id", plan: "lite", reason: v1alpha1.PlanNotUpdateable},
+		},
+		"Reverted": {
+			cr: instance(spec("destination", "lite"), resolved("destination", "lite", "lite-id"), func(cr *v1alpha1.ServiceInstance) {
+				cr.SetConditions(v1alpha1.PlanUpdateRejected("rejected"))
+			}),
+			resolver: &mockPlanIdResolver{planID: "other-id"},
+			want:     want{planID: "lite-id", plan: "lite", reason: v1alpha1.PlanUpToDate},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			persisted := false
+			kube := &test.MockClient{
+				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					persisted = true
+					return nil
+				},
+			}
+			init := &servicePlanInitializer{
+				loadSecretFn: func(kube client.Client, ctx context.Context, name, ns string) (map[string][]byte, error) {
+					return map[string][]byte{}, nil
+				},
+				newIdResolverFn: func(ctx context.Context, secretData map[string][]byte) (smClient.PlanUpdateResolver, error) {
+					return tc.resolver, nil
+				},
+			}
+
+			if err := init.Initialize(kube, context.Background(), tc.cr); err != nil {
+				t.Fatalf("Initialize() unexpected error = %v", err)
+			}
+			got := want{
+				planID:    tc.cr.Status.AtProvider.ServiceplanID,
+				plan:      tc.cr.Status.AtProvider.PlanName,
+				reason:    tc.cr.GetCondition(v1alpha1.PlanUpdateCondition).Reason,
+				persisted: persisted,
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\nInitialize(): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func instance(opts ...func(*v1alpha1.ServiceInstance)) *v1alpha1.ServiceInstance {
+	cr := &v1alpha1.ServiceInstance{}
+	for _, opt := range opts {
+		opt(cr)
+	}
+	return cr
+}
+
 type mockPlanIdResolver struct {
-	planID string
-	err    error
+	planID     string
+	updateable bool
+	err        error
 }
 
 func (m *mockPlanIdResolver) PlanIDByName(ctx context.Context, offeringName, planName string) (string, error) {
 	return m.planID, m.err
 }
+
+func (m *mockPlanIdResolver) PlanUpdateable(ctx context.Context, offeringName string) (bool, error) {
+	return m.updateable, m.err
+}
@@ -2,8 +2,10 @@ package serviceinstance
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -13,14 +15,19 @@ import (
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	siClient "github.com/sap/crossplane-provider-btp/internal/clients/account/serviceinstance"
+	smClient "github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
 	tfClient "github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
 	"github.com/sap/crossplane-provider-btp/internal/di"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
 )
 
 const (
 	errNotServiceInstance = "managed resource is not a ServiceInstance custom resource"
 	errTrackPCUsage       = "cannot track ProviderConfig usage"
+	errTrackRUsage        = "cannot track ResourceUsage"
 	errGetPC              = "cannot get ProviderConfig"
 	errGetCreds           = "cannot get credentials"
 
@@ -29,6 +36,12 @@ const (
 	errUpdateInstance  = "cannot update serviceinstance"
 	errSaveData        = "cannot update cr data"
 	errGetInstance     = "cannot get serviceinstance"
+
+	errGetCatalog           = "cannot get service catalog"
+	errCatalogNotReady      = "service catalog %s has not been fetched yet"
+	errInvalidCatalog       = "offering or plan not found in service catalog, see SoftValidation condition"
+	errOfferingNotInCatalog = "offering %s is not available in service catalog %s"
+	errPlanNotInCatalog     = "plan %s is not available for offering %s in service catalog %s"
 )
 
 // Dependency Injection
@@ -40,7 +53,7 @@ var newClientCreatorFn = func(kube client.Client) tfClient.TfProxyConnectorI[*v1
 
 var newServicePlanInitializerFn = func() Initializer {
 	return &servicePlanInitializer{
-		newIdResolverFn: di.NewPlanIdResolverFn,
+		newIdResolverFn: di.NewPlanUpdateResolverFn,
 		loadSecretFn:    di.LoadSecretData,
 	}
 }
@@ -62,20 +75,43 @@ var saveCallback tfClient.SaveConditionsFn = func(ctx context.Context, kube clie
 	return errors.Wrap(uErr, errSaveData)
 }
 
+// referenceTracker tracks a single reference, unlike tracking.ReferenceResolverTracker which tracks all references of a resource
+type referenceTracker interface {
+	CreateTrackingReference(ctx context.Context, cr resource.Managed, reference xpv1.Reference, gvk schema.GroupVersionKind) error
+}
+
 type connector struct {
-	kube  client.Client
-	usage resource.Tracker
+	kube             client.Client
+	usage            resource.Tracker
+	resourcetracker  tracking.ReferenceResolverTracker
+	referenceTracker referenceTracker
+
+	clientConnector tfClient.TfProxyConnectorI[*v1alpha1.ServiceInstance]
+	// nativeConnector manages the resource via the service manager API, see backend()
+	nativeConnector tfClient.TfProxyConnectorI[*v1alpha1.ServiceInstance]
+	nativeByDefault bool
 
-	clientConnector             tfClient.TfProxyConnectorI[*v1alpha1.ServiceInstance]
 	newServicePlanInitializerFn func() Initializer
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	_, ok := mg.(*v1alpha1.ServiceInstance)
+	cr, ok := mg.(*v1alpha1.ServiceInstance)
 	if !ok {
 		return nil, errors.New(errNotServiceInstance)
 	}
 
+	// tracks the usage of a referenced shared instance, so it isn't deleted while being referenced.
+	// Other references like the service manager are not tracked, the instance must not block their deletion.
+	if ref := cr.Spec.ForProvider.ReferencedInstanceRef; ref != nil {
+		if err := c.referenceTracker.CreateTrackingReference(ctx, cr, *ref, v1alpha1.ServiceInstanceGroupVersionKind); err != nil {
+			return nil, errors.Wrap(err, errTrackRUsage)
+		}
+	}
+
+	if err := c.validateCatalog(ctx, cr); err != nil {
+		return nil, err
+	}
+
 	// we need to resolve the plan ID here, since at crossplanes initialize stage the required references for the sm secret are not resolved yet
 	planInitializer := c.newServicePlanInitializerFn()
 	err := planInitializer.Initialize(c.kube, ctx, mg)
@@ -86,17 +122,82 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 
 	// when working with tf proxy resources we want to keep the Connect() logic as part of the delgating Connect calls of the native resources to
 	// deal with errors in the part of process that they belong to
-	client, err := c.clientConnector.Connect(ctx, mg.(*v1alpha1.ServiceInstance))
+	clientConnector, err := c.backend(ctx, cr)
+	if err != nil {
+		return nil, err
+	}
+	observeParameterDrift(cr, clientConnector == c.nativeConnector)
+	client, err := clientConnector.Connect(ctx, cr)
 	if err != nil {
 		return nil, err
 	}
 
-	return &external{tfClient: client, kube: c.kube}, nil
+	return &external{tfClient: client, kube: c.kube, tracker: c.resourcetracker}, nil
+}
+
+// validateCatalog checks offering and plan against the referenced ServiceCatalog as long as the instance hasn't been created,
+// the result is reflected as condition. Deleted instances are not validated, an invalid catalog must not block their deletion.
+func (c *connector) validateCatalog(ctx context.Context, cr *v1alpha1.ServiceInstance) error {
+	ref := cr.Spec.ForProvider.ServiceCatalogRef
+	if ref == nil || cr.Status.AtProvider.ID != "" || meta.WasDeleted(cr) {
+		return nil
+	}
+	catalog := &v1alpha1.ServiceCatalog{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, catalog); err != nil {
+		return errors.Wrap(err, errGetCatalog)
+	}
+	if catalog.Status.AtProvider.LastRefreshTime == nil {
+		return errors.Errorf(errCatalogNotReady, ref.Name)
+	}
+
+	var issues []string
+	offering := catalog.Status.AtProvider.Offering(cr.Spec.ForProvider.OfferingName)
+	switch {
+	case offering == nil:
+		issues = append(issues, fmt.Sprintf(errOfferingNotInCatalog, cr.Spec.ForProvider.OfferingName, ref.Name))
+	case cr.Spec.ForProvider.ResolvedPlanName() == v1alpha1.ReferenceInstancePlanName:
+		// reference instances use a plan that isn't necessarily listed in the catalog
+	case offering.Plan(cr.Spec.ForProvider.PlanName) == nil:
+		issues = append(issues, fmt.Sprintf(errPlanNotInCatalog, cr.Spec.ForProvider.PlanName, cr.Spec.ForProvider.OfferingName, ref.Name))
+	}
+	cr.SetConditions(v1alpha1.ValidationCondition(issues))
+	if issues != nil {
+		return errors.New(errInvalidCatalog)
+	}
+	return nil
+}
+
+// observeParameterDrift reflects whether the backend observes the parameters, a drift reported by a previous backend is dropped otherwise
+func observeParameterDrift(cr *v1alpha1.ServiceInstance, native bool) {
+	if !native {
+		cr.Status.AtProvider.ParameterDrift = nil
+	}
+	cr.SetConditions(v1alpha1.ParameterDriftObservation(native))
+}
+
+// backend selects the terraform or the native service manager connector, as configured in the ProviderConfig or by provider flag
+func (c *connector) backend(ctx context.Context, cr *v1alpha1.ServiceInstance) (tfClient.TfProxyConnectorI[*v1alpha1.ServiceInstance], error) {
+	if cr.GetProviderConfigReference() == nil {
+		return c.selectBackend(c.nativeByDefault), nil
+	}
+	pc, err := providerconfig.ResolveProviderConfig(ctx, cr, c.kube)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+	return c.selectBackend(smClient.UseNativeBackend(pc, c.nativeByDefault)), nil
+}
+
+func (c *connector) selectBackend(native bool) tfClient.TfProxyConnectorI[*v1alpha1.ServiceInstance] {
+	if native {
+		return c.nativeConnector
+	}
+	return c.clientConnector
 }
 
 type external struct {
 	tfClient tfClient.TfProxyControllerI
 	kube     client.Client
+	tracker  tracking.ReferenceResolverTracker
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -174,6 +275,13 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(errNotServiceInstance)
 	}
 	cr.SetConditions(xpv1.Deleting())
+
+	// shared instances can't be deleted as long as other instances reference them
+	c.tracker.SetConditions(ctx, cr)
+	if blocked := c.tracker.DeleteShouldBeBlocked(mg); blocked {
+		return errors.New(providerv1alpha1.ErrResourceInUse)
+	}
+
 	if err := c.tfClient.Delete(ctx); err != nil {
 		return errors.Wrap(err, "cannot delete serviceinstance")
 	}
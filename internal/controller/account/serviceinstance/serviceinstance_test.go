@@ -8,8 +8,13 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
 	"github.com/sap/crossplane-provider-btp/internal/clients/tfclient"
+	trackingtest "github.com/sap/crossplane-provider-btp/internal/tracking/test"
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -29,6 +34,7 @@ func TestConnect(t *testing.T) {
 	type fields struct {
 		creator     *TfProxyClientCreatorMock
 		initializer Initializer
+		tracker     *referenceTrackerMock
 	}
 
 	type args struct {
@@ -38,6 +44,7 @@ func TestConnect(t *testing.T) {
 	type want struct {
 		err            error
 		externalExists bool
+		tracked        []string
 	}
 
 	cases := map[string]struct {
@@ -85,13 +92,52 @@ func TestConnect(t *testing.T) {
 				err: nil,
 			},
 		},
+		"TracksSharedInstanceOnly": {
+			reason: "should only track the referenced shared instance, not the service manager",
+			fields: fields{
+				creator:     &TfProxyClientCreatorMock{},
+				initializer: &InitializerMock{},
+			},
+			args: args{
+				mg: &v1alpha1.ServiceInstance{Spec: v1alpha1.ServiceInstanceSpec{ForProvider: v1alpha1.ServiceInstanceParameters{
+					ReferencedInstanceRef: &xpv1.Reference{Name: "shared"},
+					ServiceManagerRef:     &xpv1.Reference{Name: "sm"},
+				}}},
+			},
+			want: want{
+				tracked: []string{"ServiceInstance/shared"},
+			},
+		},
+		"TrackError": {
+			reason: "should return an error when the shared instance can't be tracked",
+			fields: fields{
+				creator:     &TfProxyClientCreatorMock{},
+				initializer: &InitializerMock{},
+				tracker:     &referenceTrackerMock{err: errKube},
+			},
+			args: args{
+				mg: &v1alpha1.ServiceInstance{Spec: v1alpha1.ServiceInstanceSpec{ForProvider: v1alpha1.ServiceInstanceParameters{
+					ReferencedInstanceRef: &xpv1.Reference{Name: "shared"},
+				}}},
+			},
+			want: want{
+				err:     errKube,
+				tracked: []string{"ServiceInstance/shared"},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			tracker := tc.fields.tracker
+			if tracker == nil {
+				tracker = &referenceTrackerMock{}
+			}
 			c := connector{
 				clientConnector:             tc.fields.creator,
 				newServicePlanInitializerFn: func() Initializer { return tc.fields.initializer },
+				resourcetracker:             trackingtest.NoOpReferenceResolverTracker{},
+				referenceTracker:            tracker,
 			}
 
 			got, err := c.Connect(context.Background(), tc.args.mg)
@@ -99,6 +145,212 @@ func TestConnect(t *testing.T) {
 				t.Errorf("expected external client, got nil")
 			}
 			expectedErrorBehaviour(t, tc.want.err, err)
+			if diff := cmp.Diff(tc.want.tracked, tracker.tracked); diff != "" {
+				t.Errorf("\n%s\nConnect(...): -want tracked, +got tracked:\n", diff)
+			}
+		})
+	}
+}
+
+func TestConnectParameterDriftCondition(t *testing.T) {
+	cases := map[string]struct {
+		native     bool
+		wantReason xpv1.ConditionReason
+		wantDrift  []string
+	}{
+		"Terraform": {wantReason: v1alpha1.ParametersNotObserved},
+		"Native":    {native: true, wantReason: v1alpha1.ParametersObserved, wantDrift: []string{"size: changed"}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.ServiceInstance{}
+			cr.Status.AtProvider.ParameterDrift = []string{"size: changed"}
+			c := connector{
+				clientConnector:             &TfProxyClientCreatorMock{},
+				nativeConnector:             &TfProxyClientCreatorMock{},
+				nativeByDefault:             tc.native,
+				newServicePlanInitializerFn: func() Initializer { return &InitializerMock{} },
+				resourcetracker:             trackingtest.NoOpReferenceResolverTracker{},
+				referenceTracker:            &referenceTrackerMock{},
+			}
+			_, err := c.Connect(context.Background(), cr)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantReason, cr.GetCondition(v1alpha1.ParameterDriftCondition).Reason)
+			assert.Equal(t, tc.wantDrift, cr.Status.AtProvider.ParameterDrift)
+		})
+	}
+}
+
+func TestValidateCatalog(t *testing.T) {
+	catalog := v1alpha1.ServiceCatalogObservation{
+		Offerings: []v1alpha1.CatalogOffering{
+			{Name: "destination", Plans: []v1alpha1.CatalogPlan{{Name: "lite"}}},
+		},
+		LastRefreshTime: &metav1.Time{},
+	}
+	kube := func(status v1alpha1.ServiceCatalogObservation, err error) client.Client {
+		return &test.MockClient{
+			MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+				obj.(*v1alpha1.ServiceCatalog).Status.AtProvider = status
+				return err
+			},
+		}
+	}
+	instance := func(offering, plan string, opts ...func(*v1alpha1.ServiceInstance)) *v1alpha1.ServiceInstance {
+		cr := &v1alpha1.ServiceInstance{}
+		cr.Spec.ForProvider.OfferingName = offering
+		cr.Spec.ForProvider.PlanName = plan
+		cr.Spec.ForProvider.ServiceCatalogRef = &xpv1.Reference{Name: "catalog"}
+		for _, opt := range opts {
+			opt(cr)
+		}
+		return cr
+	}
+
+	deleted := func(cr *v1alpha1.ServiceInstance) {
+		now := metav1.Now()
+		cr.SetDeletionTimestamp(&now)
+	}
+
+	tests := map[string]struct {
+		kube       client.Client
+		cr         *v1alpha1.ServiceInstance
+		wantErr    string
+		wantReason xpv1.ConditionReason
+	}{
+		"NoCatalog": {
+			cr: &v1alpha1.ServiceInstance{},
+		},
+		"AlreadyCreated": {
+			cr: instance("unknown", "lite", func(cr *v1alpha1.ServiceInstance) { cr.Status.AtProvider.ID = "instance-id" }),
+		},
+		"DeletedWithInvalidCatalog": {
+			kube: kube(catalog, nil),
+			cr:   instance("xsuaa", "application", deleted),
+		},
+		"DeletedWithCatalogNotFetched": {
+			kube: kube(v1alpha1.ServiceCatalogObservation{}, nil),
+			cr:   instance("destination", "lite", deleted),
+		},
+		"GetError": {
+			kube:    kube(catalog, errKube),
+			cr:      instance("destination", "lite"),
+			wantErr: "cannot get service catalog: kubeError",
+		},
+		"NotFetched": {
+			kube:    kube(v1alpha1.ServiceCatalogObservation{}, nil),
+			cr:      instance("destination", "lite"),
+			wantErr: "service catalog catalog has not been fetched yet",
+		},
+		"UnknownOffering": {
+			kube:       kube(catalog, nil),
+			cr:         instance("xsuaa", "application"),
+			wantErr:    errInvalidCatalog,
+			wantReason: v1alpha1.HasValidationIssues,
+		},
+		"UnknownPlan": {
+			kube:       kube(catalog, nil),
+			cr:         instance("destination", "premium"),
+			wantErr:    errInvalidCatalog,
+			wantReason: v1alpha1.HasValidationIssues,
+		},
+		"ReferenceInstance": {
+			kube: kube(catalog, nil),
+			cr: instance("destination", "", func(cr *v1alpha1.ServiceInstance) {
+				cr.Spec.ForProvider.ReferencedInstanceID = internal.Ptr("shared-id")
+			}),
+			wantReason: v1alpha1.NoValidationIssues,
+		},
+		"Valid": {
+			kube:       kube(catalog, nil),
+			cr:         instance("destination", "lite"),
+			wantReason: v1alpha1.NoValidationIssues,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := connector{kube: tc.kube}
+			err := c.validateCatalog(context.Background(), tc.cr)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantReason, tc.cr.GetCondition(v1alpha1.SoftValidationCondition).Reason)
+		})
+	}
+}
+
+func TestBackend(t *testing.T) {
+	tfConnector := &TfProxyClientCreatorMock{}
+	nativeConnector := &TfProxyClientCreatorMock{}
+
+	kubeWithBackend := func(backend string) client.Client {
+		return &test.MockClient{
+			MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+				obj.(*providerv1alpha1.ProviderConfig).Spec.ServiceManagerBackend = backend
+				return nil
+			},
+		}
+	}
+	withPC := &v1alpha1.ServiceInstance{}
+	withPC.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+
+	cases := map[string]struct {
+		kube            client.Client
+		cr              *v1alpha1.ServiceInstance
+		nativeByDefault bool
+		want            tfclient.TfProxyConnectorI[*v1alpha1.ServiceInstance]
+		err             error
+	}{
+		"NoProviderConfigRef": {
+			cr:              &v1alpha1.ServiceInstance{},
+			nativeByDefault: true,
+			want:            nativeConnector,
+		},
+		"ProviderConfigError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errKube)},
+			cr:   withPC,
+			err:  errKube,
+		},
+		"DefaultTerraform": {
+			kube: kubeWithBackend(""),
+			cr:   withPC,
+			want: tfConnector,
+		},
+		"DefaultNative": {
+			kube:            kubeWithBackend(""),
+			cr:              withPC,
+			nativeByDefault: true,
+			want:            nativeConnector,
+		},
+		"ConfiguredNative": {
+			kube: kubeWithBackend(providerv1alpha1.ServiceManagerBackendNative),
+			cr:   withPC,
+			want: nativeConnector,
+		},
+		"ConfiguredTerraform": {
+			kube:            kubeWithBackend(providerv1alpha1.ServiceManagerBackendTerraform),
+			cr:              withPC,
+			nativeByDefault: true,
+			want:            tfConnector,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := connector{
+				kube:            tc.kube,
+				clientConnector: tfConnector,
+				nativeConnector: nativeConnector,
+				nativeByDefault: tc.nativeByDefault,
+			}
+
+			got, err := c.backend(context.Background(), tc.cr)
+			expectedErrorBehaviour(t, tc.err, err)
+			if got != tc.want {
+				t.Errorf("backend() selected the wrong connector")
+			}
 		})
 	}
 }
@@ -475,14 +727,16 @@ func TestSaveCallback(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	type fields struct {
-		client *TfProxyMock
+		client  *TfProxyMock
+		blocked bool
 	}
 	type args struct {
 		mg resource.Managed
 	}
 	type want struct {
-		err error
-		cr  *v1alpha1.ServiceInstance
+		err    error
+		errMsg string
+		cr     *v1alpha1.ServiceInstance
 	}
 
 	cases := map[string]struct {
@@ -506,6 +760,22 @@ func TestDelete(t *testing.T) {
 				),
 			},
 		},
+		"InUse": {
+			reason: "should not delete a shared instance that is still referenced",
+			fields: fields{
+				client:  &TfProxyMock{err: errClient},
+				blocked: true,
+			},
+			args: args{
+				mg: &v1alpha1.ServiceInstance{},
+			},
+			want: want{
+				errMsg: providerv1alpha1.ErrResourceInUse,
+				cr: expectedServiceInstance(
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
 		"HappyPath": {
 			reason: "should delete the resource successfully and set Deleting condition",
 			fields: fields{
@@ -530,10 +800,15 @@ func TestDelete(t *testing.T) {
 				kube: &test.MockClient{
 					MockUpdate: test.NewMockUpdateFn(nil),
 				},
+				tracker: trackingtest.NoOpReferenceResolverTracker{IsResourceBlocked: tc.fields.blocked},
 			}
 
 			err := e.Delete(context.Background(), tc.args.mg)
-			expectedErrorBehaviour(t, tc.want.err, err)
+			if tc.want.errMsg != "" {
+				assert.EqualError(t, err, tc.want.errMsg)
+			} else {
+				expectedErrorBehaviour(t, tc.want.err, err)
+			}
 
 			// Verify the entire CR
 			cr, ok := tc.args.mg.(*v1alpha1.ServiceInstance)
@@ -562,6 +837,16 @@ func (t *TfProxyClientCreatorMock) Connect(ctx context.Context, cr *v1alpha1.Ser
 
 var _ Initializer = &InitializerMock{}
 
+type referenceTrackerMock struct {
+	err     error
+	tracked []string
+}
+
+func (r *referenceTrackerMock) CreateTrackingReference(ctx context.Context, cr resource.Managed, reference xpv1.Reference, gvk schema.GroupVersionKind) error {
+	r.tracked = append(r.tracked, gvk.Kind+"/"+reference.Name)
+	return r.err
+}
+
 type InitializerMock struct {
 	err error
 }
@@ -6,7 +6,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/btp"
+	siClient "github.com/sap/crossplane-provider-btp/internal/clients/account/serviceinstance"
 	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/di"
+	"github.com/sap/crossplane-provider-btp/internal/features"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -19,14 +22,18 @@ import (
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return providerconfig.DefaultSetup(mgr, o, &v1alpha1.ServiceInstance{}, v1alpha1.ServiceInstanceGroupKind, v1alpha1.ServiceInstanceGroupVersionKind, func(kube client.Client, usage resource.Tracker, resourcetracker tracking.ReferenceResolverTracker, newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)) managed.ExternalConnecter {
 		return &connector{
-			kube:  mgr.GetClient(),
-			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
+			kube:             mgr.GetClient(),
+			usage:            resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
+			resourcetracker:  resourcetracker,
+			referenceTracker: tracking.NewDefaultReferenceResolverTracker(mgr.GetClient()),
 
 			newServicePlanInitializerFn: newServicePlanInitializerFn,
 
 			// instead of passing the creatorFn as usual we need to execute here to make sure the connector has only one instance of the client
 			// this is required to ensure terraform workspace is shared among reconciliation loops, since the state of async operations is stored in the client
 			clientConnector: newClientCreatorFn(mgr.GetClient()),
+			nativeConnector: siClient.NewNativeServiceInstanceConnector(mgr.GetClient(), di.NewNativeClientFn, di.LoadSecretData),
+			nativeByDefault: o.Features.Enabled(features.EnableNativeServiceManager),
 		}
 	})
 }
@@ -2,6 +2,8 @@ package servicemanager
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -9,6 +11,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	sm "github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	apisv1beta1 "github.com/sap/crossplane-provider-btp/apis/account/v1beta1"
@@ -20,6 +23,14 @@ import (
 const (
 	errNotServiceManager    = "managed resource is not a ServiceManager custom resource"
 	errUpdateNotImplemented = "Update action not implemented"
+	errRotateBinding        = "cannot rotate service manager binding"
+	errMigratePlan          = "cannot migrate service manager to new plan"
+	errSwitchResources      = "cannot switch to new service manager resources"
+	errRecordPending        = "cannot record pending service manager resources"
+	errDeleteRetired        = "cannot delete retired service manager resources"
+
+	// defaultOverlapPeriod applies if the overlap period is not set in the spec
+	defaultOverlapPeriod = time.Hour
 )
 
 // ServiceManagerPlanIdInitializer is will provide implementation of service plan id lookup by name
@@ -61,14 +72,22 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		tracker:  c.resourcetracker,
 		tfClient: tfClient,
 		kube:     c.kube,
+		now:      time.Now,
 	}, nil
 }
 
+// IsInitialized checks whether the plan id has been looked up for the current plan name,
+// lookups stored without plan name are repeated once to record it
 func (c *connector) IsInitialized(cr *apisv1beta1.ServiceManager) bool {
-	return cr.Spec.ForProvider.SubaccountGuid != "" && cr.Status.AtProvider.DataSourceLookup != nil
+	lookup := cr.Status.AtProvider.DataSourceLookup
+	return cr.Spec.ForProvider.SubaccountGuid != "" && lookup != nil && lookup.ServiceManagerPlanName == c.ServicePlanName(cr)
 }
 
 func (c *connector) InitializeServicePlanId(ctx context.Context, cr *apisv1beta1.ServiceManager) error {
+	// instances created before plan migrations were supported don't have their plan recorded, they use the plan looked up so far
+	if lookup := cr.Status.AtProvider.DataSourceLookup; lookup != nil && cr.Status.AtProvider.ServiceInstanceID != "" && cr.Status.AtProvider.ServiceInstancePlanID == "" {
+		cr.Status.AtProvider.ServiceInstancePlanID = lookup.ServiceManagerPlanID
+	}
 	if c.IsInitialized(cr) {
 		return nil
 	}
@@ -83,7 +102,7 @@ func (c *connector) InitializeServicePlanId(ctx context.Context, cr *apisv1beta1
 		return err
 	}
 
-	return c.saveId(ctx, cr, id)
+	return c.saveId(ctx, cr, c.ServicePlanName(cr), id)
 }
 
 func (c *connector) ServicePlanName(cr *apisv1beta1.ServiceManager) string {
@@ -93,9 +112,10 @@ func (c *connector) ServicePlanName(cr *apisv1beta1.ServiceManager) string {
 	return apisv1beta1.DefaultPlanName
 }
 
-func (c *connector) saveId(ctx context.Context, cr *apisv1beta1.ServiceManager, id string) error {
+func (c *connector) saveId(ctx context.Context, cr *apisv1beta1.ServiceManager, name string, id string) error {
 	cr.Status.AtProvider.DataSourceLookup = &apisv1beta1.DataSourceLookup{
-		ServiceManagerPlanID: id,
+		ServiceManagerPlanID:   id,
+		ServiceManagerPlanName: name,
 	}
 	return c.kube.Status().Update(ctx, cr)
 }
@@ -105,6 +125,7 @@ type external struct {
 	tracker tracking.ReferenceResolverTracker
 
 	tfClient sm.ITfClient
+	now      func() time.Time
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -120,7 +141,11 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, statusErr
 	}
 
-	return resStatus.ExternalObservation, err
+	obs := resStatus.ExternalObservation
+	if err == nil && obs.ResourceExists && obs.ResourceUpToDate {
+		obs.ResourceUpToDate = cr.Status.AtProvider.Pending == nil && !c.migrationDue(cr) && !c.rotationDue(cr) && !c.retiredExpired(cr)
+	}
+	return obs, err
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -145,12 +170,27 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotServiceManager)
 	}
 
-	err := c.tfClient.UpdateResources(ctx, cr)
+	if pending := cr.Status.AtProvider.Pending; pending != nil && pending.ServiceBindingID == "" {
+		// the creation has been interrupted before returning any resources, it is started over
+		cr.Status.AtProvider.Pending = nil
+	}
+
+	var err error
+	switch {
+	case cr.Status.AtProvider.Pending != nil:
+		err = c.switchTo(ctx, cr)
+	case c.migrationDue(cr):
+		err = c.migratePlan(ctx, cr)
+	case c.rotationDue(cr):
+		err = c.rotateBinding(ctx, cr)
+	default:
+		err = c.tfClient.UpdateResources(ctx, cr)
+	}
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
 
-	return managed.ExternalUpdate{}, err
+	return managed.ExternalUpdate{}, c.deleteRetired(ctx, cr, true)
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -167,9 +207,162 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return errors.New(providerv1alpha1.ErrResourceInUse)
 	}
 
+	c.retirePending(cr)
+	if err := c.deleteRetired(ctx, cr, false); err != nil {
+		return err
+	}
 	return c.tfClient.DeleteResources(ctx, cr)
 }
 
+// migrationDue reports whether the plan looked up for the spec differs from the plan of the current instance
+func (c *external) migrationDue(cr *apisv1beta1.ServiceManager) bool {
+	lookup := cr.Status.AtProvider.DataSourceLookup
+	current := cr.Status.AtProvider.ServiceInstancePlanID
+	return lookup != nil && current != "" && current != lookup.ServiceManagerPlanID
+}
+
+// rotationDue reports whether rotation is enabled and the current binding is older than the rotation interval
+func (c *external) rotationDue(cr *apisv1beta1.ServiceManager) bool {
+	interval := cr.Spec.ForProvider.RotationInterval
+	createdAt := cr.Status.AtProvider.BindingCreatedAt
+	return interval != nil && createdAt != nil && !c.now().Before(createdAt.Add(interval.Duration))
+}
+
+// retiredExpired reports whether any retired resources have passed their overlap period
+func (c *external) retiredExpired(cr *apisv1beta1.ServiceManager) bool {
+	for _, retired := range cr.Status.AtProvider.Retired {
+		if !c.now().Before(retired.DeleteAfter.Time) {
+			return true
+		}
+	}
+	return false
+}
+
+// rotateBinding creates a new binding for the current instance and retires the old one
+func (c *external) rotateBinding(ctx context.Context, cr *apisv1beta1.ServiceManager) error {
+	now := c.now()
+	pending := &apisv1beta1.PendingResources{
+		ServiceInstanceID:  cr.Status.AtProvider.ServiceInstanceID,
+		ServiceBindingName: fmt.Sprintf("%s-%d", bindingBaseName(cr), now.Unix()),
+		CreatedAt:          metav1.Time{Time: now},
+	}
+	if err := c.recordPending(ctx, cr, pending); err != nil {
+		return err
+	}
+	bID, retired, err := c.tfClient.RotateBinding(ctx, cr, pending.ServiceBindingName)
+	if err != nil {
+		cr.Status.AtProvider.Pending = nil
+		return errors.Wrap(err, errRotateBinding)
+	}
+	pending.ServiceBindingID = bID
+	return c.created(ctx, cr, pending, retired)
+}
+
+// migratePlan creates a new instance of the looked up plan along with a binding and retires the old ones
+func (c *external) migratePlan(ctx context.Context, cr *apisv1beta1.ServiceManager) error {
+	now := c.now()
+	pending := &apisv1beta1.PendingResources{
+		ServiceInstanceName:   fmt.Sprintf("%s-%d", instanceBaseName(cr), now.Unix()),
+		ServiceInstancePlanID: cr.Status.AtProvider.DataSourceLookup.ServiceManagerPlanID,
+		ServiceBindingName:    fmt.Sprintf("%s-%d", bindingBaseName(cr), now.Unix()),
+		CreatedAt:             metav1.Time{Time: now},
+	}
+	if err := c.recordPending(ctx, cr, pending); err != nil {
+		return err
+	}
+	sID, bID, retired, err := c.tfClient.MigratePlan(ctx, cr, pending.ServiceInstanceName, pending.ServiceBindingName, pending.ServiceInstancePlanID)
+	if err != nil {
+		cr.Status.AtProvider.Pending = nil
+		return errors.Wrap(err, errMigratePlan)
+	}
+	pending.ServiceInstanceID = sID
+	pending.ServiceBindingID = bID
+	return c.created(ctx, cr, pending, retired)
+}
+
+// recordPending persists the pending resources in the status
+func (c *external) recordPending(ctx context.Context, cr *apisv1beta1.ServiceManager, pending *apisv1beta1.PendingResources) error {
+	cr.Status.AtProvider.Pending = pending
+	return errors.Wrap(c.kube.Status().Update(ctx, cr), errRecordPending)
+}
+
+// created records the ids of the created resources along with the ones they replace and switches to them,
+// if their ids can't be recorded they are deleted right away since nothing would refer to them
+func (c *external) created(ctx context.Context, cr *apisv1beta1.ServiceManager, pending *apisv1beta1.PendingResources, retired apisv1beta1.RetiredResources) error {
+	retired.DeleteAfter = c.deleteAfter(cr, pending.CreatedAt.Time)
+	pending.Retired = retired
+	if err := c.recordPending(ctx, cr, pending); err != nil {
+		if delErr := c.tfClient.DeleteRetired(ctx, cr, pendingResources(pending)); delErr != nil {
+			return errors.Wrapf(err, "cannot delete new resources (%s)", delErr)
+		}
+		cr.Status.AtProvider.Pending = nil
+		return err
+	}
+	return c.switchTo(ctx, cr)
+}
+
+// switchTo persists the external name of the pending resources before recording them as the current ones,
+// since updating the object resets the status to the stored one which already contains the pending resources
+func (c *external) switchTo(ctx context.Context, cr *apisv1beta1.ServiceManager) error {
+	meta.SetExternalName(cr, pendingExternalName(cr.Status.AtProvider.Pending))
+	if err := c.kube.Update(ctx, cr); err != nil {
+		return errors.Wrap(err, errSwitchResources)
+	}
+	obs := &cr.Status.AtProvider
+	pending := obs.Pending
+	if pending.ServiceInstanceName != "" {
+		obs.ServiceInstanceID = pending.ServiceInstanceID
+		obs.ServiceInstanceName = pending.ServiceInstanceName
+		obs.ServiceInstancePlanID = pending.ServiceInstancePlanID
+	}
+	obs.ServiceBindingID = pending.ServiceBindingID
+	obs.ServiceBindingName = pending.ServiceBindingName
+	obs.BindingCreatedAt = &metav1.Time{Time: pending.CreatedAt.Time}
+	obs.Retired = append(obs.Retired, pending.Retired)
+	obs.Pending = nil
+	return errors.Wrap(c.kube.Status().Update(ctx, cr), errSwitchResources)
+}
+
+// retirePending adds created resources that haven't been switched to yet, or the ones they replace if they have, to the retired ones
+func (c *external) retirePending(cr *apisv1beta1.ServiceManager) {
+	pending := cr.Status.AtProvider.Pending
+	if pending == nil || pending.ServiceBindingID == "" {
+		return
+	}
+	if meta.GetExternalName(cr) == pendingExternalName(pending) {
+		cr.Status.AtProvider.Retired = append(cr.Status.AtProvider.Retired, pending.Retired)
+	} else {
+		cr.Status.AtProvider.Retired = append(cr.Status.AtProvider.Retired, pendingResources(pending))
+	}
+	cr.Status.AtProvider.Pending = nil
+}
+
+// deleteRetired deletes retired resources, either only those past their overlap period or all of them,
+// resources that couldn't be deleted are kept in the status for the next attempt
+func (c *external) deleteRetired(ctx context.Context, cr *apisv1beta1.ServiceManager, expiredOnly bool) error {
+	var kept []apisv1beta1.RetiredResources
+	var err error
+	for _, retired := range cr.Status.AtProvider.Retired {
+		if err != nil || (expiredOnly && c.now().Before(retired.DeleteAfter.Time)) {
+			kept = append(kept, retired)
+			continue
+		}
+		if err = c.tfClient.DeleteRetired(ctx, cr, retired); err != nil {
+			kept = append(kept, retired)
+		}
+	}
+	cr.Status.AtProvider.Retired = kept
+	return errors.Wrap(err, errDeleteRetired)
+}
+
+func (c *external) deleteAfter(cr *apisv1beta1.ServiceManager, now time.Time) metav1.Time {
+	overlap := defaultOverlapPeriod
+	if cr.Spec.ForProvider.OverlapPeriod != nil {
+		overlap = cr.Spec.ForProvider.OverlapPeriod.Duration
+	}
+	return metav1.Time{Time: now.Add(overlap)}
+}
+
 func (c *external) setStatus(ctx context.Context, status sm.ResourcesStatus, cr *apisv1beta1.ServiceManager) error {
 	if status.ResourceExists {
 		cr.Status.SetConditions(xpv1.Available())
@@ -180,6 +373,10 @@ func (c *external) setStatus(ctx context.Context, status sm.ResourcesStatus, cr
 	}
 	cr.Status.AtProvider.ServiceInstanceID = status.InstanceID
 	cr.Status.AtProvider.ServiceBindingID = status.BindingID
+	// bindings created before rotations were supported start their rotation interval once they are observed
+	if status.ResourceExists && cr.Status.AtProvider.BindingCreatedAt == nil {
+		cr.Status.AtProvider.BindingCreatedAt = &metav1.Time{Time: c.now()}
+	}
 	// Unfortunately we need to update the CR status manually here, because the reconciler will drop the change otherwise
 	// (I guess because we are attempting to save something while ResourceExists remains false for another cycle)
 	return c.kube.Status().Update(ctx, cr)
@@ -192,3 +389,34 @@ func formExternalName(serviceInstanceID, serviceBindingID string) string {
 	}
 	return serviceInstanceID + "/" + serviceBindingID
 }
+
+// pendingExternalName forms the externalName the pending resources are switched to
+func pendingExternalName(pending *apisv1beta1.PendingResources) string {
+	return formExternalName(pending.ServiceInstanceID, pending.ServiceBindingID)
+}
+
+// pendingResources returns the created pending resources in the shape of retired ones to delete them
+func pendingResources(pending *apisv1beta1.PendingResources) apisv1beta1.RetiredResources {
+	return apisv1beta1.RetiredResources{
+		ServiceInstanceID:   pending.ServiceInstanceID,
+		ServiceInstanceName: pending.ServiceInstanceName,
+		ServiceBindingID:    pending.ServiceBindingID,
+		ServiceBindingName:  pending.ServiceBindingName,
+	}
+}
+
+// instanceBaseName returns the instance name from the spec, rotated names are derived from it
+func instanceBaseName(cr *apisv1beta1.ServiceManager) string {
+	if cr.Spec.ForProvider.ServiceInstanceName != "" {
+		return cr.Spec.ForProvider.ServiceInstanceName
+	}
+	return apisv1beta1.DefaultServiceInstanceName
+}
+
+// bindingBaseName returns the binding name from the spec, rotated names are derived from it
+func bindingBaseName(cr *apisv1beta1.ServiceManager) string {
+	if cr.Spec.ForProvider.ServiceBindingName != "" {
+		return cr.Spec.ForProvider.ServiceBindingName
+	}
+	return apisv1beta1.DefaultServiceBindingName
+}
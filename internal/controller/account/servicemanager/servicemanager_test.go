@@ -3,6 +3,7 @@ package servicemanager
 import (
 	"context"
 	"testing"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -17,6 +18,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
 func TestObserve(t *testing.T) {
 	type want struct {
 		err error
@@ -107,6 +110,103 @@ func TestObserve(t *testing.T) {
 						Status:            v1beta1.ServiceManagerBound,
 						ServiceInstanceID: "someID",
 						ServiceBindingID:  "anotherID",
+						BindingCreatedAt:  &metav1.Time{Time: now},
+					}),
+					WithConditions(xpv1.Available())),
+			},
+		},
+		{
+			name: "RotationDue",
+			args: args{
+				cr: NewServiceManager("test",
+					WithData(v1beta1.ServiceManagerParameters{RotationInterval: &metav1.Duration{Duration: time.Hour}}),
+					WithStatus(v1beta1.ServiceManagerObservation{BindingCreatedAt: &metav1.Time{Time: now.Add(-time.Hour)}}),
+				),
+				tfClient: &TfClientFake{
+					observeFn: func() (servicemanager.ResourcesStatus, error) {
+						return servicemanager.ResourcesStatus{
+							ExternalObservation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+							InstanceID:          "someID",
+							BindingID:           "anotherID",
+						}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cr: NewServiceManager("test",
+					WithData(v1beta1.ServiceManagerParameters{RotationInterval: &metav1.Duration{Duration: time.Hour}}),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						Status:            v1beta1.ServiceManagerBound,
+						ServiceInstanceID: "someID",
+						ServiceBindingID:  "anotherID",
+						BindingCreatedAt:  &metav1.Time{Time: now.Add(-time.Hour)},
+					}),
+					WithConditions(xpv1.Available())),
+			},
+		},
+		{
+			name: "MigrationDue",
+			args: args{
+				cr: NewServiceManager("test",
+					WithStatus(v1beta1.ServiceManagerObservation{
+						ServiceInstancePlanID: "oldPlan",
+						BindingCreatedAt:      &metav1.Time{Time: now},
+						DataSourceLookup:      &v1beta1.DataSourceLookup{ServiceManagerPlanID: "newPlan"},
+					}),
+				),
+				tfClient: &TfClientFake{
+					observeFn: func() (servicemanager.ResourcesStatus, error) {
+						return servicemanager.ResourcesStatus{
+							ExternalObservation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+							InstanceID:          "someID",
+							BindingID:           "anotherID",
+						}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cr: NewServiceManager("test",
+					WithStatus(v1beta1.ServiceManagerObservation{
+						Status:                v1beta1.ServiceManagerBound,
+						ServiceInstanceID:     "someID",
+						ServiceBindingID:      "anotherID",
+						ServiceInstancePlanID: "oldPlan",
+						BindingCreatedAt:      &metav1.Time{Time: now},
+						DataSourceLookup:      &v1beta1.DataSourceLookup{ServiceManagerPlanID: "newPlan"},
+					}),
+					WithConditions(xpv1.Available())),
+			},
+		},
+		{
+			name: "RetiredExpired",
+			args: args{
+				cr: NewServiceManager("test",
+					WithStatus(v1beta1.ServiceManagerObservation{
+						BindingCreatedAt: &metav1.Time{Time: now},
+						Retired:          []v1beta1.RetiredResources{{ServiceBindingID: "oldID", DeleteAfter: metav1.Time{Time: now}}},
+					}),
+				),
+				tfClient: &TfClientFake{
+					observeFn: func() (servicemanager.ResourcesStatus, error) {
+						return servicemanager.ResourcesStatus{
+							ExternalObservation: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+							InstanceID:          "someID",
+							BindingID:           "anotherID",
+						}, nil
+					},
+				},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				cr: NewServiceManager("test",
+					WithStatus(v1beta1.ServiceManagerObservation{
+						Status:            v1beta1.ServiceManagerBound,
+						ServiceInstanceID: "someID",
+						ServiceBindingID:  "anotherID",
+						BindingCreatedAt:  &metav1.Time{Time: now},
+						Retired:           []v1beta1.RetiredResources{{ServiceBindingID: "oldID", DeleteAfter: metav1.Time{Time: now}}},
 					}),
 					WithConditions(xpv1.Available())),
 			},
@@ -121,6 +221,7 @@ func TestObserve(t *testing.T) {
 						return nil
 					},
 				},
+				now: func() time.Time { return now },
 			}
 			obs, err := uua.Observe(context.TODO(), tc.args.cr)
 			if diff := cmp.Diff(obs, tc.want.obs); diff != "" {
@@ -204,11 +305,14 @@ func TestCreate(t *testing.T) {
 func TestUpdate(t *testing.T) {
 	type want struct {
 		err error
+		cr  *v1beta1.ServiceManager
 	}
 	type args struct {
 		cr       *v1beta1.ServiceManager
 		tfClient *TfClientFake
+		kube     client.Client
 	}
+	retiredBinding := v1beta1.RetiredResources{ServiceInstanceID: "someID", ServiceBindingID: "anotherID", ServiceBindingName: "binding"}
 	tests := []struct {
 		name string
 		args args
@@ -227,6 +331,7 @@ func TestUpdate(t *testing.T) {
 			},
 			want: want{
 				err: errors.New("updateError"),
+				cr:  NewServiceManager("test", WithExternalName("someID")),
 			},
 		},
 		{
@@ -241,18 +346,325 @@ func TestUpdate(t *testing.T) {
 			},
 			want: want{
 				err: nil,
+				cr:  NewServiceManager("test", WithExternalName("someID")),
+			},
+		},
+		{
+			name: "RotateBinding",
+			args: args{
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithData(v1beta1.ServiceManagerParameters{ServiceBindingName: "binding", RotationInterval: &metav1.Duration{Duration: time.Hour}}),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						ServiceInstanceID: "someID",
+						ServiceBindingID:  "anotherID",
+						BindingCreatedAt:  &metav1.Time{Time: now.Add(-2 * time.Hour)},
+					}),
+				),
+				tfClient: &TfClientFake{
+					rotateFn: func(bindingName string) (string, v1beta1.RetiredResources, error) {
+						if bindingName != "binding-1704110400" {
+							return "", v1beta1.RetiredResources{}, errors.Errorf("unexpected binding name %s", bindingName)
+						}
+						return "newBindingID", retiredBinding, nil
+					},
+				},
+			},
+			want: want{
+				cr: NewServiceManager("test",
+					WithExternalName("someID/newBindingID"),
+					WithData(v1beta1.ServiceManagerParameters{ServiceBindingName: "binding", RotationInterval: &metav1.Duration{Duration: time.Hour}}),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						ServiceInstanceID:  "someID",
+						ServiceBindingID:   "newBindingID",
+						ServiceBindingName: "binding-1704110400",
+						BindingCreatedAt:   &metav1.Time{Time: now},
+						Retired: []v1beta1.RetiredResources{{
+							ServiceInstanceID:  "someID",
+							ServiceBindingID:   "anotherID",
+							ServiceBindingName: "binding",
+							DeleteAfter:        metav1.Time{Time: now.Add(time.Hour)},
+						}},
+					}),
+				),
+			},
+		},
+		{
+			name: "RotateBindingError",
+			args: args{
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithData(v1beta1.ServiceManagerParameters{RotationInterval: &metav1.Duration{Duration: time.Hour}}),
+					WithStatus(v1beta1.ServiceManagerObservation{BindingCreatedAt: &metav1.Time{Time: now.Add(-2 * time.Hour)}}),
+				),
+				tfClient: &TfClientFake{
+					rotateFn: func(bindingName string) (string, v1beta1.RetiredResources, error) {
+						return "", v1beta1.RetiredResources{}, errors.New("rotateError")
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("rotateError"), errRotateBinding),
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithData(v1beta1.ServiceManagerParameters{RotationInterval: &metav1.Duration{Duration: time.Hour}}),
+					WithStatus(v1beta1.ServiceManagerObservation{BindingCreatedAt: &metav1.Time{Time: now.Add(-2 * time.Hour)}}),
+				),
+			},
+		},
+		{
+			name: "MigratePlan",
+			args: args{
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithData(v1beta1.ServiceManagerParameters{OverlapPeriod: &metav1.Duration{Duration: 10 * time.Minute}}),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						ServiceInstanceID:     "someID",
+						ServiceBindingID:      "anotherID",
+						ServiceInstancePlanID: "oldPlan",
+						BindingCreatedAt:      &metav1.Time{Time: now.Add(-time.Minute)},
+						DataSourceLookup:      &v1beta1.DataSourceLookup{ServiceManagerPlanID: "newPlan", ServiceManagerPlanName: "subaccount-admin"},
+					}),
+				),
+				tfClient: &TfClientFake{
+					migrateFn: func(instanceName string, bindingName string, planID string) (string, string, v1beta1.RetiredResources, error) {
+						if instanceName != "managed-service-manager-1704110400" || bindingName != "managed-service-manager-binding-1704110400" || planID != "newPlan" {
+							return "", "", v1beta1.RetiredResources{}, errors.Errorf("unexpected migration to %s/%s of plan %s", instanceName, bindingName, planID)
+						}
+						return "newInstanceID", "newBindingID", v1beta1.RetiredResources{ServiceInstanceID: "someID", ServiceInstanceName: "managed-service-manager", ServiceBindingID: "anotherID", ServiceBindingName: "managed-service-manager-binding"}, nil
+					},
+				},
+			},
+			want: want{
+				cr: NewServiceManager("test",
+					WithExternalName("newInstanceID/newBindingID"),
+					WithData(v1beta1.ServiceManagerParameters{OverlapPeriod: &metav1.Duration{Duration: 10 * time.Minute}}),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						ServiceInstanceID:     "newInstanceID",
+						ServiceInstanceName:   "managed-service-manager-1704110400",
+						ServiceInstancePlanID: "newPlan",
+						ServiceBindingID:      "newBindingID",
+						ServiceBindingName:    "managed-service-manager-binding-1704110400",
+						BindingCreatedAt:      &metav1.Time{Time: now},
+						Retired: []v1beta1.RetiredResources{{
+							ServiceInstanceID:   "someID",
+							ServiceInstanceName: "managed-service-manager",
+							ServiceBindingID:    "anotherID",
+							ServiceBindingName:  "managed-service-manager-binding",
+							DeleteAfter:         metav1.Time{Time: now.Add(10 * time.Minute)},
+						}},
+						DataSourceLookup: &v1beta1.DataSourceLookup{ServiceManagerPlanID: "newPlan", ServiceManagerPlanName: "subaccount-admin"},
+					}),
+				),
+			},
+		},
+		{
+			name: "SwitchError",
+			args: args{
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithData(v1beta1.ServiceManagerParameters{ServiceBindingName: "binding", RotationInterval: &metav1.Duration{Duration: time.Hour}}),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						ServiceInstanceID: "someID",
+						ServiceBindingID:  "anotherID",
+						BindingCreatedAt:  &metav1.Time{Time: now.Add(-2 * time.Hour)},
+					}),
+				),
+				tfClient: &TfClientFake{
+					rotateFn: func(bindingName string) (string, v1beta1.RetiredResources, error) {
+						return "newBindingID", retiredBinding, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate:       test.NewMockUpdateFn(errors.New("updateError")),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("updateError"), errSwitchResources),
+				cr: NewServiceManager("test",
+					WithExternalName("someID/newBindingID"),
+					WithData(v1beta1.ServiceManagerParameters{ServiceBindingName: "binding", RotationInterval: &metav1.Duration{Duration: time.Hour}}),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						ServiceInstanceID: "someID",
+						ServiceBindingID:  "anotherID",
+						BindingCreatedAt:  &metav1.Time{Time: now.Add(-2 * time.Hour)},
+						Pending: &v1beta1.PendingResources{
+							ServiceInstanceID:  "someID",
+							ServiceBindingID:   "newBindingID",
+							ServiceBindingName: "binding-1704110400",
+							CreatedAt:          metav1.Time{Time: now},
+							Retired: v1beta1.RetiredResources{
+								ServiceInstanceID:  "someID",
+								ServiceBindingID:   "anotherID",
+								ServiceBindingName: "binding",
+								DeleteAfter:        metav1.Time{Time: now.Add(time.Hour)},
+							},
+						},
+					}),
+				),
+			},
+		},
+		{
+			name: "RecordPendingError",
+			args: args{
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithData(v1beta1.ServiceManagerParameters{RotationInterval: &metav1.Duration{Duration: time.Hour}}),
+					WithStatus(v1beta1.ServiceManagerObservation{BindingCreatedAt: &metav1.Time{Time: now.Add(-2 * time.Hour)}}),
+				),
+				tfClient: &TfClientFake{
+					rotateFn: func(bindingName string) (string, v1beta1.RetiredResources, error) {
+						return "", v1beta1.RetiredResources{}, errors.New("unexpected rotation")
+					},
+				},
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(errors.New("statusError")),
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("statusError"), errRecordPending),
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithData(v1beta1.ServiceManagerParameters{RotationInterval: &metav1.Duration{Duration: time.Hour}}),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						BindingCreatedAt: &metav1.Time{Time: now.Add(-2 * time.Hour)},
+						Pending: &v1beta1.PendingResources{
+							ServiceBindingName: "managed-service-manager-binding-1704110400",
+							CreatedAt:          metav1.Time{Time: now},
+						},
+					}),
+				),
+			},
+		},
+		{
+			name: "ResumeSwitch",
+			args: args{
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						ServiceInstanceID:     "someID",
+						ServiceBindingID:      "anotherID",
+						ServiceInstancePlanID: "oldPlan",
+						Pending: &v1beta1.PendingResources{
+							ServiceInstanceID:     "newInstanceID",
+							ServiceInstanceName:   "managed-service-manager-1704110400",
+							ServiceInstancePlanID: "newPlan",
+							ServiceBindingID:      "newBindingID",
+							ServiceBindingName:    "managed-service-manager-binding-1704110400",
+							CreatedAt:             metav1.Time{Time: now.Add(-time.Minute)},
+							Retired:               v1beta1.RetiredResources{ServiceInstanceID: "someID", ServiceBindingID: "anotherID", DeleteAfter: metav1.Time{Time: now.Add(time.Hour)}},
+						},
+						DataSourceLookup: &v1beta1.DataSourceLookup{ServiceManagerPlanID: "newPlan"},
+					}),
+				),
+				tfClient: &TfClientFake{
+					migrateFn: func(instanceName string, bindingName string, planID string) (string, string, v1beta1.RetiredResources, error) {
+						return "", "", v1beta1.RetiredResources{}, errors.New("unexpected migration")
+					},
+				},
+			},
+			want: want{
+				cr: NewServiceManager("test",
+					WithExternalName("newInstanceID/newBindingID"),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						ServiceInstanceID:     "newInstanceID",
+						ServiceInstanceName:   "managed-service-manager-1704110400",
+						ServiceInstancePlanID: "newPlan",
+						ServiceBindingID:      "newBindingID",
+						ServiceBindingName:    "managed-service-manager-binding-1704110400",
+						BindingCreatedAt:      &metav1.Time{Time: now.Add(-time.Minute)},
+						Retired:               []v1beta1.RetiredResources{{ServiceInstanceID: "someID", ServiceBindingID: "anotherID", DeleteAfter: metav1.Time{Time: now.Add(time.Hour)}}},
+						DataSourceLookup:      &v1beta1.DataSourceLookup{ServiceManagerPlanID: "newPlan"},
+					}),
+				),
+			},
+		},
+		{
+			name: "DeleteExpiredRetired",
+			args: args{
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						Retired: []v1beta1.RetiredResources{
+							{ServiceBindingID: "expired", DeleteAfter: metav1.Time{Time: now.Add(-time.Minute)}},
+							{ServiceBindingID: "pending", DeleteAfter: metav1.Time{Time: now.Add(time.Minute)}},
+						},
+					}),
+				),
+				tfClient: &TfClientFake{
+					updateFn: func() error {
+						return nil
+					},
+					deleteRetiredFn: func(retired v1beta1.RetiredResources) error {
+						if retired.ServiceBindingID != "expired" {
+							return errors.Errorf("unexpected deletion of %s", retired.ServiceBindingID)
+						}
+						return nil
+					},
+				},
+			},
+			want: want{
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						Retired: []v1beta1.RetiredResources{
+							{ServiceBindingID: "pending", DeleteAfter: metav1.Time{Time: now.Add(time.Minute)}},
+						},
+					}),
+				),
+			},
+		},
+		{
+			name: "DeleteExpiredRetiredError",
+			args: args{
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						Retired: []v1beta1.RetiredResources{{ServiceBindingID: "expired", DeleteAfter: metav1.Time{Time: now.Add(-time.Minute)}}},
+					}),
+				),
+				tfClient: &TfClientFake{
+					updateFn: func() error {
+						return nil
+					},
+					deleteRetiredFn: func(retired v1beta1.RetiredResources) error {
+						return errors.New("deleteError")
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("deleteError"), errDeleteRetired),
+				cr: NewServiceManager("test",
+					WithExternalName("someID/anotherID"),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						Retired: []v1beta1.RetiredResources{{ServiceBindingID: "expired", DeleteAfter: metav1.Time{Time: now.Add(-time.Minute)}}},
+					}),
+				),
 			},
 		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
+			kube := tc.args.kube
+			if kube == nil {
+				kube = &test.MockClient{
+					MockUpdate:       test.NewMockUpdateFn(nil),
+					MockStatusUpdate: test.NewMockSubResourceUpdateFn(nil),
+				}
+			}
 			uua := &external{
 				tfClient: tc.args.tfClient,
+				kube:     kube,
+				now:      func() time.Time { return now },
 			}
 			_, err := uua.Update(context.TODO(), tc.args.cr)
 			if diff := cmp.Diff(err, tc.want.err, test.EquateErrors()); diff != "" {
 				t.Errorf("\ne.Update(): -want error, +got error:\n%s\n", diff)
 			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("\ne.Update(): expected cr after operation -want, +got:\n%s\n", diff)
+			}
 		})
 	}
 }
@@ -302,6 +714,51 @@ func TestDelete(t *testing.T) {
 				cr:  NewServiceManager("test", WithExternalName("someID/anotherID"), WithConditions(xpv1.Deleting())),
 			},
 		},
+		{
+			name: "RetiredDeleteError",
+			args: args{
+				cr: NewServiceManager("test", WithExternalName("someID/anotherID"),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						Retired: []v1beta1.RetiredResources{{ServiceBindingID: "oldID", DeleteAfter: metav1.Time{Time: now.Add(time.Hour)}}},
+					})),
+				tfClient: &TfClientFake{
+					deleteRetiredFn: func(retired v1beta1.RetiredResources) error {
+						return errors.New("deleteError")
+					},
+					deleteFn: func() error {
+						return errors.New("current resources must not be deleted before the retired ones")
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("deleteError"), errDeleteRetired),
+				cr: NewServiceManager("test", WithExternalName("someID/anotherID"),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						Retired: []v1beta1.RetiredResources{{ServiceBindingID: "oldID", DeleteAfter: metav1.Time{Time: now.Add(time.Hour)}}},
+					}),
+					WithConditions(xpv1.Deleting())),
+			},
+		},
+		{
+			name: "RetiredDeletedFirst",
+			args: args{
+				cr: NewServiceManager("test", WithExternalName("someID/anotherID"),
+					WithStatus(v1beta1.ServiceManagerObservation{
+						Retired: []v1beta1.RetiredResources{{ServiceBindingID: "oldID", DeleteAfter: metav1.Time{Time: now.Add(time.Hour)}}},
+					})),
+				tfClient: &TfClientFake{
+					deleteRetiredFn: func(retired v1beta1.RetiredResources) error {
+						return nil
+					},
+					deleteFn: func() error {
+						return nil
+					},
+				},
+			},
+			want: want{
+				cr: NewServiceManager("test", WithExternalName("someID/anotherID"), WithConditions(xpv1.Deleting())),
+			},
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -365,6 +822,10 @@ type TfClientFake struct {
 	createFn  func() (string, string, error)
 	updateFn  func() error
 	deleteFn  func() error
+
+	rotateFn        func(bindingName string) (string, v1beta1.RetiredResources, error)
+	migrateFn       func(instanceName string, bindingName string, planID string) (string, string, v1beta1.RetiredResources, error)
+	deleteRetiredFn func(retired v1beta1.RetiredResources) error
 }
 
 func (t TfClientFake) ObserveResources(ctx context.Context, cr *v1beta1.ServiceManager) (servicemanager.ResourcesStatus, error) {
@@ -382,3 +843,15 @@ func (t TfClientFake) UpdateResources(ctx context.Context, cr *v1beta1.ServiceMa
 func (t TfClientFake) DeleteResources(ctx context.Context, cr *v1beta1.ServiceManager) error {
 	return t.deleteFn()
 }
+
+func (t TfClientFake) RotateBinding(ctx context.Context, cr *v1beta1.ServiceManager, bindingName string) (string, v1beta1.RetiredResources, error) {
+	return t.rotateFn(bindingName)
+}
+
+func (t TfClientFake) MigratePlan(ctx context.Context, cr *v1beta1.ServiceManager, instanceName string, bindingName string, planID string) (string, string, v1beta1.RetiredResources, error) {
+	return t.migrateFn(instanceName, bindingName, planID)
+}
+
+func (t TfClientFake) DeleteRetired(ctx context.Context, cr *v1beta1.ServiceManager, retired v1beta1.RetiredResources) error {
+	return t.deleteRetiredFn(retired)
+}
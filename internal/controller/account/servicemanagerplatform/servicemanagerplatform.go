@@ -0,0 +1,250 @@
+package servicemanagerplatform
+
+import (
+	"context"
+	"sort"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/apis/account/v1beta1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
+	smapi "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errNotPlatform     = "managed resource is not a ServiceManagerPlatform custom resource"
+	errTrackPCUsage    = "cannot track ProviderConfig usage"
+	errTrackRUsage     = "cannot track ResourceUsage"
+	errLoadSmSecret    = "cannot load service manager secret"
+	errInitSmClient    = "cannot initialize service manager client"
+	errObservePlatform = "cannot observe platform"
+	errRegister        = "cannot register platform"
+	errRegenerate      = "cannot regenerate credentials of adopted platform"
+	errUpdate          = "cannot update platform"
+	errUnregister      = "cannot unregister platform"
+)
+
+type connector struct {
+	kube            client.Client
+	usage           resource.Tracker
+	resourcetracker tracking.ReferenceResolverTracker
+
+	newClientFn  func(ctx context.Context, secretData map[string][]byte) (servicemanager.PlatformClientI, error)
+	loadSecretFn func(kube client.Client, ctx context.Context, secretName, secretNamespace string) (map[string][]byte, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ServiceManagerPlatform)
+	if !ok {
+		return nil, errors.New(errNotPlatform)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+	if err := c.resourcetracker.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackRUsage)
+	}
+
+	secretData, err := c.loadSecretFn(c.kube, ctx, cr.Spec.ForProvider.ServiceManagerSecret, cr.Spec.ForProvider.ServiceManagerSecretNamespace)
+	if err != nil {
+		return nil, errors.Wrap(err, errLoadSmSecret)
+	}
+	smClient, err := c.newClientFn(ctx, secretData)
+	if err != nil {
+		return nil, errors.Wrap(err, errInitSmClient)
+	}
+
+	return &external{
+		client: smClient,
+		smUrl:  string(secretData[v1beta1.ResourceCredentialsServiceManagerUrl]),
+	}, nil
+}
+
+type external struct {
+	client servicemanager.PlatformClientI
+	// url of the service manager, published along with the platform credentials
+	smUrl string
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceManagerPlatform)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotPlatform)
+	}
+
+	platform, err := e.lookup(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errObservePlatform)
+	}
+	if platform == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// adopt a platform registered with the same name, e.g. if persisting the external name failed after registration.
+	// Its credentials are only returned on registration, so new ones are issued for the connection secret.
+	var credentials *smapi.Credentials
+	lateInitialized := false
+	if id := internal.Val(platform.Id); meta.GetExternalName(cr) != id {
+		if credentials, err = e.client.RegenerateCredentials(ctx, id); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errRegenerate)
+		}
+		meta.SetExternalName(cr, id)
+		lateInitialized = true
+	}
+
+	cr.Status.AtProvider.ID = internal.Val(platform.Id)
+	cr.Status.AtProvider.Ready = platform.Ready
+	if platform.CreatedAt != nil {
+		cr.Status.AtProvider.CreatedAt = &metav1.Time{Time: *platform.CreatedAt}
+	}
+	if internal.Val(platform.Ready) {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Creating())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(cr, platform),
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       withCredentials(e.connectionDetails(cr), credentials),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ServiceManagerPlatform)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotPlatform)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	params := cr.Spec.ForProvider
+	payload := smapi.RegisterPlatformRequestPayload{
+		Name:        params.Name,
+		Type:        params.Type,
+		Description: params.Description,
+	}
+	if params.Labels != nil {
+		payload.Labels = &params.Labels
+	}
+	platform, err := e.client.RegisterPlatform(ctx, payload)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errRegister)
+	}
+	meta.SetExternalName(cr, internal.Val(platform.Id))
+
+	// the credentials are only returned on registration, the connection secret is their only copy
+	return managed.ExternalCreation{ConnectionDetails: withCredentials(e.connectionDetails(cr), platform.Credentials)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ServiceManagerPlatform)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotPlatform)
+	}
+
+	platform, err := e.client.GetPlatform(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	if platform == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	params := cr.Spec.ForProvider
+	payload := smapi.UpdatePlatformRequestPayload{
+		Name:        internal.Ptr(params.Name),
+		Description: params.Description,
+		Labels:      labelChanges(params.Labels, internal.Val(platform.Labels)),
+	}
+	if err := e.client.UpdatePlatform(ctx, internal.Val(platform.Id), payload); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ServiceManagerPlatform)
+	if !ok {
+		return errors.New(errNotPlatform)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	id := meta.GetExternalName(cr)
+	if id == "" || id == cr.Name {
+		return nil
+	}
+	return errors.Wrap(e.client.UnregisterPlatform(ctx, id), errUnregister)
+}
+
+// lookup uses the external name as platform id once it is set, otherwise it searches for a platform with the name from the spec
+func (e *external) lookup(ctx context.Context, cr *v1alpha1.ServiceManagerPlatform) (*smapi.PlatformResponseObject, error) {
+	id := meta.GetExternalName(cr)
+	if id != "" && id != cr.Name {
+		return e.client.GetPlatform(ctx, id)
+	}
+	return e.client.FindPlatform(ctx, cr.Spec.ForProvider.Name)
+}
+
+func (e *external) connectionDetails(cr *v1alpha1.ServiceManagerPlatform) managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		v1alpha1.PlatformCredentialsID:    []byte(meta.GetExternalName(cr)),
+		v1alpha1.PlatformCredentialsSmUrl: []byte(e.smUrl),
+	}
+}
+
+// withCredentials adds the basic credentials of the platform to the connection details, if there are any
+func withCredentials(details managed.ConnectionDetails, credentials *smapi.Credentials) managed.ConnectionDetails {
+	if credentials != nil && credentials.Basic != nil {
+		details[v1alpha1.PlatformCredentialsUsername] = []byte(internal.Val(credentials.Basic.Username))
+		details[v1alpha1.PlatformCredentialsPassword] = []byte(internal.Val(credentials.Basic.Password))
+	}
+	return details
+}
+
+// isUpToDate compares name, description and the labels set in the spec, unset fields aren't managed
+func isUpToDate(cr *v1alpha1.ServiceManagerPlatform, platform *smapi.PlatformResponseObject) bool {
+	params := cr.Spec.ForProvider
+	if params.Name != internal.Val(platform.Name) {
+		return false
+	}
+	if params.Description != nil && *params.Description != internal.Val(platform.Description) {
+		return false
+	}
+	return len(labelChanges(params.Labels, internal.Val(platform.Labels))) == 0
+}
+
+// labelChanges lists the operations that bring the labels of the spec onto the platform.
+// Only keys from the spec are touched, labels maintained by the service manager itself are kept.
+func labelChanges(desired map[string][]string, actual map[string][]string) []smapi.Label {
+	keys := make([]string, 0, len(desired))
+	for k := range desired {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	changes := []smapi.Label{}
+	for _, k := range keys {
+		current, exists := actual[k]
+		if exists && cmp.Equal(desired[k], current, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b })) {
+			continue
+		}
+		if exists {
+			changes = append(changes, smapi.Label{Key: internal.Ptr(k), Op: internal.Ptr(servicemanager.LabelOpRemove)})
+		}
+		changes = append(changes, smapi.Label{Key: internal.Ptr(k), Op: internal.Ptr(servicemanager.LabelOpAdd), Values: desired[k]})
+	}
+	return changes
+}
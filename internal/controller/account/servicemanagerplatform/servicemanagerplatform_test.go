@@ -0,0 +1,373 @@
+package servicemanagerplatform
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/clients/servicemanager"
+	smapi "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-service-manager-api-go/pkg"
+	trackingtest "github.com/sap/crossplane-provider-btp/internal/tracking/test"
+)
+
+var errClient = errors.New("api error")
+
+func TestConnect(t *testing.T) {
+	tests := map[string]struct {
+		loadErr   error
+		clientErr error
+		wantErr   bool
+		wantUrl   string
+	}{
+		"SecretMissing": {
+			loadErr: errors.New("not found"),
+			wantErr: true,
+		},
+		"ClientError": {
+			clientErr: errors.New("invalid credentials"),
+			wantErr:   true,
+		},
+		"Success": {
+			wantUrl: "https://sm.example.com",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := connector{
+				usage:           trackingtest.NoOpReferenceResolverTracker{},
+				resourcetracker: trackingtest.NoOpReferenceResolverTracker{},
+				loadSecretFn: func(kube client.Client, ctx context.Context, secretName, secretNamespace string) (map[string][]byte, error) {
+					return map[string][]byte{"sm_url": []byte("https://sm.example.com")}, tc.loadErr
+				},
+				newClientFn: func(ctx context.Context, secretData map[string][]byte) (servicemanager.PlatformClientI, error) {
+					return &servicemanager.PlatformClientFake{}, tc.clientErr
+				},
+			}
+			got, err := c.Connect(context.TODO(), platform())
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("Connect() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err == nil && got.(*external).smUrl != tc.wantUrl {
+				t.Errorf("Connect() smUrl = %v, want %v", got.(*external).smUrl, tc.wantUrl)
+			}
+		})
+	}
+}
+
+func TestObserve(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		cr         *v1alpha1.ServiceManagerPlatform
+		client     *servicemanager.PlatformClientFake
+		want       managed.ExternalObservation
+		wantErr    bool
+		wantLookup string
+		wantStatus v1alpha1.ServiceManagerPlatformObservation
+		wantCond   xpv1.Condition
+		// id of the platform whose credentials have been regenerated
+		wantRegenerated string
+	}{
+		"LookupError": {
+			cr:         platform(),
+			client:     &servicemanager.PlatformClientFake{Err: errClient},
+			wantErr:    true,
+			wantLookup: "name:k8s-platform",
+		},
+		"NotRegistered": {
+			cr:         platform(),
+			client:     &servicemanager.PlatformClientFake{},
+			want:       managed.ExternalObservation{ResourceExists: false},
+			wantLookup: "name:k8s-platform",
+		},
+		"AdoptByName": {
+			cr: platform(),
+			client: &servicemanager.PlatformClientFake{
+				Platform: smPlatform(true),
+				Credentials: &smapi.Credentials{Basic: &smapi.CredentialsBasic{
+					Username: internal.Ptr("user"),
+					Password: internal.Ptr("secret"),
+				}},
+			},
+			want: managed.ExternalObservation{
+				ResourceExists:          true,
+				ResourceUpToDate:        true,
+				ResourceLateInitialized: true,
+				ConnectionDetails: managed.ConnectionDetails{
+					"platformId": []byte("platform-id"),
+					"sm_url":     []byte("https://sm"),
+					"username":   []byte("user"),
+					"password":   []byte("secret"),
+				},
+			},
+			wantRegenerated: "platform-id",
+			wantLookup:      "name:k8s-platform",
+			wantStatus:      v1alpha1.ServiceManagerPlatformObservation{ID: "platform-id", Ready: internal.Ptr(true)},
+			wantCond:        xpv1.Available(),
+		},
+		"NotReady": {
+			cr:     platform(withExternalName("platform-id")),
+			client: &servicemanager.PlatformClientFake{Platform: smPlatform(false)},
+			want: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  true,
+				ConnectionDetails: managed.ConnectionDetails{"platformId": []byte("platform-id"), "sm_url": []byte("https://sm")},
+			},
+			wantLookup: "id:platform-id",
+			wantStatus: v1alpha1.ServiceManagerPlatformObservation{ID: "platform-id", Ready: internal.Ptr(false)},
+			wantCond:   xpv1.Creating(),
+		},
+		"DescriptionChanged": {
+			cr: platform(withExternalName("platform-id"), withDescription("new")),
+			client: &servicemanager.PlatformClientFake{Platform: smPlatform(true, func(p *smapi.PlatformResponseObject) {
+				p.Description = internal.Ptr("old")
+				p.CreatedAt = &created
+			})},
+			want: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{"platformId": []byte("platform-id"), "sm_url": []byte("https://sm")},
+			},
+			wantLookup: "id:platform-id",
+			wantStatus: v1alpha1.ServiceManagerPlatformObservation{ID: "platform-id", Ready: internal.Ptr(true), CreatedAt: &metav1.Time{Time: created}},
+			wantCond:   xpv1.Available(),
+		},
+		"LabelsChanged": {
+			cr: platform(withExternalName("platform-id"), withLabels(map[string][]string{"env": {"dev"}})),
+			client: &servicemanager.PlatformClientFake{Platform: smPlatform(true, func(p *smapi.PlatformResponseObject) {
+				p.Labels = &map[string][]string{"env": {"prod"}}
+			})},
+			want: managed.ExternalObservation{
+				ResourceExists:    true,
+				ResourceUpToDate:  false,
+				ConnectionDetails: managed.ConnectionDetails{"platformId": []byte("platform-id"), "sm_url": []byte("https://sm")},
+			},
+			wantLookup: "id:platform-id",
+			wantStatus: v1alpha1.ServiceManagerPlatformObservation{ID: "platform-id", Ready: internal.Ptr(true)},
+			wantCond:   xpv1.Available(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client, smUrl: "https://sm"}
+			got, err := e.Observe(context.TODO(), tc.cr)
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Observe() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nObserve(): -want, +got:\n", diff)
+			}
+			lookup := "id:" + tc.client.LookedUpID
+			if tc.client.LookedUpName != "" {
+				lookup = "name:" + tc.client.LookedUpName
+			}
+			if lookup != tc.wantLookup {
+				t.Errorf("Observe() looked up %v, want %v", lookup, tc.wantLookup)
+			}
+			if tc.client.RegeneratedID != tc.wantRegenerated {
+				t.Errorf("Observe() regenerated credentials of %v, want %v", tc.client.RegeneratedID, tc.wantRegenerated)
+			}
+			if diff := cmp.Diff(tc.wantStatus, tc.cr.Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\nObserve(): -want status, +got status:\n", diff)
+			}
+			if tc.wantCond.Type != "" {
+				if diff := cmp.Diff(tc.wantCond, tc.cr.GetCondition(tc.wantCond.Type), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+					t.Errorf("\n%s\nObserve(): -want condition, +got condition:\n", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	fake := &servicemanager.PlatformClientFake{Registered: &smapi.RegisteredPlatformResponseObject{
+		Id: internal.Ptr("platform-id"),
+		Credentials: &smapi.Credentials{Basic: &smapi.CredentialsBasic{
+			Username: internal.Ptr("user"),
+			Password: internal.Ptr("secret"),
+		}},
+	}}
+	cr := platform(withDescription("cluster"), withLabels(map[string][]string{"env": {"dev"}}))
+	e := external{client: fake, smUrl: "https://sm"}
+
+	got, err := e.Create(context.TODO(), cr)
+	if err != nil {
+		t.Fatalf("Create() unexpected error = %v", err)
+	}
+
+	wantPayload := &smapi.RegisterPlatformRequestPayload{
+		Name:        "k8s-platform",
+		Type:        v1alpha1.PlatformTypeKubernetes,
+		Description: internal.Ptr("cluster"),
+		Labels:      &map[string][]string{"env": {"dev"}},
+	}
+	if diff := cmp.Diff(wantPayload, fake.RegisterPayload); diff != "" {
+		t.Errorf("\n%s\nCreate(): -want payload, +got payload:\n", diff)
+	}
+	wantDetails := managed.ConnectionDetails{
+		"platformId": []byte("platform-id"),
+		"sm_url":     []byte("https://sm"),
+		"username":   []byte("user"),
+		"password":   []byte("secret"),
+	}
+	if diff := cmp.Diff(wantDetails, got.ConnectionDetails); diff != "" {
+		t.Errorf("\n%s\nCreate(): -want details, +got details:\n", diff)
+	}
+	if meta.GetExternalName(cr) != "platform-id" {
+		t.Errorf("Create() external name = %v, want platform-id", meta.GetExternalName(cr))
+	}
+}
+
+func TestCreateError(t *testing.T) {
+	e := external{client: &servicemanager.PlatformClientFake{Err: errClient}}
+	if _, err := e.Create(context.TODO(), platform()); !errors.Is(err, errClient) {
+		t.Errorf("Create() error = %v, want %v", err, errClient)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	fake := &servicemanager.PlatformClientFake{Platform: smPlatform(true, func(p *smapi.PlatformResponseObject) {
+		p.Labels = &map[string][]string{"env": {"prod"}, "subaccount_id": {"sa"}}
+	})}
+	cr := platform(withExternalName("platform-id"), withLabels(map[string][]string{"env": {"dev"}, "team": {"a"}}))
+	e := external{client: fake}
+
+	if _, err := e.Update(context.TODO(), cr); err != nil {
+		t.Fatalf("Update() unexpected error = %v", err)
+	}
+	wantPayload := &smapi.UpdatePlatformRequestPayload{
+		Name: internal.Ptr("k8s-platform"),
+		Labels: []smapi.Label{
+			{Key: internal.Ptr("env"), Op: internal.Ptr(servicemanager.LabelOpRemove)},
+			{Key: internal.Ptr("env"), Op: internal.Ptr(servicemanager.LabelOpAdd), Values: []string{"dev"}},
+			{Key: internal.Ptr("team"), Op: internal.Ptr(servicemanager.LabelOpAdd), Values: []string{"a"}},
+		},
+	}
+	if diff := cmp.Diff(wantPayload, fake.UpdatePayload); diff != "" {
+		t.Errorf("\n%s\nUpdate(): -want payload, +got payload:\n", diff)
+	}
+	if fake.UpdatedID != "platform-id" {
+		t.Errorf("Update() called with %v, want platform-id", fake.UpdatedID)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := map[string]struct {
+		cr          *v1alpha1.ServiceManagerPlatform
+		err         error
+		wantErr     bool
+		wantDeleted string
+	}{
+		"NeverRegistered": {
+			cr: platform(withExternalName("platform")),
+		},
+		"ApiError": {
+			cr:          platform(withExternalName("platform-id")),
+			err:         errClient,
+			wantErr:     true,
+			wantDeleted: "platform-id",
+		},
+		"Success": {
+			cr:          platform(withExternalName("platform-id")),
+			wantDeleted: "platform-id",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake := &servicemanager.PlatformClientFake{Err: tc.err}
+			e := external{client: fake}
+			err := e.Delete(context.TODO(), tc.cr)
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if fake.DeletedID != tc.wantDeleted {
+				t.Errorf("Delete() called with %q, want %q", fake.DeletedID, tc.wantDeleted)
+			}
+			if diff := cmp.Diff(xpv1.Deleting(), tc.cr.GetCondition(xpv1.TypeReady), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\nDelete(): -want condition, +got condition:\n", diff)
+			}
+		})
+	}
+}
+
+func TestLabelChanges(t *testing.T) {
+	tests := map[string]struct {
+		desired map[string][]string
+		actual  map[string][]string
+		want    []smapi.Label
+	}{
+		"Unmanaged": {
+			actual: map[string][]string{"subaccount_id": {"sa"}},
+			want:   []smapi.Label{},
+		},
+		"EqualIgnoringOrder": {
+			desired: map[string][]string{"env": {"dev", "test"}},
+			actual:  map[string][]string{"env": {"test", "dev"}},
+			want:    []smapi.Label{},
+		},
+		"Added": {
+			desired: map[string][]string{"env": {"dev"}},
+			want:    []smapi.Label{{Key: internal.Ptr("env"), Op: internal.Ptr(servicemanager.LabelOpAdd), Values: []string{"dev"}}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, labelChanges(tc.desired, tc.actual)); diff != "" {
+				t.Errorf("\n%s\nlabelChanges(): -want, +got:\n", diff)
+			}
+		})
+	}
+}
+
+func platform(opts ...func(*v1alpha1.ServiceManagerPlatform)) *v1alpha1.ServiceManagerPlatform {
+	cr := &v1alpha1.ServiceManagerPlatform{}
+	cr.SetName("platform")
+	cr.Spec.ForProvider.Name = "k8s-platform"
+	cr.Spec.ForProvider.Type = v1alpha1.PlatformTypeKubernetes
+	for _, opt := range opts {
+		opt(cr)
+	}
+	return cr
+}
+
+func withExternalName(name string) func(*v1alpha1.ServiceManagerPlatform) {
+	return func(cr *v1alpha1.ServiceManagerPlatform) {
+		meta.SetExternalName(cr, name)
+	}
+}
+
+func withDescription(description string) func(*v1alpha1.ServiceManagerPlatform) {
+	return func(cr *v1alpha1.ServiceManagerPlatform) {
+		cr.Spec.ForProvider.Description = &description
+	}
+}
+
+func withLabels(labels map[string][]string) func(*v1alpha1.ServiceManagerPlatform) {
+	return func(cr *v1alpha1.ServiceManagerPlatform) {
+		cr.Spec.ForProvider.Labels = labels
+	}
+}
+
+func smPlatform(ready bool, opts ...func(*smapi.PlatformResponseObject)) *smapi.PlatformResponseObject {
+	p := &smapi.PlatformResponseObject{
+		Id:    internal.Ptr("platform-id"),
+		Name:  internal.Ptr("k8s-platform"),
+		Type:  internal.Ptr(v1alpha1.PlatformTypeKubernetes),
+		Ready: internal.Ptr(ready),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
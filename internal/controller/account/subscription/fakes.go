@@ -11,6 +11,7 @@ type MockApiHandler struct {
 	deleteCounter      int
 	returnExternalName string
 	returnGet          *subscription.SubscriptionGet
+	returnMetadata     *subscription.ApplicationMetadata
 	returnErr          error
 }
 
@@ -31,12 +32,17 @@ func (m *MockApiHandler) GetSubscription(ctx context.Context, externalName strin
 	return m.returnGet, m.returnErr
 }
 
+func (m *MockApiHandler) GetApplicationMetadata(ctx context.Context, appName string, planName string) (*subscription.ApplicationMetadata, error) {
+	return m.returnMetadata, m.returnErr
+}
+
 var _ subscription.SubscriptionApiHandlerI = &MockApiHandler{}
 
 type MockTypeMapper struct {
-	synced    bool
-	available bool
-	deletable bool
+	synced           bool
+	available        bool
+	deletable        bool
+	validationIssues []string
 }
 
 func (m *MockTypeMapper) IsAvailable(cr *v1alpha1.Subscription) bool {
@@ -49,6 +55,7 @@ func (m *MockTypeMapper) IsDeletable(cr *v1alpha1.Subscription) bool {
 
 func (m *MockTypeMapper) SyncStatus(get *subscription.SubscriptionGet, crStatus *v1alpha1.SubscriptionObservation) {
 	crStatus.State = get.State
+	crStatus.SubscriptionUrl = get.SubscriptionUrl
 }
 
 func (m *MockTypeMapper) ConvertToCreatePayload(cr *v1alpha1.Subscription) subscription.SubscriptionPost {
@@ -63,4 +70,8 @@ func (m *MockTypeMapper) IsUpToDate(cr *v1alpha1.Subscription, get *subscription
 	return m.synced
 }
 
+func (m *MockTypeMapper) Validate(cr *v1alpha1.Subscription, metadata *subscription.ApplicationMetadata) []string {
+	return m.validationIssues
+}
+
 var _ subscription.SubscriptionTypeMapperI = &MockTypeMapper{}
@@ -29,6 +29,11 @@ const (
 	errExtractSecretKey     = "no Cloud Management Secret Found"
 	errGetCredentialsSecret = "could not get secret of local cloud management"
 	errCredentialsCorrupted = "secret credentials data not in the expected format"
+
+	errGetAppMetadata = "cannot get application metadata"
+	errInvalidSpec    = "subscription does not match application metadata, see SoftValidation condition"
+
+	connectionDetailUrl = "url"
 )
 
 var failureStates = []string{
@@ -141,6 +146,13 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err
 	}
 	if apiRes == nil {
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		// validate before subscribing, errors would otherwise only surface after a failed async subscription
+		if err := c.validate(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
@@ -172,7 +184,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  c.isUpToDate(apiRes, cr),
-		ConnectionDetails: managed.ConnectionDetails{},
+		ConnectionDetails: connectionDetails(cr),
 	}, nil
 }
 
@@ -241,6 +253,25 @@ func (c *external) loadSubscription(ctx context.Context, cr *v1alpha1.Subscripti
 	return c.apiHandler.GetSubscription(ctx, meta.GetExternalName(cr))
 }
 
+// validate checks appName, planName and parameters against the application metadata, the result is reflected as condition
+func (c *external) validate(ctx context.Context, cr *v1alpha1.Subscription) error {
+	metadata, err := c.apiHandler.GetApplicationMetadata(ctx, cr.Spec.ForProvider.AppName, cr.Spec.ForProvider.PlanName)
+	if err != nil {
+		return errors.Wrap(err, errGetAppMetadata)
+	}
+
+	issues := c.typeMapper.Validate(cr, metadata)
+	cr.SetConditions(v1alpha1.ValidationCondition(issues))
+	if issues != nil {
+		return errors.New(errInvalidSpec)
+	}
+
+	if metadata != nil {
+		cr.Status.AtProvider.Dependencies = metadata.Dependencies
+	}
+	return nil
+}
+
 // syncStatus delegates saving the observation based on external resource to the typemapper
 func (c *external) syncStatus(apiRes *subscription.SubscriptionGet, cr *v1alpha1.Subscription) {
 	c.typeMapper.SyncStatus(apiRes, &cr.Status.AtProvider)
@@ -251,6 +282,15 @@ func (c *external) isUpToDate(apiRes *subscription.SubscriptionGet, cr *v1alpha1
 	return c.typeMapper.IsUpToDate(cr, apiRes)
 }
 
+// connectionDetails publishes the application URL of the subscribed tenant once it is known
+func connectionDetails(cr *v1alpha1.Subscription) managed.ConnectionDetails {
+	details := managed.ConnectionDetails{}
+	if url := cr.Status.AtProvider.SubscriptionUrl; url != nil && *url != "" {
+		details[connectionDetailUrl] = []byte(*url)
+	}
+	return details
+}
+
 // shouldRecreateOnFailure determines if a subscription should be recreated
 // when it is in a failed state. This is the case if the spec.RecreateOnSubscriptionFailure
 // is set and the current state is SubscriptionStateSubscribeFailed.
@@ -12,11 +12,13 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
 	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 	"github.com/sap/crossplane-provider-btp/internal"
 	"github.com/sap/crossplane-provider-btp/internal/clients/subscription"
+	saas_client "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-saas-provisioning-api-go/pkg"
 	"github.com/sap/crossplane-provider-btp/internal/testutils"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 	tracking_test "github.com/sap/crossplane-provider-btp/internal/tracking/test"
@@ -54,10 +56,47 @@ func TestObserve(t *testing.T) {
 			reason: "When externalName isn't in expected format, it has never been created",
 			args: args{
 				cr: NewSubscription("dir-unittests", WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("dir-unittests")),
+				mockApiHandler: &MockApiHandler{
+					returnMetadata: &subscription.ApplicationMetadata{Dependencies: []string{"dependent-app"}},
+				},
+				mockTypeMapper: &MockTypeMapper{},
 			},
 			want: want{
-				o:  managed.ExternalObservation{ResourceExists: false},
+				o: managed.ExternalObservation{ResourceExists: false},
+				cr: NewSubscription("dir-unittests", WithConditions(v1alpha1.ValidationOk()), WithStatus(v1alpha1.SubscriptionObservation{
+					Dependencies: []string{"dependent-app"},
+				}), WithExternalName("dir-unittests")),
+			},
+		},
+		"MetadataError": {
+			reason: "When the application metadata can't be loaded we can't validate and must not subscribe",
+			args: args{
 				cr: NewSubscription("dir-unittests", WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("dir-unittests")),
+				mockApiHandler: &MockApiHandler{
+					returnErr: errors.New("internalServerError"),
+				},
+				mockTypeMapper: &MockTypeMapper{},
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.Wrap(errors.New("internalServerError"), errGetAppMetadata),
+				cr:  NewSubscription("dir-unittests", WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("dir-unittests")),
+			},
+		},
+		"InvalidSpec": {
+			reason: "Validation issues must be reported as condition and block the subscription",
+			args: args{
+				cr: NewSubscription("dir-unittests", WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("dir-unittests")),
+				mockApiHandler: &MockApiHandler{
+					returnMetadata: &subscription.ApplicationMetadata{},
+				},
+				mockTypeMapper: &MockTypeMapper{validationIssues: []string{"parameters.tenantAdmin in body is required"}},
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				err: errors.New(errInvalidSpec),
+				cr: NewSubscription("dir-unittests", WithConditions(v1alpha1.ValidationError("parameters.tenantAdmin in body is required")),
+					WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("dir-unittests")),
 			},
 		},
 		"APIErrorOnRead": {
@@ -80,13 +119,15 @@ func TestObserve(t *testing.T) {
 			args: args{
 				cr: NewSubscription("dir-unittests", WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("name1/plan2")),
 				mockApiHandler: &MockApiHandler{
-					returnGet: nil,
-					returnErr: nil,
+					returnGet:      nil,
+					returnMetadata: &subscription.ApplicationMetadata{},
+					returnErr:      nil,
 				},
+				mockTypeMapper: &MockTypeMapper{},
 			},
 			want: want{
 				o:  managed.ExternalObservation{ResourceExists: false},
-				cr: NewSubscription("dir-unittests", WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("name1/plan2")),
+				cr: NewSubscription("dir-unittests", WithConditions(v1alpha1.ValidationOk()), WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("name1/plan2")),
 			},
 		},
 		"RequiresUpdate": {
@@ -94,9 +135,9 @@ func TestObserve(t *testing.T) {
 			args: args{
 				cr: NewSubscription("dir-unittests", WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("name1/plan2")),
 				mockApiHandler: &MockApiHandler{
-					returnGet: &subscription.SubscriptionGet{
+					returnGet: &subscription.SubscriptionGet{EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{
 						State: internal.Ptr("SUBSCRIBED"),
-					},
+					}},
 					returnErr: nil,
 				},
 				mockTypeMapper: &MockTypeMapper{
@@ -120,9 +161,9 @@ func TestObserve(t *testing.T) {
 			args: args{
 				cr: NewSubscription("dir-unittests", WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("name1/plan2")),
 				mockApiHandler: &MockApiHandler{
-					returnGet: &subscription.SubscriptionGet{
+					returnGet: &subscription.SubscriptionGet{EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{
 						State: internal.Ptr("SUBSCRIBED"),
-					},
+					}},
 					returnErr: nil,
 				},
 				mockTypeMapper: &MockTypeMapper{
@@ -146,9 +187,9 @@ func TestObserve(t *testing.T) {
 			args: args{
 				cr: NewSubscription("dir-unittests", WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("name1/plan2")),
 				mockApiHandler: &MockApiHandler{
-					returnGet: &subscription.SubscriptionGet{
+					returnGet: &subscription.SubscriptionGet{EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{
 						State: internal.Ptr("SUBSCRIBED"),
-					},
+					}},
 					returnErr: nil,
 				},
 				mockTypeMapper: &MockTypeMapper{
@@ -167,6 +208,35 @@ func TestObserve(t *testing.T) {
 				}), WithExternalName("name1/plan2")),
 			},
 		},
+		"PublishesUrl": {
+			reason: "The application url of the subscribed tenant is published as connection detail",
+			args: args{
+				cr: NewSubscription("dir-unittests", WithStatus(v1alpha1.SubscriptionObservation{}), WithExternalName("name1/plan2")),
+				mockApiHandler: &MockApiHandler{
+					returnGet: &subscription.SubscriptionGet{EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{
+						State:           internal.Ptr("SUBSCRIBED"),
+						SubscriptionUrl: internal.Ptr("https://tenant.example.com"),
+					}},
+				},
+				mockTypeMapper: &MockTypeMapper{
+					synced:    true,
+					available: true,
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						"url": []byte("https://tenant.example.com"),
+					},
+				},
+				cr: NewSubscription("dir-unittests", WithConditions(xpv1.Available()), WithStatus(v1alpha1.SubscriptionObservation{
+					State:           internal.Ptr("SUBSCRIBED"),
+					SubscriptionUrl: internal.Ptr("https://tenant.example.com"),
+				}), WithExternalName("name1/plan2")),
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -183,7 +253,7 @@ func TestObserve(t *testing.T) {
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want cr, +got cr:\n%s\n", tc.reason, diff)
 			}
 
@@ -275,14 +345,14 @@ func TestRecreateOnFailed(t *testing.T) {
 	mockKube := testutils.NewFakeKubeClientBuilder().Build()
 	extName := "test-ext-name"
 	ctrl := external{
-		tracker:    nil,
-		kube:       &mockKube,
+		tracker: nil,
+		kube:    &mockKube,
 		apiHandler: &MockApiHandler{
-			deleteCounter: 0,
+			deleteCounter:      0,
 			returnExternalName: extName,
-			returnGet: &subscription.SubscriptionGet{
+			returnGet: &subscription.SubscriptionGet{EntitledApplicationsResponseObject: saas_client.EntitledApplicationsResponseObject{
 				State: ptr.To(v1alpha1.SubscriptionStateSubscribeFailed),
-			},
+			}},
 		},
 		typeMapper: &MockTypeMapper{
 			synced:    true,
@@ -311,7 +381,6 @@ func TestRecreateOnFailed(t *testing.T) {
 		ResourceExists:    true,
 		ResourceUpToDate:  true,
 		ConnectionDetails: managed.ConnectionDetails{},
-
 	}, got); diff != "" {
 		t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", "initial observation", diff)
 	}
@@ -341,20 +410,19 @@ func TestRecreateOnFailed(t *testing.T) {
 		ResourceExists:    true,
 		ResourceUpToDate:  true,
 		ConnectionDetails: managed.ConnectionDetails{},
-
 	}, got); diff != "" {
 		t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", "initial observation", diff)
 	}
 
 	// The external resource is deleted
 	ctrl.typeMapper = &MockTypeMapper{
-			synced:    false,
-			available: false,
-			deletable: false,
+		synced:    false,
+		available: false,
+		deletable: false,
 	}
 	// The API does not return SUBSCRIBE_FAILED anymore
 	ctrl.apiHandler = &MockApiHandler{
-		deleteCounter: 0,
+		deleteCounter:      0,
 		returnExternalName: extName,
 		// returnGet: &subscription.SubscriptionGet{
 		// 	State: ptr.To(v1alpha1.SubscriptionStateSubscribeFailed),
@@ -374,8 +442,7 @@ func TestRecreateOnFailed(t *testing.T) {
 
 	// The resource shall be created
 	if diff := cmp.Diff(managed.ExternalObservation{
-		ResourceExists:    false,
-
+		ResourceExists: false,
 	}, got); diff != "" {
 		t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", "initial observation", diff)
 	}
@@ -620,9 +687,9 @@ func TestConnect(t *testing.T) {
 				AddResources(tc.args.kubeObjects...).
 				Build()
 			c := connector{
-				kube:         &kube,
-				usage:        tracking_test.NoOpReferenceResolverTracker{},
-				newServiceFn: newSubscriptionClientFn,
+				kube:            &kube,
+				usage:           tracking_test.NoOpReferenceResolverTracker{},
+				newServiceFn:    newSubscriptionClientFn,
 				resourcetracker: tracking.NewDefaultReferenceResolverTracker(&kube),
 			}
 
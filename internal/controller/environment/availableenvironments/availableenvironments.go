@@ -0,0 +1,75 @@
+package availableenvironments
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	availableenvs "github.com/sap/crossplane-provider-btp/internal/clients/availableenvironments"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errNotAvailableEnvironments = "managed resource is not an AvailableEnvironments custom resource"
+	errListEnvironments         = "Could not list available environments"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube            client.Client
+	usage           resource.Tracker
+	resourcetracker tracking.ReferenceResolverTracker
+
+	newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)
+}
+
+// An external only observes the environments available to the subaccount, there is nothing to create, update or
+// delete.
+type external struct {
+	client availableenvs.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AvailableEnvironments)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotAvailableEnvironments)
+	}
+
+	// the listing is read-only, it is gone as soon as the managed resource is deleted
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	available, err := c.client.GetAvailableEnvironments(ctx)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListEnvironments)
+	}
+
+	cr.Status.AtProvider = availableenvs.GenerateObservation(available, cr.Spec.ForProvider.EnvironmentType)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	return nil
+}
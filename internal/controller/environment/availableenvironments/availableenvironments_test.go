@@ -0,0 +1,105 @@
+package availableenvironments
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+)
+
+type mockClient struct {
+	available []provisioningclient.AvailableEnvironmentResponseObject
+	err       error
+}
+
+func (m mockClient) GetAvailableEnvironments(ctx context.Context) ([]provisioningclient.AvailableEnvironmentResponseObject, error) {
+	return m.available, m.err
+}
+
+func TestObserve(t *testing.T) {
+	deleted := metav1.Now()
+
+	type want struct {
+		o   managed.ExternalObservation
+		cr  *v1alpha1.AvailableEnvironments
+		err error
+	}
+
+	cases := map[string]struct {
+		client mockClient
+		cr     *v1alpha1.AvailableEnvironments
+		want   want
+	}{
+		"ListFailed": {
+			client: mockClient{err: errors.New("boom")},
+			cr:     availableEnvironments(),
+			want: want{
+				cr:  availableEnvironments(),
+				err: errors.Wrap(errors.New("boom"), errListEnvironments),
+			},
+		},
+		"Deleted": {
+			cr: availableEnvironments(withDeletionTimestamp(deleted)),
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: false},
+				cr: availableEnvironments(withDeletionTimestamp(deleted)),
+			},
+		},
+		"Listed": {
+			client: mockClient{available: []provisioningclient.AvailableEnvironmentResponseObject{
+				{EnvironmentType: internal.Ptr("kyma"), ServiceName: internal.Ptr("kymaruntime"), PlanName: internal.Ptr("azure")},
+				{EnvironmentType: internal.Ptr("cloudfoundry"), ServiceName: internal.Ptr("cloudfoundry"), PlanName: internal.Ptr("standard")},
+			}},
+			cr: availableEnvironments(func(cr *v1alpha1.AvailableEnvironments) {
+				cr.Spec.ForProvider.EnvironmentType = "kyma"
+			}),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cr: availableEnvironments(func(cr *v1alpha1.AvailableEnvironments) {
+					cr.Spec.ForProvider.EnvironmentType = "kyma"
+					cr.Status.AtProvider.Environments = []v1alpha1.AvailableEnvironment{
+						{EnvironmentType: "kyma", ServiceName: "kymaruntime", PlanName: "azure"},
+					}
+					cr.Status.SetConditions(xpv1.Available())
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func availableEnvironments(m ...func(*v1alpha1.AvailableEnvironments)) *v1alpha1.AvailableEnvironments {
+	cr := &v1alpha1.AvailableEnvironments{ObjectMeta: metav1.ObjectMeta{Name: "subaccount-environments"}}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withDeletionTimestamp(t metav1.Time) func(*v1alpha1.AvailableEnvironments) {
+	return func(cr *v1alpha1.AvailableEnvironments) { cr.SetDeletionTimestamp(&t) }
+}
@@ -2,6 +2,8 @@ package cloudfoundry
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -15,6 +17,7 @@ import (
 
 	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
 	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
 	env "github.com/sap/crossplane-provider-btp/internal/clients/cfenvironment"
 	"github.com/sap/crossplane-provider-btp/internal/tracking"
 
@@ -26,10 +29,11 @@ const (
 	errExtractSecretKey        = "no Cloud Management Secret Found"
 	errGetCredentialsSecret    = "could not get secret of local cloud management"
 	errSecretDataInvalid       = "secret spec.Data.__raw is invalid"
-	errUpdateNotSupported      = "update not supported"
 	errTrackRUsage             = "cannot track ResourceUsage"
 	errTrackPCUsage            = "cannot track ProviderConfig usage"
 	errCreateConnectionDetails = "Cannot create connection details"
+	errCheckAvailability       = "Could not check if the Cloud Foundry plan is available in the subaccount"
+	errImmutableFieldsChanged  = "%s changed, but can't be updated on an existing environment"
 
 	errGetPC    = "cannot get ProviderConfig"
 	errGetCreds = "cannot get credentials"
@@ -133,11 +137,27 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}, nil
 	}
 
+	immutableChanges := env.ImmutableFieldChanges(*cr)
+	if len(immutableChanges) > 0 {
+		cr.Status.SetConditions(v1alpha1.EnvironmentUpdateRejected(fmt.Sprintf(errImmutableFieldsChanged, strings.Join(immutableChanges, ", "))))
+	} else if cr.GetCondition(v1alpha1.EnvironmentUpdateCondition).Reason == v1alpha1.ImmutableFieldChanged {
+		cr.Status.SetConditions(v1alpha1.EnvironmentUpdateAccepted())
+	}
+
+	// a renamed org is only found by its new name, so the external name follows once the rename is applied
+	renamed := false
+	if orgName := cr.Spec.ForProvider.OrgName; orgName != "" && internal.Val(env.ExternalName(instance)) == orgName &&
+		meta.GetExternalName(cr) != "" && meta.GetExternalName(cr) != orgName {
+		meta.SetExternalName(cr, orgName)
+		renamed = true
+	}
+
 	details, err := env.GetConnectionDetails(instance)
 	return managed.ExternalObservation{
-		ResourceExists:    true,
-		ResourceUpToDate:  true,
-		ConnectionDetails: details,
+		ResourceExists:          true,
+		ResourceUpToDate:        !c.client.NeedsUpdate(*cr) && len(immutableChanges) == 0,
+		ResourceLateInitialized: renamed,
+		ConnectionDetails:       details,
 	}, errors.Wrap(err, errCreateConnectionDetails)
 }
 
@@ -147,6 +167,16 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotEnvironment)
 	}
 
+	unavailable, err := c.client.CheckAvailability(ctx, *cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCheckAvailability)
+	}
+	if unavailable != "" {
+		cr.Status.SetConditions(v1alpha1.EnvironmentPlanUnavailable(unavailable))
+		return managed.ExternalCreation{}, errors.New(unavailable)
+	}
+	cr.Status.SetConditions(v1alpha1.EnvironmentPlanAvailable())
+
 	createdOrgName, err := c.client.CreateInstance(ctx, *cr)
 	if err != nil {
 		return managed.ExternalCreation{}, err
@@ -161,13 +191,13 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 }
 
 func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
-	_, ok := mg.(*v1alpha1.CloudFoundryEnvironment)
+	cr, ok := mg.(*v1alpha1.CloudFoundryEnvironment)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotEnvironment)
 	}
 
-	// Update is not supported
-	return managed.ExternalUpdate{}, nil
+	// changes of immutable fields are reported as error after all other changes are applied
+	return managed.ExternalUpdate{}, c.client.UpdateInstance(ctx, *cr)
 }
 
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -162,6 +162,132 @@ func TestObserve(t *testing.T) {
 					)),
 			},
 		},
+		"ManagersOutOfSync": {
+			args: args{
+				client: fake.MockClient{MockDescribeCluster: func(cr v1alpha1.CloudFoundryEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, []v1alpha1.User, error) {
+					return &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+						State:  internal.Ptr("OK"),
+						Labels: internal.Ptr("{}"),
+					}, []v1alpha1.User{aUser}, nil
+				}, MockNeedsUpdate: func(cr v1alpha1.CloudFoundryEnvironment) bool {
+					return true
+				}},
+				cr: environment(withUID("1234"),
+					withData(v1alpha1.CfEnvironmentParameters{OrgManagersPolicy: v1alpha1.OrgManagersPolicyAuthoritative})),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"__raw": []byte("{}")},
+				},
+				err: nil,
+				cr: environment(withUID("1234"), withConditions(xpv1.Available()),
+					withData(v1alpha1.CfEnvironmentParameters{OrgManagersPolicy: v1alpha1.OrgManagersPolicyAuthoritative}),
+					withStatus(v1alpha1.CfEnvironmentObservation{
+						EnvironmentObservation: v1alpha1.EnvironmentObservation{
+							State:  internal.Ptr("OK"),
+							Labels: internal.Ptr("{}"),
+						},
+						Managers: []v1alpha1.User{aUser},
+					},
+					)),
+			},
+		},
+		"ImmutableFieldChanged": {
+			args: args{
+				client: fake.MockClient{MockDescribeCluster: func(cr v1alpha1.CloudFoundryEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, []v1alpha1.User, error) {
+					return &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+						State:          internal.Ptr("OK"),
+						Labels:         internal.Ptr("{}"),
+						LandscapeLabel: internal.Ptr("cf-eu10"),
+					}, nil, nil
+				}, MockNeedsUpdate: func(cr v1alpha1.CloudFoundryEnvironment) bool {
+					return false
+				}},
+				cr: environment(withData(v1alpha1.CfEnvironmentParameters{Landscape: "cf-us10"})),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{"__raw": []byte("{}")},
+				},
+				err: nil,
+				cr: environment(withConditions(xpv1.Available(), v1alpha1.EnvironmentUpdateRejected("landscape changed, but can't be updated on an existing environment")),
+					withData(v1alpha1.CfEnvironmentParameters{Landscape: "cf-us10"}),
+					withStatus(v1alpha1.CfEnvironmentObservation{
+						EnvironmentObservation: v1alpha1.EnvironmentObservation{
+							State:          internal.Ptr("OK"),
+							Labels:         internal.Ptr("{}"),
+							LandscapeLabel: internal.Ptr("cf-eu10"),
+						},
+					})),
+			},
+		},
+		"ImmutableFieldReverted": {
+			args: args{
+				client: fake.MockClient{MockDescribeCluster: func(cr v1alpha1.CloudFoundryEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, []v1alpha1.User, error) {
+					return &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+						State:          internal.Ptr("OK"),
+						Labels:         internal.Ptr("{}"),
+						LandscapeLabel: internal.Ptr("cf-eu10"),
+					}, nil, nil
+				}, MockNeedsUpdate: func(cr v1alpha1.CloudFoundryEnvironment) bool {
+					return false
+				}},
+				cr: environment(withConditions(v1alpha1.EnvironmentUpdateRejected("landscape changed")),
+					withData(v1alpha1.CfEnvironmentParameters{Landscape: "cf-eu10"})),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"__raw": []byte("{}")},
+				},
+				err: nil,
+				cr: environment(withConditions(xpv1.Available(), v1alpha1.EnvironmentUpdateAccepted()),
+					withData(v1alpha1.CfEnvironmentParameters{Landscape: "cf-eu10"}),
+					withStatus(v1alpha1.CfEnvironmentObservation{
+						EnvironmentObservation: v1alpha1.EnvironmentObservation{
+							State:          internal.Ptr("OK"),
+							Labels:         internal.Ptr("{}"),
+							LandscapeLabel: internal.Ptr("cf-eu10"),
+						},
+					})),
+			},
+		},
+		"OrgRenamed": {
+			args: args{
+				client: fake.MockClient{MockDescribeCluster: func(cr v1alpha1.CloudFoundryEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, []v1alpha1.User, error) {
+					return &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+						State:  internal.Ptr("OK"),
+						Labels: internal.Ptr("{\"Org Name\":\"new-org\"}"),
+					}, nil, nil
+				}, MockNeedsUpdate: func(cr v1alpha1.CloudFoundryEnvironment) bool {
+					return false
+				}},
+				cr: environment(withAnnotaions(map[string]string{"crossplane.io/external-name": "old-org"}),
+					withData(v1alpha1.CfEnvironmentParameters{OrgName: "new-org"})),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       managed.ConnectionDetails{"__raw": []byte("{\"Org Name\":\"new-org\"}"), "orgName": []byte("new-org")},
+				},
+				err: nil,
+				cr: environment(withAnnotaions(map[string]string{"crossplane.io/external-name": "new-org"}), withConditions(xpv1.Available()),
+					withData(v1alpha1.CfEnvironmentParameters{OrgName: "new-org"}),
+					withStatus(v1alpha1.CfEnvironmentObservation{
+						EnvironmentObservation: v1alpha1.EnvironmentObservation{
+							State:  internal.Ptr("OK"),
+							Labels: internal.Ptr("{\"Org Name\":\"new-org\"}"),
+						},
+					})),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -216,7 +342,20 @@ func TestCreate(t *testing.T) {
 			want: want{
 				o:   managed.ExternalCreation{},
 				err: errors.New("Could not call backend"),
-				cr:  environment(),
+				cr:  environment(withConditions(v1alpha1.EnvironmentPlanAvailable())),
+			},
+		},
+		"PlanNotAvailable": {
+			args: args{
+				client: fake.MockClient{MockAvailability: func(cr v1alpha1.CloudFoundryEnvironment) (string, error) {
+					return "plan standard of environment type cloudfoundry is not available on landscape cf-us10, available landscapes: cf-eu10", nil
+				}},
+				cr: environment(),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.New("plan standard of environment type cloudfoundry is not available on landscape cf-us10, available landscapes: cf-eu10"),
+				cr:  environment(withConditions(v1alpha1.EnvironmentPlanUnavailable("plan standard of environment type cloudfoundry is not available on landscape cf-us10, available landscapes: cf-eu10"))),
 			},
 		},
 		"Successful": {
@@ -233,7 +372,8 @@ func TestCreate(t *testing.T) {
 				cr:  environment(withData(v1alpha1.CfEnvironmentParameters{OrgName: "test-org", EnvironmentName: "test-env"}),
 								withAnnotaions(map[string]string{
 									"crossplane.io/external-name": "test-org",
-								}),),
+								}),
+								withConditions(v1alpha1.EnvironmentPlanAvailable())),
 			},
 		},
 	}
@@ -254,6 +394,72 @@ func TestCreate(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	type args struct {
+		cr     resource.Managed
+		client environments.Client
+	}
+
+	type want struct {
+		o   managed.ExternalUpdate
+		err error
+	}
+
+	var cases = map[string]struct {
+		args args
+		want want
+	}{
+		"NilManaged": {
+			args: args{
+				client: fake.MockClient{},
+				cr:     nil,
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.New(errNotEnvironment),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				client: fake.MockClient{MockUpdate: func(cr v1alpha1.CloudFoundryEnvironment) error {
+					return errors.New("Could not call backend")
+				}},
+				cr: environment(),
+			},
+			want: want{
+				o:   managed.ExternalUpdate{},
+				err: errors.New("Could not call backend"),
+			},
+		},
+		"Successful": {
+			args: args{
+				client: fake.MockClient{MockUpdate: func(cr v1alpha1.CloudFoundryEnvironment) error {
+					return nil
+				}},
+				cr: environment(withData(v1alpha1.CfEnvironmentParameters{
+					OrgManagersPolicy: v1alpha1.OrgManagersPolicyAuthoritative,
+					OrgManagers:       []v1alpha1.User{aUser},
+				})),
+			},
+			want: want{
+				o: managed.ExternalUpdate{},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.args.client, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}}
+			got, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Update(...): -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestDelete(t *testing.T) {
 	type args struct {
 		cr     resource.Managed
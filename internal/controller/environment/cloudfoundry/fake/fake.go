@@ -13,6 +13,7 @@ type MockClient struct {
 	MockCreate          func(cr v1alpha1.CloudFoundryEnvironment) (string, error)
 	MockDelete          func(cr v1alpha1.CloudFoundryEnvironment) error
 	MockUpdate          func(cr v1alpha1.CloudFoundryEnvironment) error
+	MockAvailability    func(cr v1alpha1.CloudFoundryEnvironment) (string, error)
 
 	MockNeedsUpdate func(cr v1alpha1.CloudFoundryEnvironment) bool
 }
@@ -37,4 +38,11 @@ func (m MockClient) DeleteInstance(ctx context.Context, cr v1alpha1.CloudFoundry
 	return m.MockDelete(cr)
 }
 
+func (m MockClient) CheckAvailability(ctx context.Context, cr v1alpha1.CloudFoundryEnvironment) (string, error) {
+	if m.MockAvailability == nil {
+		return "", nil
+	}
+	return m.MockAvailability(cr)
+}
+
 var _ environments.Client = &MockClient{}
@@ -0,0 +1,188 @@
+package environmentinstance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal"
+	envinstance "github.com/sap/crossplane-provider-btp/internal/clients/environmentinstance"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errNotEnvironmentInstance = "managed resource is not an EnvironmentInstance custom resource"
+	errCantDescribe           = "Could not describe environment instance"
+	errCheckUpdate            = "Could not check for needsUpdate"
+	errConnectionDetails      = "Could not read connection details from the labels of the environment instance"
+	errUpdateLabels           = "Could not update labels of environment instance"
+	errOperationFailed        = "environment instance is in state %s: %s"
+)
+
+// A connector is expected to produce an ExternalClient when its Connect method
+// is called.
+type connector struct {
+	kube            client.Client
+	usage           resource.Tracker
+	resourcetracker tracking.ReferenceResolverTracker
+
+	newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)
+	log          logr.Logger
+}
+
+// An ExternalClient observes, then either creates, updates, or deletes an
+// external resource to ensure it reflects the managed resource's desired state.
+type external struct {
+	client  envinstance.Client
+	tracker tracking.ReferenceResolverTracker
+	kube    client.Client
+	log     logr.Logger
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.EnvironmentInstance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEnvironmentInstance)
+	}
+
+	instance, err := c.client.DescribeInstance(ctx, *cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCantDescribe)
+	}
+	if instance == nil {
+		cr.Status.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider = envinstance.GenerateObservation(instance)
+
+	state := ""
+	if cr.Status.AtProvider.State != nil {
+		state = *cr.Status.AtProvider.State
+	}
+	switch state {
+	case v1alpha1.InstanceStateOk, v1alpha1.InstanceStateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.InstanceStateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.InstanceStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	case v1alpha1.InstanceStateCreationFailed, v1alpha1.InstanceStateUpdateFailed, v1alpha1.InstanceStateDeletionFailed:
+		// the broker explains the failure in the state message, it is the only hint for the user what went wrong
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf(errOperationFailed, state, internal.Val(cr.Status.AtProvider.StateMessage))))
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	// changes can only be applied once the broker finished the last operation
+	if state != v1alpha1.InstanceStateOk {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	diff, err := needsUpdate(*cr)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, errors.Wrap(err, errCheckUpdate)
+	}
+
+	details, err := envinstance.GetConnectionDetails(instance)
+	if err != nil {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, errors.Wrap(err, errConnectionDetails)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  len(diff) == 0,
+		Diff:              strings.Join(diff, ", "),
+		ConnectionDetails: details,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.EnvironmentInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEnvironmentInstance)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	id, err := c.client.CreateInstance(ctx, *cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, id)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.EnvironmentInstance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEnvironmentInstance)
+	}
+
+	if envinstance.LabelsNeedUpdate(*cr) {
+		if err := c.client.UpdateLabels(ctx, *cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLabels)
+		}
+	}
+
+	parameterDiff, err := envinstance.ParametersDiff(*cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if envinstance.PlanNeedsUpdate(*cr) || len(parameterDiff) > 0 {
+		return managed.ExternalUpdate{}, c.client.UpdateInstance(ctx, *cr)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.EnvironmentInstance)
+	if !ok {
+		return errors.New(errNotEnvironmentInstance)
+	}
+	c.tracker.SetConditions(ctx, cr)
+	if blocked := c.tracker.DeleteShouldBeBlocked(mg); blocked {
+		return errors.New(providerv1alpha1.ErrResourceInUse)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State != nil && *cr.Status.AtProvider.State == v1alpha1.InstanceStateDeleting {
+		return nil
+	}
+
+	return c.client.DeleteInstance(ctx, *cr)
+}
+
+// needsUpdate lists what differs between spec and environment instance
+func needsUpdate(cr v1alpha1.EnvironmentInstance) ([]string, error) {
+	diff, err := envinstance.ParametersDiff(cr)
+	if err != nil {
+		return nil, err
+	}
+	for i := range diff {
+		diff[i] = "parameters." + diff[i]
+	}
+	if envinstance.PlanNeedsUpdate(cr) {
+		diff = append(diff, "planName")
+	}
+	if envinstance.LabelsNeedUpdate(cr) {
+		diff = append(diff, "labels")
+	}
+	return diff, nil
+}
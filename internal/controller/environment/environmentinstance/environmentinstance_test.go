@@ -0,0 +1,348 @@
+package environmentinstance
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/controller/environment/environmentinstance/fake"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+	trackingtest "github.com/sap/crossplane-provider-btp/internal/tracking/test"
+)
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		cr       *v1alpha1.EnvironmentInstance
+		instance *provisioningclient.BusinessEnvironmentInstanceResponseObject
+		err      error
+	}
+	type want struct {
+		o   managed.ExternalObservation
+		cr  *v1alpha1.EnvironmentInstance
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"DescribeFailed": {
+			args: args{
+				cr:  instance(),
+				err: errors.New("boom"),
+			},
+			want: want{
+				o:   managed.ExternalObservation{},
+				cr:  instance(),
+				err: errors.Wrap(errors.New("boom"), errCantDescribe),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr: instance(),
+			},
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: false},
+				cr: instance(withConditions(xpv1.Unavailable())),
+			},
+		},
+		"Creating": {
+			args: args{
+				cr: instance(withParameters(`{"size_of_runtime": 1}`)),
+				instance: &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+					State: internal.Ptr(v1alpha1.InstanceStateCreating),
+				},
+			},
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cr: instance(withParameters(`{"size_of_runtime": 1}`), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreationFailed": {
+			args: args{
+				cr: instance(),
+				instance: &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+					State:        internal.Ptr(v1alpha1.InstanceStateCreationFailed),
+					StateMessage: internal.Ptr("quota exceeded"),
+				},
+			},
+			want: want{
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				cr: instance(withConditions(xpv1.Unavailable().WithMessage("environment instance is in state CREATION_FAILED: quota exceeded"))),
+			},
+		},
+		"AvailableWithConnectionDetails": {
+			args: args{
+				cr: instance(withParameters(`{"size_of_runtime": 1}`)),
+				instance: &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+					State:      internal.Ptr(v1alpha1.InstanceStateOk),
+					PlanName:   internal.Ptr("standard"),
+					Parameters: internal.Ptr(`{"size_of_runtime": 1, "is_development_allowed": true}`),
+					Labels:     internal.Ptr(`{"ABAP URL": "https://abap.example.com"}`),
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{"ABAP URL": []byte("https://abap.example.com")},
+				},
+				cr: instance(withParameters(`{"size_of_runtime": 1}`), withConditions(xpv1.Available())),
+			},
+		},
+		"ParametersChanged": {
+			args: args{
+				cr: instance(withParameters(`{"size_of_runtime": 2}`)),
+				instance: &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+					State:      internal.Ptr(v1alpha1.InstanceStateOk),
+					PlanName:   internal.Ptr("standard"),
+					Parameters: internal.Ptr(`{"size_of_runtime": 1}`),
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "parameters.size_of_runtime",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				cr: instance(withParameters(`{"size_of_runtime": 2}`), withConditions(xpv1.Available())),
+			},
+		},
+		"PlanAndLabelsChanged": {
+			args: args{
+				cr: instance(withLabels(map[string][]string{"team": {"b"}})),
+				instance: &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+					State:        internal.Ptr(v1alpha1.InstanceStateOk),
+					PlanName:     internal.Ptr("free"),
+					CustomLabels: &map[string][]string{"team": {"a"}},
+				},
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					Diff:              "planName, labels",
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				cr: instance(withLabels(map[string][]string{"team": {"b"}}), withConditions(xpv1.Available())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: fake.MockClient{
+				MockDescribeInstance: func(ctx context.Context, cr *v1alpha1.EnvironmentInstance) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
+					return tc.args.instance, tc.args.err
+				},
+			}}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Observe(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\ne.Observe(...): -want, +got:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreTypes(v1alpha1.EnvironmentInstanceObservation{})); diff != "" {
+				t.Errorf("\ne.Observe(...): -want cr, +got cr:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		createErr error
+		want      want
+	}{
+		"Success": {
+			want: want{externalName: "1234"},
+		},
+		"CreateFailed": {
+			createErr: errors.New("boom"),
+			want:      want{externalName: "abap", err: errors.New("boom")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := instance()
+			e := external{client: fake.MockClient{
+				MockCreateInstance: func(ctx context.Context, cr *v1alpha1.EnvironmentInstance) (string, error) {
+					if tc.createErr != nil {
+						return "", tc.createErr
+					}
+					return "1234", nil
+				},
+			}}
+			_, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Create(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(cr)); diff != "" {
+				t.Errorf("\ne.Create(...): -want external name, +got external name:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		labelsUpdated   bool
+		instanceUpdated bool
+		err             error
+	}
+
+	cases := map[string]struct {
+		cr   *v1alpha1.EnvironmentInstance
+		want want
+	}{
+		"LabelsOnly": {
+			cr: instance(
+				withLabels(map[string][]string{"team": {"a"}}),
+				withObservation(v1alpha1.EnvironmentObservation{PlanName: internal.Ptr("standard")}),
+			),
+			want: want{labelsUpdated: true},
+		},
+		"PlanChanged": {
+			cr:   instance(withObservation(v1alpha1.EnvironmentObservation{PlanName: internal.Ptr("free")})),
+			want: want{instanceUpdated: true},
+		},
+		"ParametersChanged": {
+			cr: instance(
+				withParameters(`{"size_of_runtime": 2}`),
+				withObservation(v1alpha1.EnvironmentObservation{PlanName: internal.Ptr("standard"), Parameters: internal.Ptr(`{"size_of_runtime": 1}`)}),
+			),
+			want: want{instanceUpdated: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var labelsUpdated, instanceUpdated bool
+			e := external{client: fake.MockClient{
+				MockUpdateLabels: func(ctx context.Context, cr *v1alpha1.EnvironmentInstance) error {
+					labelsUpdated = true
+					return nil
+				},
+				MockUpdateInstance: func(ctx context.Context, cr *v1alpha1.EnvironmentInstance) error {
+					instanceUpdated = true
+					return nil
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if labelsUpdated != tc.want.labelsUpdated {
+				t.Errorf("\ne.Update(...): labels updated: want %v, got %v\n", tc.want.labelsUpdated, labelsUpdated)
+			}
+			if instanceUpdated != tc.want.instanceUpdated {
+				t.Errorf("\ne.Update(...): instance updated: want %v, got %v\n", tc.want.instanceUpdated, instanceUpdated)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		cr      *v1alpha1.EnvironmentInstance
+		blocked bool
+
+		wantDeleted bool
+		wantErr     error
+	}{
+		"Blocked": {
+			cr:      instance(),
+			blocked: true,
+			wantErr: errors.New(providerv1alpha1.ErrResourceInUse),
+		},
+		"AlreadyDeleting": {
+			cr: instance(withObservation(v1alpha1.EnvironmentObservation{State: internal.Ptr(v1alpha1.InstanceStateDeleting)})),
+		},
+		"Success": {
+			cr:          instance(withObservation(v1alpha1.EnvironmentObservation{State: internal.Ptr(v1alpha1.InstanceStateOk)})),
+			wantDeleted: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			e := external{
+				tracker: trackingtest.NoOpReferenceResolverTracker{IsResourceBlocked: tc.blocked},
+				client: fake.MockClient{
+					MockDeleteInstance: func(ctx context.Context, cr *v1alpha1.EnvironmentInstance) error {
+						deleted = true
+						return nil
+					},
+				},
+			}
+			err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+			if deleted != tc.wantDeleted {
+				t.Errorf("\ne.Delete(...): deleted: want %v, got %v\n", tc.wantDeleted, deleted)
+			}
+		})
+	}
+}
+
+type instanceModifier func(*v1alpha1.EnvironmentInstance)
+
+func withConditions(c ...xpv1.Condition) instanceModifier {
+	return func(r *v1alpha1.EnvironmentInstance) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withParameters(raw string) instanceModifier {
+	return func(r *v1alpha1.EnvironmentInstance) {
+		r.Spec.ForProvider.Parameters = runtime.RawExtension{Raw: []byte(raw)}
+	}
+}
+
+func withLabels(labels map[string][]string) instanceModifier {
+	return func(r *v1alpha1.EnvironmentInstance) { r.Spec.ForProvider.Labels = labels }
+}
+
+func withObservation(o v1alpha1.EnvironmentObservation) instanceModifier {
+	return func(r *v1alpha1.EnvironmentInstance) { r.Status.AtProvider.EnvironmentObservation = o }
+}
+
+func instance(m ...instanceModifier) *v1alpha1.EnvironmentInstance {
+	cr := &v1alpha1.EnvironmentInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "abap",
+			Annotations: map[string]string{meta.AnnotationKeyExternalName: "abap"},
+		},
+		Spec: v1alpha1.EnvironmentInstanceSpec{
+			ForProvider: v1alpha1.EnvironmentInstanceParameters{
+				EnvironmentType: "abap",
+				ServiceName:     "abap",
+				PlanName:        "standard",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
@@ -0,0 +1,35 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	envinstance "github.com/sap/crossplane-provider-btp/internal/clients/environmentinstance"
+	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
+)
+
+var _ envinstance.Client = &MockClient{}
+
+type MockClient struct {
+	MockDescribeInstance func(ctx context.Context, cr *v1alpha1.EnvironmentInstance) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error)
+	MockCreateInstance   func(ctx context.Context, cr *v1alpha1.EnvironmentInstance) (string, error)
+	MockUpdateInstance   func(ctx context.Context, cr *v1alpha1.EnvironmentInstance) error
+	MockUpdateLabels     func(ctx context.Context, cr *v1alpha1.EnvironmentInstance) error
+	MockDeleteInstance   func(ctx context.Context, cr *v1alpha1.EnvironmentInstance) error
+}
+
+func (c MockClient) DescribeInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, error) {
+	return c.MockDescribeInstance(ctx, &cr)
+}
+func (c MockClient) CreateInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) (string, error) {
+	return c.MockCreateInstance(ctx, &cr)
+}
+func (c MockClient) UpdateInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) error {
+	return c.MockUpdateInstance(ctx, &cr)
+}
+func (c MockClient) UpdateLabels(ctx context.Context, cr v1alpha1.EnvironmentInstance) error {
+	return c.MockUpdateLabels(ctx, &cr)
+}
+func (c MockClient) DeleteInstance(ctx context.Context, cr v1alpha1.EnvironmentInstance) error {
+	return c.MockDeleteInstance(ctx, &cr)
+}
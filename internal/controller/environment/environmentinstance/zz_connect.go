@@ -0,0 +1,32 @@
+package environmentinstance
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	envinstance "github.com/sap/crossplane-provider-btp/internal/clients/environmentinstance"
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+)
+
+// Connect typically produces an ExternalClient by:
+// 1. Tracking that the managed resource is using a ProviderConfig.
+// 2. Getting the managed resource's ProviderConfig.
+// 3. Getting the credentials specified by the ProviderConfig.
+// 4. Using the credentials to form a client.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.EnvironmentInstance)
+	if !ok {
+		return nil, errors.New(errNotEnvironmentInstance)
+	}
+
+	svc, err := providerconfig.CreateCloudManagementClient(ctx, mg, c.kube, c.usage, c.resourcetracker, cr.Spec.CloudManagementSecret, cr.Spec.CloudManagementSecretNamespace, c.newServiceFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &external{client: envinstance.NewEnvironmentInstances(*svc), log: c.log, kube: c.kube, tracker: c.resourcetracker}, nil
+}
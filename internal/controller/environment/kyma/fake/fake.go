@@ -14,6 +14,14 @@ var _ environments.Client = &MockClient{}
 type MockClient struct {
 	MockDescribeCluster func(ctx context.Context, input *v1alpha1.KymaEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, bool, error)
 	MockCreateCluster   func(ctx context.Context, input *v1alpha1.KymaEnvironment) (string, error)
+	MockUpdateCluster   func(ctx context.Context, input *v1alpha1.KymaEnvironment) error
+	MockUpdateLabels    func(ctx context.Context, input *v1alpha1.KymaEnvironment) error
+	MockAvailability    func(ctx context.Context, input *v1alpha1.KymaEnvironment) (string, error)
+	MockSchemas         func(ctx context.Context, input *v1alpha1.KymaEnvironment) (string, string, error)
+	MockRevokeBindings  func(ctx context.Context, input *v1alpha1.KymaEnvironment) error
+	MockClusterBinding  func(ctx context.Context, input *v1alpha1.KymaEnvironment) (string, []byte, error)
+	MockRevokeBinding   func(ctx context.Context, input *v1alpha1.KymaEnvironment, bindingId string) error
+	MockDeleteCluster   func(ctx context.Context, input *v1alpha1.KymaEnvironment) error
 }
 
 func (c MockClient) DescribeInstance(ctx context.Context, cr v1alpha1.KymaEnvironment) (
@@ -27,8 +35,66 @@ func (c MockClient) CreateInstance(ctx context.Context, cr v1alpha1.KymaEnvironm
 	return c.MockCreateCluster(ctx, &cr)
 }
 func (c MockClient) UpdateInstance(ctx context.Context, cr v1alpha1.KymaEnvironment) error {
-	return nil
+	if c.MockUpdateCluster == nil {
+		return nil
+	}
+	return c.MockUpdateCluster(ctx, &cr)
+}
+func (c MockClient) UpdateLabels(ctx context.Context, cr v1alpha1.KymaEnvironment) error {
+	if c.MockUpdateLabels == nil {
+		return nil
+	}
+	return c.MockUpdateLabels(ctx, &cr)
+}
+func (c MockClient) CheckAvailability(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, error) {
+	if c.MockAvailability == nil {
+		return "", nil
+	}
+	return c.MockAvailability(ctx, &cr)
+}
+func (c MockClient) ParameterSchemas(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, string, error) {
+	if c.MockSchemas == nil {
+		return "", "", nil
+	}
+	return c.MockSchemas(ctx, &cr)
+}
+func (c MockClient) RevokeBindings(ctx context.Context, cr v1alpha1.KymaEnvironment) error {
+	if c.MockRevokeBindings == nil {
+		return nil
+	}
+	return c.MockRevokeBindings(ctx, &cr)
+}
+func (c MockClient) CreateClusterBinding(ctx context.Context, cr v1alpha1.KymaEnvironment) (string, []byte, error) {
+	if c.MockClusterBinding == nil {
+		return "", nil, nil
+	}
+	return c.MockClusterBinding(ctx, &cr)
+}
+func (c MockClient) RevokeBinding(ctx context.Context, cr v1alpha1.KymaEnvironment, bindingId string) error {
+	if c.MockRevokeBinding == nil {
+		return nil
+	}
+	return c.MockRevokeBinding(ctx, &cr, bindingId)
 }
 func (c MockClient) DeleteInstance(ctx context.Context, cr v1alpha1.KymaEnvironment) error {
+	if c.MockDeleteCluster == nil {
+		return nil
+	}
+	return c.MockDeleteCluster(ctx, &cr)
+}
+
+var _ environments.ClusterClient = &MockCluster{}
+
+type MockCluster struct {
+	Resources []environments.OperatorResource
+	Deleted   []environments.OperatorResource
+	ListErr   error
+}
+
+func (c *MockCluster) ListOperatorResources(ctx context.Context) ([]environments.OperatorResource, error) {
+	return c.Resources, c.ListErr
+}
+func (c *MockCluster) DeleteOperatorResource(ctx context.Context, r environments.OperatorResource) error {
+	c.Deleted = append(c.Deleted, r)
 	return nil
 }
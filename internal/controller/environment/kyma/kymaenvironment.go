@@ -5,9 +5,12 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/go-logr/logr"
@@ -31,19 +34,30 @@ import (
 )
 
 const (
-	errNotKymaEnvironment   = "managed resource is not a KymaEnvironment custom resource"
-	errExtractSecretKey     = "No Cloud Management Secret Found"
-	errGetCredentialsSecret = "Could not get secret of local cloud management"
-	errTrackPCUsage         = "cannot track ProviderConfig usage"
-	errGetPC                = "cannot get ProviderConfig"
-	errGetCreds             = "cannot get credentials"
-	errTrackRUsage          = "cannot track ResourceUsage"
-	errCheckUpdate          = "Could not check for needsUpdate"
-	errParameterParsing     = ".Spec.ForProvider.Parameters seem to be corrupted"
-	errServiceParsing       = "Parameters from service response seem to be corrupted"
-	errCantDescribe         = "Could not describe kyma instance"
-	errCircutBreak          = "circuit breaker is on; check retry status, update parameters or set annotation " + v1alpha1.IgnoreCircuitBreaker + " to any value"
-	maxRetriesDefault       = 3
+	errNotKymaEnvironment    = "managed resource is not a KymaEnvironment custom resource"
+	errCheckUpdate           = "Could not check for needsUpdate"
+	errParameterParsing      = ".Spec.ForProvider.Parameters seem to be corrupted"
+	errServiceParsing        = "Parameters from service response seem to be corrupted"
+	errCantDescribe          = "Could not describe kyma instance"
+	errUpdateLabels          = "Could not update labels of kyma instance"
+	errCheckAvailability     = "Could not check if the kyma plan is available in the subaccount"
+	errObtainKubeconfig      = "can not obtain kubeConfig"
+	errClusterBinding        = "Could not create a binding to access the kyma cluster"
+	errConnectCluster        = "Could not connect to kyma cluster"
+	errClusterNotReady       = "kyma cluster can't be accessed in state %s"
+	errListBindingResources  = "Could not list the KymaEnvironmentBindings"
+	errDeleteBindingResource = "Could not delete KymaEnvironmentBinding"
+	errRevokeBindings        = "Could not revoke bindings before deprovisioning"
+	errGetSchemas            = "Could not get the parameter schemas of the kyma plan"
+	errParametersRejected    = "parameters can't be applied to the kyma instance"
+	errCircutBreak           = "circuit breaker is on; check retry status, update parameters or set annotation " + v1alpha1.IgnoreCircuitBreaker + " to any value"
+	maxRetriesDefault        = 3
+	// maxListedDependents limits the resources named in the cleanup condition
+	maxListedDependents = 5
+	// kubeconfigRefreshBefore is how long before the expiry of its credentials the kubeconfig is downloaded again
+	kubeconfigRefreshBefore = time.Hour
+	// kubeconfigRefreshInterval is the minimum time between two downloads of a kubeconfig with expiring credentials
+	kubeconfigRefreshInterval = 10 * time.Minute
 )
 
 // A connector is expected to produce an ExternalClient when its Connect method
@@ -60,11 +74,12 @@ type connector struct {
 // An ExternalClient observes, then either creates, updates, or deletes an
 // external resource to ensure it reflects the managed resource's desired state.
 type external struct {
-	client     kymaenv.Client
-	tracker    tracking.ReferenceResolverTracker
-	kube       client.Client
-	httpClient *http.Client
-	log        logr.Logger
+	client       kymaenv.Client
+	tracker      tracking.ReferenceResolverTracker
+	kube         client.Client
+	httpClient   *http.Client
+	newClusterFn func(kubeconfig []byte) (kymaenv.ClusterClient, error)
+	log          logr.Logger
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -80,7 +95,11 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	lastModified := cr.Status.AtProvider.ModifiedDate
+	kubeconfigExpiresAt := cr.Status.AtProvider.KubeconfigExpiresAt
+	kubeconfigDownloadedAt := cr.Status.AtProvider.KubeconfigDownloadedAt
 	cr.Status.AtProvider = kymaenv.GenerateObservation(instance)
+	cr.Status.AtProvider.KubeconfigExpiresAt = kubeconfigExpiresAt
+	cr.Status.AtProvider.KubeconfigDownloadedAt = kubeconfigDownloadedAt
 
 	if cr.Status.AtProvider.State == nil {
 		cr.Status.SetConditions(xpv1.Unavailable())
@@ -102,7 +121,7 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}, nil
 	}
 
-	needsUpdate, diff, err := c.needsUpdateWithDiff(cr)
+	needsUpdate, diff, err := c.needsUpdateWithDiff(ctx, cr)
 	if needsUpdate || err != nil {
 		return managed.ExternalObservation{
 			ResourceExists:   true,
@@ -111,31 +130,50 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}, errors.Wrap(err, errCheckUpdate)
 	}
 
+	observation := managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: hasUpdate,
+	}
+	// a drift of the labels is updated without touching the cluster, so the kubeconfig is still kept up to date
+	if *cr.Status.AtProvider.State == v1alpha1.InstanceStateOk && kymaenv.LabelsNeedUpdate(*cr) {
+		observation.ResourceUpToDate = false
+		observation.Diff = "labels"
+	}
+
 	if connectionDetailsNeedUpdate(lastModified, cr) {
 		details, readErr := environments.GetConnectionDetails(instance, c.httpClient)
 		if readErr != nil {
-			return managed.ExternalObservation{
-				ResourceExists:   true,
-				ResourceUpToDate: true,
-			}, errors.Wrap(readErr, "can not obtain kubeConfig")
+			// the environment itself is fine, the download is retried with the next observation
+			cr.Status.SetConditions(v1alpha1.KubeconfigUnavailable(errors.Wrap(readErr, errObtainKubeconfig).Error()))
+			return observation, nil
 		}
-		return managed.ExternalObservation{
-			ResourceExists:          true,
-			ResourceUpToDate:        true,
-			ConnectionDetails:       details,
-			ResourceLateInitialized: hasUpdate,
-		}, nil
+		cr.Status.AtProvider.KubeconfigDownloadedAt = &metav1.Time{Time: time.Now()}
+		cr.Status.AtProvider.KubeconfigExpiresAt = nil
+		if expiry := kymaenv.KubeconfigExpiry(details[v1alpha1.KubeConfigSecretKey]); expiry != nil {
+			cr.Status.AtProvider.KubeconfigExpiresAt = &metav1.Time{Time: *expiry}
+		}
+		cr.Status.SetConditions(v1alpha1.KubeconfigAvailable())
+		observation.ConnectionDetails = details
 	}
 
-	return managed.ExternalObservation{
-		ResourceExists:          true,
-		ResourceUpToDate:        true,
-		ResourceLateInitialized: hasUpdate,
-	}, nil
+	return observation, nil
 }
 
+// connectionDetailsNeedUpdate reports if the kubeconfig has to be downloaded, because the environment was modified, its
+// credentials are about to expire or the last download failed. Expiring credentials are downloaded at most once per
+// kubeconfigRefreshInterval, as the broker may hand out the same credentials until they expired.
 func connectionDetailsNeedUpdate(lastModified *string, cr *v1alpha1.KymaEnvironment) bool {
-	return lastModified != nil && !reflect.DeepEqual(lastModified, cr.Status.AtProvider.ModifiedDate)
+	if lastModified != nil && !reflect.DeepEqual(lastModified, cr.Status.AtProvider.ModifiedDate) {
+		return true
+	}
+	if expiresAt := cr.Status.AtProvider.KubeconfigExpiresAt; expiresAt != nil && time.Until(expiresAt.Time) < kubeconfigRefreshBefore {
+		downloadedAt := cr.Status.AtProvider.KubeconfigDownloadedAt
+		if downloadedAt == nil || time.Since(downloadedAt.Time) >= kubeconfigRefreshInterval {
+			return true
+		}
+	}
+	return cr.GetCondition(v1alpha1.KubeconfigCondition).Reason == v1alpha1.KubeconfigDownloadFailed
 }
 
 func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
@@ -144,6 +182,16 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotKymaEnvironment)
 	}
 
+	unavailable, err := c.client.CheckAvailability(ctx, *cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCheckAvailability)
+	}
+	if unavailable != "" {
+		cr.Status.SetConditions(v1alpha1.EnvironmentPlanUnavailable(unavailable))
+		return managed.ExternalCreation{}, errors.New(unavailable)
+	}
+	cr.Status.SetConditions(v1alpha1.EnvironmentPlanAvailable())
+
 	guid, err := c.client.CreateInstance(ctx, *cr)
 	if err != nil {
 		return managed.ExternalCreation{}, err
@@ -167,10 +215,24 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errCircutBreak)
 	}
 
-	err := c.client.UpdateInstance(ctx, *cr)
+	if kymaenv.LabelsNeedUpdate(*cr) {
+		if err := c.client.UpdateLabels(ctx, *cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateLabels)
+		}
+	}
 
+	// a labels only drift must not trigger a (long running) update of the kyma parameters
+	_, _, diff, err := parametersDiff(cr)
 	if err != nil {
-		return managed.ExternalUpdate{}, err
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckUpdate)
+	}
+	if diff != "" {
+		if cond := cr.GetCondition(v1alpha1.ParameterValidationCondition); cond.Reason == v1alpha1.InvalidParameters {
+			return managed.ExternalUpdate{}, errors.Wrap(errors.New(cond.Message), errParametersRejected)
+		}
+		if err := c.client.UpdateInstance(ctx, *cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
 	}
 
 	return managed.ExternalUpdate{
@@ -194,27 +256,132 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 		return nil
 	}
 
+	if err := c.cleanup(ctx, cr); err != nil {
+		return err
+	}
+
 	return c.client.DeleteInstance(ctx, *cr)
 }
 
+// cleanup removes what depends on the environment according to its cleanup policy, it fails as long as
+// KymaEnvironmentBindings of the environment or resources of the BTP service operator are left in the cluster
+func (c *external) cleanup(ctx context.Context, cr *v1alpha1.KymaEnvironment) error {
+	policy := cr.Spec.ForProvider.CleanupPolicy
+	if policy == "" || policy == v1alpha1.CleanupPolicyNone {
+		return nil
+	}
+
+	if err := c.cleanupBindingResources(ctx, cr, policy); err != nil {
+		return err
+	}
+	skipped, err := c.cleanupOperatorResources(ctx, cr, policy)
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.RevokeBindings(ctx, *cr); err != nil {
+		return errors.Wrap(err, errRevokeBindings)
+	}
+	if skipped != "" {
+		cr.Status.SetConditions(v1alpha1.CleanupSkipped(skipped))
+		return nil
+	}
+	cr.Status.SetConditions(v1alpha1.CleanupDone())
+	return nil
+}
+
+// cleanupBindingResources handles the KymaEnvironmentBindings of the environment, their bindings would otherwise be
+// revoked without them noticing
+func (c *external) cleanupBindingResources(ctx context.Context, cr *v1alpha1.KymaEnvironment, policy string) error {
+	list := &v1alpha1.KymaEnvironmentBindingList{}
+	if err := c.kube.List(ctx, list); err != nil {
+		return errors.Wrap(err, errListBindingResources)
+	}
+	var names []string
+	for i := range list.Items {
+		b := &list.Items[i]
+		if cr.Status.AtProvider.ID == nil || b.Spec.KymaEnvironmentId != *cr.Status.AtProvider.ID {
+			continue
+		}
+		if policy == v1alpha1.CleanupPolicyDelete && !meta.WasDeleted(b) {
+			if err := c.kube.Delete(ctx, b); resource.IgnoreNotFound(err) != nil {
+				return errors.Wrap(err, errDeleteBindingResource)
+			}
+		}
+		names = append(names, b.Name)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	msg := remainingDependentsMessage("KymaEnvironmentBindings", names)
+	cr.Status.SetConditions(v1alpha1.CleanupInProgress(msg))
+	return errors.New(msg)
+}
+
+// cleanupOperatorResources handles the resources of the BTP service operator in the cluster, which is accessed with a
+// binding of its own that is revoked right afterwards. The kubeconfig of the connection secret can't be used, as it
+// authenticates with the kubectl oidc-login plugin. A cluster that can't be accessed, because the environment failed or
+// is still in progress or the cluster doesn't respond, would block the deprovisioning forever, so its cleanup is
+// skipped and the reason returned.
+func (c *external) cleanupOperatorResources(ctx context.Context, cr *v1alpha1.KymaEnvironment, policy string) (string, error) {
+	if state := internal.Val(cr.Status.AtProvider.State); state != v1alpha1.InstanceStateOk {
+		return fmt.Sprintf(errClusterNotReady, state), nil
+	}
+	bindingId, kubeconfig, err := c.client.CreateClusterBinding(ctx, *cr)
+	if err != nil {
+		return errors.Wrap(err, errClusterBinding).Error(), nil
+	}
+	// the binding expires on its own, if revoking it fails
+	defer func() { _ = c.client.RevokeBinding(ctx, *cr, bindingId) }()
+
+	cluster, err := c.newClusterFn(kubeconfig)
+	if err != nil {
+		return errors.Wrap(err, errConnectCluster).Error(), nil
+	}
+	resources, err := cluster.ListOperatorResources(ctx)
+	if err != nil {
+		return errors.Wrap(err, errConnectCluster).Error(), nil
+	}
+	if len(resources) == 0 {
+		return "", nil
+	}
+
+	if policy == v1alpha1.CleanupPolicyDelete {
+		for _, r := range resources {
+			if err := cluster.DeleteOperatorResource(ctx, r); err != nil {
+				return "", err
+			}
+		}
+	}
+	names := make([]string, 0, len(resources))
+	for _, r := range resources {
+		names = append(names, r.String())
+	}
+	msg := remainingDependentsMessage("BTP service operator resources in the cluster", names)
+	cr.Status.SetConditions(v1alpha1.CleanupInProgress(msg))
+	return "", errors.New(msg)
+}
+
+func remainingDependentsMessage(kind string, names []string) string {
+	listed := names
+	if len(names) > maxListedDependents {
+		listed = append(names[:maxListedDependents:maxListedDependents], fmt.Sprintf("and %d more", len(names)-maxListedDependents))
+	}
+	return fmt.Sprintf("waiting for deletion of %d %s: %s", len(names), kind, strings.Join(listed, ", "))
+}
+
 func (c *external) needsCreation(cr *v1alpha1.KymaEnvironment) bool {
 	return cr.Status.AtProvider.State == nil
 }
 
-func (c *external) needsUpdateWithDiff(cr *v1alpha1.KymaEnvironment) (bool, string, error) {
+func (c *external) needsUpdateWithDiff(ctx context.Context, cr *v1alpha1.KymaEnvironment) (bool, string, error) {
 	if *cr.Status.AtProvider.State != v1alpha1.InstanceStateOk {
 		return false, "", nil
 	}
 
-	desired, err := internal.UnmarshalRawParameters(cr.Spec.ForProvider.Parameters.Raw)
-	desired = kymaenv.AddKymaDefaultParameters(desired, cr.Name, string(cr.UID))
-	if err != nil {
-		return false, "", errors.Wrap(err, errParameterParsing)
-	}
-
-	current, err := internal.UnmarshalRawParameters([]byte(*cr.Status.AtProvider.Parameters))
+	desired, current, diff, err := parametersDiff(cr)
 	if err != nil {
-		return false, "", errors.Wrap(err, errServiceParsing)
+		return false, "", err
 	}
 
 	maxRetries, err := lookupMaxRetries(cr, maxRetriesDefault)
@@ -222,7 +389,24 @@ func (c *external) needsUpdateWithDiff(cr *v1alpha1.KymaEnvironment) (bool, stri
 		return false, "", err
 	}
 
-	diff := cmp.Diff(desired, current)
+	if diff != "" {
+		issues, err := c.validateUpdate(ctx, cr, desired, current)
+		if err != nil {
+			return false, "", err
+		}
+		if len(issues) > 0 {
+			// a spec the broker would reject is reported by the condition and doesn't count as a failed retry
+			cr.Status.SetConditions(v1alpha1.ParametersRejected(strings.Join(issues, "\n")))
+			if cr.Status.RetryStatus == nil {
+				cr.Status.RetryStatus = &v1alpha1.RetryStatus{}
+			}
+			cr.Status.RetryStatus.Diff = diff
+			return true, diff, nil
+		}
+	}
+	if cr.GetCondition(v1alpha1.ParameterValidationCondition).Reason == v1alpha1.InvalidParameters {
+		cr.Status.SetConditions(v1alpha1.ParametersAccepted())
+	}
 
 	updateCircuitBreakerStatus(cr, desired, current, diff, maxRetries)
 
@@ -230,6 +414,30 @@ func (c *external) needsUpdateWithDiff(cr *v1alpha1.KymaEnvironment) (bool, stri
 
 }
 
+// parametersDiff compares the desired parameters, completed by the defaults of the provider, with the observed ones
+func parametersDiff(cr *v1alpha1.KymaEnvironment) (map[string]interface{}, map[string]interface{}, string, error) {
+	desired, err := internal.UnmarshalRawParameters(cr.Spec.ForProvider.Parameters.Raw)
+	if err != nil {
+		return nil, nil, "", errors.Wrap(err, errParameterParsing)
+	}
+	desired = kymaenv.AddKymaDefaultParameters(desired, cr.Name, string(cr.UID))
+
+	current, err := internal.UnmarshalRawParameters([]byte(internal.Val(cr.Status.AtProvider.Parameters)))
+	if err != nil {
+		return nil, nil, "", errors.Wrap(err, errServiceParsing)
+	}
+	return desired, current, cmp.Diff(desired, current), nil
+}
+
+// validateUpdate checks the desired parameters against the schemas of the plan before they are sent to the broker
+func (c *external) validateUpdate(ctx context.Context, cr *v1alpha1.KymaEnvironment, desired map[string]interface{}, current map[string]interface{}) ([]string, error) {
+	createSchema, updateSchema, err := c.client.ParameterSchemas(ctx, *cr)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetSchemas)
+	}
+	return kymaenv.ValidateUpdate(createSchema, updateSchema, desired, current), nil
+}
+
 func lookupMaxRetries(cr *v1alpha1.KymaEnvironment, defaultRetries int) (int, error) {
 	if metav1.HasAnnotation(cr.ObjectMeta, v1alpha1.AnnotationMaxRetries) {
 		maxRetries, err := strconv.Atoi(cr.GetAnnotations()[v1alpha1.AnnotationMaxRetries])
@@ -3,9 +3,11 @@ package kyma
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -17,6 +19,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	provisioningclient "github.com/sap/crossplane-provider-btp/internal/openapi_clients/btp-provisioning-service-api-go/pkg"
 
@@ -24,6 +27,7 @@ import (
 	"github.com/sap/crossplane-provider-btp/internal"
 	kyma "github.com/sap/crossplane-provider-btp/internal/clients/kymaenvironment"
 	"github.com/sap/crossplane-provider-btp/internal/controller/environment/kyma/fake"
+	trackingtest "github.com/sap/crossplane-provider-btp/internal/tracking/test"
 )
 
 // Unlike many Kubernetes projects Crossplane does not use third party testing
@@ -64,6 +68,32 @@ func TestCreate(t *testing.T) {
 			want: want{
 				o:   managed.ExternalCreation{},
 				err: errors.New("Could not establish connection to the API server"),
+				cr:  environment(withConditions(v1alpha1.EnvironmentPlanAvailable())),
+			},
+		},
+		"PlanNotAvailable": {
+			args: args{
+				client: fake.MockClient{MockAvailability: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (string, error) {
+					return "plan gcp of environment type kyma is not available in the subaccount, available plans: azure", nil
+				}},
+				cr: environment(),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.New("plan gcp of environment type kyma is not available in the subaccount, available plans: azure"),
+				cr:  environment(withConditions(v1alpha1.EnvironmentPlanUnavailable("plan gcp of environment type kyma is not available in the subaccount, available plans: azure"))),
+			},
+		},
+		"AvailabilityCheckFailed": {
+			args: args{
+				client: fake.MockClient{MockAvailability: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (string, error) {
+					return "", errors.New("boom")
+				}},
+				cr: environment(),
+			},
+			want: want{
+				o:   managed.ExternalCreation{},
+				err: errors.Wrap(errors.New("boom"), errCheckAvailability),
 				cr:  environment(),
 			},
 		},
@@ -77,7 +107,7 @@ func TestCreate(t *testing.T) {
 			want: want{
 				o:   managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{}},
 				err: nil,
-				cr:  environment(withExternalName("1234")),
+				cr:  environment(withExternalName("1234"), withConditions(v1alpha1.EnvironmentPlanAvailable())),
 			},
 		},
 	}
@@ -181,8 +211,11 @@ func TestObserve(t *testing.T) {
 					ResourceUpToDate: true,
 				},
 				crCompareOpts: []cmp.Option{ignoreCircuitBreakerStatus()},
-				err:           errors.Wrap(errors.New("invalid character '}' looking for beginning of value"), "can not obtain kubeConfig"),
-				cr:            environment(withUID("1234"), withConditions(xpv1.Available())),
+				err:           nil,
+				cr: environment(withUID("1234"), withConditions(
+					xpv1.Available(),
+					v1alpha1.KubeconfigUnavailable("can not obtain kubeConfig: invalid character '}' looking for beginning of value"),
+				)),
 			},
 		},
 		"SuccessfulAvailable": {
@@ -232,7 +265,41 @@ func TestObserve(t *testing.T) {
 				},
 				crCompareOpts: []cmp.Option{ignoreCircuitBreakerStatus()},
 				err:           nil,
-				cr:            environment(withUID("1234"), withConditions(xpv1.Available())),
+				cr:            environment(withUID("1234"), withConditions(xpv1.Available(), v1alpha1.KubeconfigAvailable())),
+			},
+		},
+		"LabelsDriftedWithConnectionDetails": {
+			args: args{
+				client: fake.MockClient{MockDescribeCluster: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, bool, error) {
+					return &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+						State:        internal.Ptr("OK"),
+						ModifiedDate: internal.Ptr(float32(2000000000000.000000)),
+						Labels:       internal.Ptr("{\"name\": \"kyma\", \"KubeconfigURL\": \"someUrl\"}"),
+						Parameters:   internal.Ptr("{\"name\":\"kyma\"}"),
+						CustomLabels: &map[string][]string{"team": {"a"}},
+					}, false, nil
+				}},
+				httpClient: mockedHttpClient(kubeConfigData),
+				cr: environment(withUID("1234"),
+					withKymaParameters(v1alpha1.KymaEnvironmentParameters{Labels: &map[string][]string{"team": {"b"}}}),
+					withObservation(v1alpha1.KymaEnvironmentObservation{EnvironmentObservation: v1alpha1.EnvironmentObservation{ModifiedDate: internal.Ptr("1000000000000.000000")}})),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						"kubeconfig":                 []byte(kubeConfigData),
+						"name":                       []byte("kyma"),
+						"KubeconfigURL":              []byte("someUrl"),
+						"server":                     []byte("someServerUrl"),
+						"certificate-authority-data": []byte("someCaData"),
+					},
+				},
+				crCompareOpts: []cmp.Option{ignoreCircuitBreakerStatus()},
+				err:           nil,
+				cr: environment(withUID("1234"), withConditions(xpv1.Available(), v1alpha1.KubeconfigAvailable()),
+					withKymaParameters(v1alpha1.KymaEnvironmentParameters{Labels: &map[string][]string{"team": {"b"}}})),
 			},
 		},
 		"AvailableWithPartialConnectionDetails": {
@@ -262,7 +329,36 @@ func TestObserve(t *testing.T) {
 				},
 				crCompareOpts: []cmp.Option{ignoreCircuitBreakerStatus()},
 				err:           nil,
-				cr:            environment(withUID("1234"), withConditions(xpv1.Available())),
+				cr:            environment(withUID("1234"), withConditions(xpv1.Available(), v1alpha1.KubeconfigAvailable())),
+			},
+		},
+		"RefreshExpiringKubeconfig": {
+			args: args{
+				client: fake.MockClient{MockDescribeCluster: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, bool, error) {
+					return &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+						State:      internal.Ptr("OK"),
+						Labels:     internal.Ptr("{\"KubeconfigURL\": \"someUrl\"}"),
+						Parameters: internal.Ptr("{\"name\":\"kyma\"}"),
+					}, false, nil
+				}},
+				httpClient: mockedHttpClient(kubeConfigData),
+				cr: environment(withUID("1234"), withObservation(v1alpha1.KymaEnvironmentObservation{
+					KubeconfigExpiresAt: &metav1.Time{Time: time.Now().Add(10 * time.Minute)},
+				})),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						"kubeconfig":                 []byte(kubeConfigData),
+						"KubeconfigURL":              []byte("someUrl"),
+						"server":                     []byte("someServerUrl"),
+						"certificate-authority-data": []byte("someCaData"),
+					},
+				},
+				crCompareOpts: []cmp.Option{ignoreCircuitBreakerStatus()},
+				cr:            environment(withUID("1234"), withConditions(xpv1.Available(), v1alpha1.KubeconfigAvailable())),
 			},
 		},
 		"UpdateInProgress": {
@@ -333,6 +429,32 @@ func TestObserve(t *testing.T) {
 					})),
 			},
 		},
+		"LabelsDrifted": {
+			args: args{
+				client: fake.MockClient{MockDescribeCluster: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, bool, error) {
+					return &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+						State:        internal.Ptr("OK"),
+						Parameters:   internal.Ptr("{\"name\":\"kyma\"}"),
+						CustomLabels: &map[string][]string{"team": {"a"}},
+					}, false, nil
+				}},
+				cr: environment(withKymaParameters(v1alpha1.KymaEnvironmentParameters{
+					Labels: &map[string][]string{"team": {"b"}},
+				})),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				crCompareOpts: []cmp.Option{ignoreCircuitBreakerStatus()},
+				err:           nil,
+				cr: environment(withConditions(xpv1.Available()),
+					withKymaParameters(v1alpha1.KymaEnvironmentParameters{
+						Labels: &map[string][]string{"team": {"b"}},
+					})),
+			},
+		},
 		"Update with invalid json Parameters": {
 			args: args{
 				client: fake.MockClient{MockDescribeCluster: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, bool, error) {
@@ -502,6 +624,100 @@ func TestObserve(t *testing.T) {
 				),
 			},
 		},
+		"ParametersRejected": {
+			args: args{
+				client: fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, bool, error) {
+						return &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+							State:      internal.Ptr("OK"),
+							Parameters: internal.Ptr(`{"foo": "bar1", "name": "kyma"}`),
+						}, false, nil
+					},
+					MockSchemas: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (string, string, error) {
+						return "", `{"type": "object", "properties": {"name": {"type": "string"}}}`, nil
+					},
+				},
+				cr: environment(withKymaParameters(v1alpha1.KymaEnvironmentParameters{
+					Parameters: runtime.RawExtension{Raw: []byte(`foo: bar2`)},
+				}), withRetryStatus(&v1alpha1.RetryStatus{Count: 2})),
+			},
+			want: want{
+				crCompareOpts: []cmp.Option{ignoreCircuitBreakerDiff()},
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				cr: environment(
+					withKymaParameters(v1alpha1.KymaEnvironmentParameters{
+						Parameters: runtime.RawExtension{Raw: []byte(`foo: bar2`)},
+					}),
+					withConditions(xpv1.Available(), v1alpha1.ParametersRejected("parameters.foo can't be changed on an existing environment")),
+					withRetryStatus(&v1alpha1.RetryStatus{Count: 2}),
+				),
+			},
+		},
+		"ParametersValidAgain": {
+			args: args{
+				client: fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, bool, error) {
+						return &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+							State:      internal.Ptr("OK"),
+							Parameters: internal.Ptr(`{"foo": "bar1", "name": "kyma"}`),
+						}, false, nil
+					},
+					MockSchemas: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (string, string, error) {
+						return "", `{"type": "object", "properties": {"foo": {"type": "string"}}}`, nil
+					},
+				},
+				cr: environment(withKymaParameters(v1alpha1.KymaEnvironmentParameters{
+					Parameters: runtime.RawExtension{Raw: []byte(`foo: bar2`)},
+				}), withConditions(v1alpha1.ParametersRejected("parameters.foo can't be changed on an existing environment"))),
+			},
+			want: want{
+				crCompareOpts: []cmp.Option{ignoreCircuitBreakerStatus()},
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				cr: environment(
+					withKymaParameters(v1alpha1.KymaEnvironmentParameters{
+						Parameters: runtime.RawExtension{Raw: []byte(`foo: bar2`)},
+					}),
+					withConditions(xpv1.Available(), v1alpha1.ParametersAccepted()),
+				),
+			},
+		},
+		"SchemasUnavailable": {
+			args: args{
+				client: fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (*provisioningclient.BusinessEnvironmentInstanceResponseObject, bool, error) {
+						return &provisioningclient.BusinessEnvironmentInstanceResponseObject{
+							State:      internal.Ptr("OK"),
+							Parameters: internal.Ptr(`{"foo": "bar1", "name": "kyma"}`),
+						}, false, nil
+					},
+					MockSchemas: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (string, string, error) {
+						return "", "", errors.New("boom")
+					},
+				},
+				cr: environment(withKymaParameters(v1alpha1.KymaEnvironmentParameters{
+					Parameters: runtime.RawExtension{Raw: []byte(`foo: bar2`)},
+				})),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: errors.Wrap(errors.Wrap(errors.New("boom"), errGetSchemas), errCheckUpdate),
+				cr: environment(
+					withKymaParameters(v1alpha1.KymaEnvironmentParameters{
+						Parameters: runtime.RawExtension{Raw: []byte(`foo: bar2`)},
+					}),
+					withConditions(xpv1.Available()),
+				),
+			},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -592,6 +808,100 @@ func TestCircuitBreaker(t *testing.T) {
 	}
 }
 
+func TestUpdate(t *testing.T) {
+	type args struct {
+		cr        *v1alpha1.KymaEnvironment
+		labelsErr error
+	}
+
+	type want struct {
+		labelsUpdated   bool
+		instanceUpdated bool
+		err             error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ParametersOnly": {
+			args: args{
+				cr: environment(withObservedParameters(`{"name":"kyma","region":"eu"}`)),
+			},
+			want: want{instanceUpdated: true},
+		},
+		"LabelsOnly": {
+			args: args{
+				cr: environment(
+					withKymaParameters(v1alpha1.KymaEnvironmentParameters{Labels: &map[string][]string{"team": {"a"}}}),
+					withObservedParameters(`{"name":"kyma"}`),
+				),
+			},
+			want: want{labelsUpdated: true},
+		},
+		"LabelsAndParameters": {
+			args: args{
+				cr: environment(
+					withKymaParameters(v1alpha1.KymaEnvironmentParameters{Labels: &map[string][]string{"team": {"a"}}}),
+					withObservedParameters(`{"name":"kyma","region":"eu"}`),
+				),
+			},
+			want: want{labelsUpdated: true, instanceUpdated: true},
+		},
+		"ParametersRejected": {
+			args: args{
+				cr: environment(
+					withConditions(v1alpha1.ParametersRejected("parameters.region can't be changed on an existing environment")),
+					withObservedParameters(`{"name":"kyma","region":"eu"}`),
+				),
+			},
+			want: want{err: errors.Wrap(errors.New("parameters.region can't be changed on an existing environment"), errParametersRejected)},
+		},
+		"CorruptedParameters": {
+			args: args{
+				cr: environment(withKymaParameters(v1alpha1.KymaEnvironmentParameters{
+					Parameters: runtime.RawExtension{Raw: []byte(`{asd:y}`)},
+				})),
+			},
+			want: want{err: errors.Wrap(errors.Wrap(errors.New("ReadString: expects \" or n, but found a, error found in #2 byte of ...|{asd:y}|..., bigger context ...|{asd:y}|..."), errParameterParsing), errCheckUpdate)},
+		},
+		"LabelsUpdateFailed": {
+			args: args{
+				cr:        environment(withKymaParameters(v1alpha1.KymaEnvironmentParameters{Labels: &map[string][]string{"team": {"a"}}})),
+				labelsErr: errors.New("boom"),
+			},
+			want: want{labelsUpdated: true, err: errors.Wrap(errors.New("boom"), errUpdateLabels)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var labelsUpdated, instanceUpdated bool
+			client := fake.MockClient{
+				MockUpdateLabels: func(ctx context.Context, input *v1alpha1.KymaEnvironment) error {
+					labelsUpdated = true
+					return tc.args.labelsErr
+				},
+				MockUpdateCluster: func(ctx context.Context, input *v1alpha1.KymaEnvironment) error {
+					instanceUpdated = true
+					return nil
+				},
+			}
+			e := external{client: client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Update(...): -want error, +got error:\n%s\n", diff)
+			}
+			if labelsUpdated != tc.want.labelsUpdated {
+				t.Errorf("\ne.Update(...): labels updated: want %v, got %v\n", tc.want.labelsUpdated, labelsUpdated)
+			}
+			if instanceUpdated != tc.want.instanceUpdated {
+				t.Errorf("\ne.Update(...): instance updated: want %v, got %v\n", tc.want.instanceUpdated, instanceUpdated)
+			}
+		})
+	}
+}
+
 func TestUpdateCircuitBreakerStatus(t *testing.T) {
 	type args struct {
 		cr         *v1alpha1.KymaEnvironment
@@ -784,6 +1094,10 @@ func withRetryStatus(retryStatus *v1alpha1.RetryStatus) environmentModifier {
 	return func(r *v1alpha1.KymaEnvironment) { r.Status.RetryStatus = retryStatus }
 }
 
+func withObservedParameters(parameters string) environmentModifier {
+	return func(r *v1alpha1.KymaEnvironment) { r.Status.AtProvider.Parameters = &parameters }
+}
+
 func withObservation(observation v1alpha1.KymaEnvironmentObservation) environmentModifier {
 	return func(r *v1alpha1.KymaEnvironment) {
 		r.Status.AtProvider = observation
@@ -820,3 +1134,279 @@ func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
 }
 
 type RoundTripFunc func(req *http.Request) *http.Response
+
+func TestConnectionDetailsNeedUpdate(t *testing.T) {
+	cases := map[string]struct {
+		lastModified *string
+		cr           *v1alpha1.KymaEnvironment
+		want         bool
+	}{
+		"NotModified": {
+			lastModified: internal.Ptr("1000.000000"),
+			cr:           environment(withObservation(v1alpha1.KymaEnvironmentObservation{EnvironmentObservation: v1alpha1.EnvironmentObservation{ModifiedDate: internal.Ptr("1000.000000")}})),
+		},
+		"Modified": {
+			lastModified: internal.Ptr("1000.000000"),
+			cr:           environment(withObservation(v1alpha1.KymaEnvironmentObservation{EnvironmentObservation: v1alpha1.EnvironmentObservation{ModifiedDate: internal.Ptr("2000.000000")}})),
+			want:         true,
+		},
+		"CredentialsValid": {
+			cr: environment(withObservation(v1alpha1.KymaEnvironmentObservation{KubeconfigExpiresAt: &metav1.Time{Time: time.Now().Add(8 * time.Hour)}})),
+		},
+		"CredentialsExpiring": {
+			cr:   environment(withObservation(v1alpha1.KymaEnvironmentObservation{KubeconfigExpiresAt: &metav1.Time{Time: time.Now().Add(10 * time.Minute)}})),
+			want: true,
+		},
+		"CredentialsExpired": {
+			cr:   environment(withObservation(v1alpha1.KymaEnvironmentObservation{KubeconfigExpiresAt: &metav1.Time{Time: time.Now().Add(-time.Minute)}})),
+			want: true,
+		},
+		"CredentialsExpiringRecentlyDownloaded": {
+			cr: environment(withObservation(v1alpha1.KymaEnvironmentObservation{
+				KubeconfigExpiresAt:    &metav1.Time{Time: time.Now().Add(10 * time.Minute)},
+				KubeconfigDownloadedAt: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			})),
+		},
+		"CredentialsExpiredDownloadedBefore": {
+			cr: environment(withObservation(v1alpha1.KymaEnvironmentObservation{
+				KubeconfigExpiresAt:    &metav1.Time{Time: time.Now().Add(-time.Minute)},
+				KubeconfigDownloadedAt: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			})),
+			want: true,
+		},
+		"DownloadFailed": {
+			cr:   environment(withConditions(v1alpha1.KubeconfigUnavailable("boom"))),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := connectionDetailsNeedUpdate(tc.lastModified, tc.cr); got != tc.want {
+				t.Errorf("connectionDetailsNeedUpdate(...): want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	dependents := []kyma.OperatorResource{
+		{Kind: "ServiceBinding", Namespace: "default", Name: "binding"},
+		{Kind: "ServiceInstance", Namespace: "default", Name: "instance"},
+	}
+	waiting := "waiting for deletion of 2 BTP service operator resources in the cluster: ServiceBinding default/binding, ServiceInstance default/instance"
+	waitingForBindings := "waiting for deletion of 1 KymaEnvironmentBindings: admin"
+	bindingResources := []v1alpha1.KymaEnvironmentBinding{
+		{ObjectMeta: metav1.ObjectMeta{Name: "admin"}, Spec: v1alpha1.KymaEnvironmentBindingSpec{KymaEnvironmentId: "kyma-id"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Spec: v1alpha1.KymaEnvironmentBindingSpec{KymaEnvironmentId: "other-id"}},
+	}
+
+	type args struct {
+		cr               *v1alpha1.KymaEnvironment
+		cluster          *fake.MockCluster
+		bindingResources []v1alpha1.KymaEnvironmentBinding
+		bindingErr       error
+		revokeErr        error
+	}
+	type want struct {
+		err            error
+		cr             *v1alpha1.KymaEnvironment
+		deleted        []kyma.OperatorResource
+		deletedBinding []string
+		clusterRevoked bool
+		revoked        bool
+		deprovision    bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoCleanup": {
+			args: args{cr: environment()},
+			want: want{cr: environment(), deprovision: true},
+		},
+		"WaitForDependents": {
+			args: args{
+				cr:      environment(withCleanupPolicy(v1alpha1.CleanupPolicyWait), withID("kyma-id"), withState(v1alpha1.InstanceStateOk)),
+				cluster: &fake.MockCluster{Resources: dependents},
+			},
+			want: want{
+				err:            errors.New(waiting),
+				cr:             environment(withCleanupPolicy(v1alpha1.CleanupPolicyWait), withID("kyma-id"), withState(v1alpha1.InstanceStateOk), withConditions(v1alpha1.CleanupInProgress(waiting))),
+				clusterRevoked: true,
+			},
+		},
+		"DeleteDependents": {
+			args: args{
+				cr:      environment(withCleanupPolicy(v1alpha1.CleanupPolicyDelete), withID("kyma-id"), withState(v1alpha1.InstanceStateOk)),
+				cluster: &fake.MockCluster{Resources: dependents},
+			},
+			want: want{
+				err:            errors.New(waiting),
+				cr:             environment(withCleanupPolicy(v1alpha1.CleanupPolicyDelete), withID("kyma-id"), withState(v1alpha1.InstanceStateOk), withConditions(v1alpha1.CleanupInProgress(waiting))),
+				deleted:        dependents,
+				clusterRevoked: true,
+			},
+		},
+		"WaitForBindingResources": {
+			args: args{
+				cr:               environment(withCleanupPolicy(v1alpha1.CleanupPolicyWait), withID("kyma-id"), withState(v1alpha1.InstanceStateOk)),
+				bindingResources: bindingResources,
+			},
+			want: want{
+				err: errors.New(waitingForBindings),
+				cr:  environment(withCleanupPolicy(v1alpha1.CleanupPolicyWait), withID("kyma-id"), withState(v1alpha1.InstanceStateOk), withConditions(v1alpha1.CleanupInProgress(waitingForBindings))),
+			},
+		},
+		"DeleteBindingResources": {
+			args: args{
+				cr:               environment(withCleanupPolicy(v1alpha1.CleanupPolicyDelete), withID("kyma-id"), withState(v1alpha1.InstanceStateOk)),
+				bindingResources: bindingResources,
+			},
+			want: want{
+				err:            errors.New(waitingForBindings),
+				cr:             environment(withCleanupPolicy(v1alpha1.CleanupPolicyDelete), withID("kyma-id"), withState(v1alpha1.InstanceStateOk), withConditions(v1alpha1.CleanupInProgress(waitingForBindings))),
+				deletedBinding: []string{"admin"},
+			},
+		},
+		"CleanupDone": {
+			args: args{
+				cr:               environment(withCleanupPolicy(v1alpha1.CleanupPolicyDelete), withID("kyma-id"), withState(v1alpha1.InstanceStateOk)),
+				cluster:          &fake.MockCluster{},
+				bindingResources: bindingResources[1:],
+			},
+			want: want{
+				cr:             environment(withCleanupPolicy(v1alpha1.CleanupPolicyDelete), withID("kyma-id"), withState(v1alpha1.InstanceStateOk), withConditions(v1alpha1.CleanupDone())),
+				clusterRevoked: true,
+				revoked:        true,
+				deprovision:    true,
+			},
+		},
+		"RevokeFailed": {
+			args: args{
+				cr:        environment(withCleanupPolicy(v1alpha1.CleanupPolicyWait), withID("kyma-id"), withState(v1alpha1.InstanceStateOk)),
+				cluster:   &fake.MockCluster{},
+				revokeErr: errors.New("boom"),
+			},
+			want: want{
+				err:            errors.Wrap(errors.New("boom"), errRevokeBindings),
+				cr:             environment(withCleanupPolicy(v1alpha1.CleanupPolicyWait), withID("kyma-id"), withState(v1alpha1.InstanceStateOk)),
+				clusterRevoked: true,
+				revoked:        true,
+			},
+		},
+		"ClusterBindingFailed": {
+			args: args{
+				cr:         environment(withCleanupPolicy(v1alpha1.CleanupPolicyWait), withID("kyma-id"), withState(v1alpha1.InstanceStateOk)),
+				bindingErr: errors.New("boom"),
+			},
+			want: want{
+				cr: environment(withCleanupPolicy(v1alpha1.CleanupPolicyWait), withID("kyma-id"), withState(v1alpha1.InstanceStateOk),
+					withConditions(v1alpha1.CleanupSkipped(errors.Wrap(errors.New("boom"), errClusterBinding).Error()))),
+				revoked:     true,
+				deprovision: true,
+			},
+		},
+		"ClusterUnreachable": {
+			args: args{
+				cr:      environment(withCleanupPolicy(v1alpha1.CleanupPolicyDelete), withID("kyma-id"), withState(v1alpha1.InstanceStateOk)),
+				cluster: &fake.MockCluster{ListErr: errors.New("boom")},
+			},
+			want: want{
+				cr: environment(withCleanupPolicy(v1alpha1.CleanupPolicyDelete), withID("kyma-id"), withState(v1alpha1.InstanceStateOk),
+					withConditions(v1alpha1.CleanupSkipped(errors.Wrap(errors.New("boom"), errConnectCluster).Error()))),
+				clusterRevoked: true,
+				revoked:        true,
+				deprovision:    true,
+			},
+		},
+		"CreationFailed": {
+			args: args{
+				cr:         environment(withCleanupPolicy(v1alpha1.CleanupPolicyDelete), withID("kyma-id"), withState(v1alpha1.InstanceStateCreationFailed)),
+				bindingErr: errors.New("not bindable"),
+			},
+			want: want{
+				cr: environment(withCleanupPolicy(v1alpha1.CleanupPolicyDelete), withID("kyma-id"), withState(v1alpha1.InstanceStateCreationFailed),
+					withConditions(v1alpha1.CleanupSkipped(fmt.Sprintf(errClusterNotReady, v1alpha1.InstanceStateCreationFailed)))),
+				revoked:     true,
+				deprovision: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var clusterRevoked, revoked, deprovisioned bool
+			var deletedBinding []string
+			e := external{
+				client: fake.MockClient{
+					MockClusterBinding: func(ctx context.Context, input *v1alpha1.KymaEnvironment) (string, []byte, error) {
+						return "cluster-binding", []byte(kubeConfigData), tc.args.bindingErr
+					},
+					MockRevokeBinding: func(ctx context.Context, input *v1alpha1.KymaEnvironment, bindingId string) error {
+						clusterRevoked = bindingId == "cluster-binding"
+						return nil
+					},
+					MockRevokeBindings: func(ctx context.Context, input *v1alpha1.KymaEnvironment) error {
+						revoked = true
+						return tc.args.revokeErr
+					},
+					MockDeleteCluster: func(ctx context.Context, input *v1alpha1.KymaEnvironment) error {
+						deprovisioned = true
+						return nil
+					},
+				},
+				tracker: trackingtest.NoOpReferenceResolverTracker{},
+				kube: &test.MockClient{
+					MockList: func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+						list.(*v1alpha1.KymaEnvironmentBindingList).Items = tc.args.bindingResources
+						return nil
+					},
+					MockDelete: func(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+						deletedBinding = append(deletedBinding, obj.GetName())
+						return nil
+					},
+				},
+				newClusterFn: func(kubeconfig []byte) (kyma.ClusterClient, error) {
+					return tc.args.cluster, nil
+				},
+			}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want error, +got error:\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("\ne.Delete(...): -want cr, +got cr:\n%s\n", diff)
+			}
+			if tc.args.cluster != nil {
+				if diff := cmp.Diff(tc.want.deleted, tc.args.cluster.Deleted); diff != "" {
+					t.Errorf("\ne.Delete(...): -want deleted, +got deleted:\n%s\n", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.deletedBinding, deletedBinding); diff != "" {
+				t.Errorf("\ne.Delete(...): -want deleted KymaEnvironmentBindings, +got:\n%s\n", diff)
+			}
+			if clusterRevoked != tc.want.clusterRevoked {
+				t.Errorf("\ne.Delete(...): cluster binding revoked: want %v, got %v\n", tc.want.clusterRevoked, clusterRevoked)
+			}
+			if revoked != tc.want.revoked {
+				t.Errorf("\ne.Delete(...): bindings revoked: want %v, got %v\n", tc.want.revoked, revoked)
+			}
+			if deprovisioned != tc.want.deprovision {
+				t.Errorf("\ne.Delete(...): deprovisioned: want %v, got %v\n", tc.want.deprovision, deprovisioned)
+			}
+		})
+	}
+}
+
+func withCleanupPolicy(policy string) environmentModifier {
+	return func(r *v1alpha1.KymaEnvironment) { r.Spec.ForProvider.CleanupPolicy = policy }
+}
+
+func withID(id string) environmentModifier {
+	return func(r *v1alpha1.KymaEnvironment) { r.Status.AtProvider.ID = &id }
+}
+
+func withState(state string) environmentModifier {
+	return func(r *v1alpha1.KymaEnvironment) { r.Status.AtProvider.State = &state }
+}
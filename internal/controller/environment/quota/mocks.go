@@ -0,0 +1,90 @@
+package quota
+
+import (
+	"context"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+// MaintainerMock holds the stubbed values and the called identifier shared by the quota maintainer mocks
+type MaintainerMock struct {
+	createdID string
+	err       error
+	// for verification
+	CalledIdentifier string
+}
+
+// OrganizationQuotaMaintainerMock is a mock implementation of OrganizationQuotaMaintainer interface
+// returns stubed values and records called identifier to most methods
+type OrganizationQuotaMaintainerMock struct {
+	MaintainerMock
+	generateObservation v1alpha1.OrganizationQuotaObservation
+}
+
+var _ OrganizationQuotaMaintainer = &OrganizationQuotaMaintainerMock{}
+
+func (m *OrganizationQuotaMaintainerMock) GenerateObservation(ctx context.Context, guid string, name string) (v1alpha1.OrganizationQuotaObservation, error) {
+	m.CalledIdentifier = guid
+	return m.generateObservation, m.err
+}
+
+func (m *OrganizationQuotaMaintainerMock) NeedsCreation(observation v1alpha1.OrganizationQuotaObservation) bool {
+	return observation.ID == nil
+}
+
+func (m *OrganizationQuotaMaintainerMock) IsUpToDate(params v1alpha1.OrganizationQuotaParameters, orgGuid string, observation v1alpha1.OrganizationQuotaObservation) bool {
+	return internal.Val(observation.Name) == params.Name && len(observation.OrganizationGuids) == 1 && observation.OrganizationGuids[0] == orgGuid
+}
+
+func (m *OrganizationQuotaMaintainerMock) Create(ctx context.Context, orgGuid string, params v1alpha1.OrganizationQuotaParameters) (string, error) {
+	m.CalledIdentifier = orgGuid
+	return m.createdID, m.err
+}
+
+func (m *OrganizationQuotaMaintainerMock) Update(ctx context.Context, guid string, orgGuid string, params v1alpha1.OrganizationQuotaParameters) error {
+	m.CalledIdentifier = guid
+	return m.err
+}
+
+func (m *OrganizationQuotaMaintainerMock) Delete(ctx context.Context, guid string, orgGuid string) error {
+	m.CalledIdentifier = guid
+	return m.err
+}
+
+// SpaceQuotaMaintainerMock is a mock implementation of SpaceQuotaMaintainer interface
+// returns stubed values and records called identifier to most methods
+type SpaceQuotaMaintainerMock struct {
+	MaintainerMock
+	generateObservation v1alpha1.SpaceQuotaObservation
+}
+
+var _ SpaceQuotaMaintainer = &SpaceQuotaMaintainerMock{}
+
+func (m *SpaceQuotaMaintainerMock) GenerateObservation(ctx context.Context, guid string, orgGuid string, name string) (v1alpha1.SpaceQuotaObservation, error) {
+	m.CalledIdentifier = guid
+	return m.generateObservation, m.err
+}
+
+func (m *SpaceQuotaMaintainerMock) NeedsCreation(observation v1alpha1.SpaceQuotaObservation) bool {
+	return observation.ID == nil
+}
+
+func (m *SpaceQuotaMaintainerMock) IsUpToDate(params v1alpha1.SpaceQuotaParameters, spaceGuids []string, observation v1alpha1.SpaceQuotaObservation) bool {
+	return internal.Val(observation.Name) == params.Name && len(observation.SpaceGuids) == len(spaceGuids)
+}
+
+func (m *SpaceQuotaMaintainerMock) Create(ctx context.Context, orgGuid string, params v1alpha1.SpaceQuotaParameters, spaceGuids []string) (string, error) {
+	m.CalledIdentifier = orgGuid
+	return m.createdID, m.err
+}
+
+func (m *SpaceQuotaMaintainerMock) Update(ctx context.Context, guid string, params v1alpha1.SpaceQuotaParameters, spaceGuids []string) error {
+	m.CalledIdentifier = guid
+	return m.err
+}
+
+func (m *SpaceQuotaMaintainerMock) Delete(ctx context.Context, guid string) error {
+	m.CalledIdentifier = guid
+	return m.err
+}
@@ -0,0 +1,172 @@
+package quota
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errTrackRUsage  = "cannot track ResourceUsage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+	errNoOrgGuid    = "orgGuid is not set or not resolved yet"
+
+	errNewClient   = "cannot create new Service"
+	errGetQuota    = "cannot get %s quota"
+	errCreateQuota = "cannot create %s quota"
+	errUpdateQuota = "cannot update %s quota"
+	errDeleteQuota = "cannot delete %s quota"
+)
+
+type connector struct {
+	scope           Scope
+	kube            client.Client
+	usage           resource.Tracker
+	resourcetracker tracking.ReferenceResolverTracker
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := c.scope.asQuota(mg)
+	if !ok {
+		return nil, errors.New(c.scope.errNotQuota)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+	if err := c.resourcetracker.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackRUsage)
+	}
+
+	if cr.orgGuid() == "" {
+		return nil, errors.New(errNoOrgGuid)
+	}
+
+	pc, err := providerconfig.ResolveProviderConfig(ctx, mg, c.kube)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+	cd := pc.Spec.ServiceAccountSecret
+	serviceAccountSecretData, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.scope.newClientFn(cr.apiEndpoint(), serviceAccountSecretData)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{scope: c.scope, client: svc}, nil
+}
+
+type external struct {
+	scope  Scope
+	client quotaClient
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := c.scope.asQuota(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(c.scope.errNotQuota)
+	}
+
+	guid, err := c.client.observe(ctx, cr, quotaGuid(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetQuota, c.scope.name)
+	}
+
+	if guid == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// an adopted quota is left to its owner on deletion, it is released by reporting it as gone
+	if meta.WasDeleted(cr) && !createdByProvider(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// an existing quota with the same name is adopted, org quotas are global to the landscape and space quotas unique within the org
+	adopted := meta.GetExternalName(cr) != guid
+	if adopted {
+		meta.SetExternalName(cr, guid)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	// adopted quotas may be shared with other orgs or spaces and are only observed, never updated
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        !createdByProvider(cr) || c.client.isUpToDate(cr),
+		ResourceLateInitialized: adopted,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := c.scope.asQuota(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(c.scope.errNotQuota)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	guid, err := c.client.create(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrapf(err, errCreateQuota, c.scope.name)
+	}
+	meta.SetExternalName(cr, guid)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := c.scope.asQuota(mg)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(c.scope.errNotQuota)
+	}
+
+	if !createdByProvider(cr) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	err := c.client.update(ctx, cr, meta.GetExternalName(cr))
+	return managed.ExternalUpdate{}, errors.Wrapf(err, errUpdateQuota, c.scope.name)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := c.scope.asQuota(mg)
+	if !ok {
+		return errors.New(c.scope.errNotQuota)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	if !createdByProvider(cr) {
+		return nil
+	}
+
+	return errors.Wrapf(c.client.delete(ctx, cr, meta.GetExternalName(cr)), errDeleteQuota, c.scope.name)
+}
+
+// quotaGuid returns the GUID stored as external name, empty as long as crossplane's default of the resource name is in place
+func quotaGuid(cr resource.Managed) string {
+	if guid := meta.GetExternalName(cr); guid != cr.GetName() {
+		return guid
+	}
+	return ""
+}
+
+// createdByProvider tells quotas created by this provider apart from adopted ones that existed before,
+// only the former are updated and deleted. The managed reconciler records every successful Create.
+func createdByProvider(cr resource.Managed) bool {
+	return !meta.GetExternalCreateSucceeded(cr).IsZero()
+}
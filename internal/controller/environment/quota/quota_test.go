@@ -0,0 +1,386 @@
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+var (
+	apiError = errors.New("apiError")
+)
+
+// quotaState selects the quota the maintainer mocks report
+type quotaState int
+
+const (
+	missing quotaState = iota
+	// unapplied quotas exist with the desired name but aren't applied to the org or spaces of the spec
+	unapplied
+	applied
+)
+
+// scopes lists every scope with constructors for its resources and maintainer mock, each test case runs for all of them
+var scopes = map[string]struct {
+	scope  Scope
+	cr     func(name string, m ...QuotaModifier) resource.Managed
+	client func(stub MaintainerMock, state quotaState) (quotaClient, *MaintainerMock)
+	// observation sets the status reported for the given state
+	observation func(state quotaState) QuotaModifier
+}{
+	"OrganizationQuota": {
+		scope: OrgScope,
+		cr: func(name string, m ...QuotaModifier) resource.Managed {
+			return modify(&v1alpha1.OrganizationQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-quota"},
+				Spec: v1alpha1.OrganizationQuotaSpec{
+					ForProvider: v1alpha1.OrganizationQuotaParameters{Name: name},
+					OrgGuid:     "org-guid",
+				},
+			}, m)
+		},
+		client: func(stub MaintainerMock, state quotaState) (quotaClient, *MaintainerMock) {
+			mock := &OrganizationQuotaMaintainerMock{MaintainerMock: stub, generateObservation: orgQuotaObservation(state)}
+			return orgQuotaClient{mock}, &mock.MaintainerMock
+		},
+		observation: func(state quotaState) QuotaModifier {
+			return func(r resource.Managed) {
+				r.(*v1alpha1.OrganizationQuota).Status.AtProvider = orgQuotaObservation(state)
+			}
+		},
+	},
+	"SpaceQuota": {
+		scope: SpaceScope,
+		cr: func(name string, m ...QuotaModifier) resource.Managed {
+			return modify(&v1alpha1.SpaceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-quota"},
+				Spec: v1alpha1.SpaceQuotaSpec{
+					ForProvider: v1alpha1.SpaceQuotaParameters{Name: name},
+					OrgGuid:     "org-guid",
+					SpaceGuids:  []string{"space-guid"},
+				},
+			}, m)
+		},
+		client: func(stub MaintainerMock, state quotaState) (quotaClient, *MaintainerMock) {
+			mock := &SpaceQuotaMaintainerMock{MaintainerMock: stub, generateObservation: spaceQuotaObservation(state)}
+			return spaceQuotaClient{mock}, &mock.MaintainerMock
+		},
+		observation: func(state quotaState) QuotaModifier {
+			return func(r resource.Managed) { r.(*v1alpha1.SpaceQuota).Status.AtProvider = spaceQuotaObservation(state) }
+		},
+	},
+}
+
+func orgQuotaObservation(state quotaState) v1alpha1.OrganizationQuotaObservation {
+	switch state {
+	case unapplied:
+		return v1alpha1.OrganizationQuotaObservation{ID: internal.Ptr("quota-guid"), Name: internal.Ptr("small")}
+	case applied:
+		return v1alpha1.OrganizationQuotaObservation{ID: internal.Ptr("quota-guid"), Name: internal.Ptr("small"), OrganizationGuids: []string{"org-guid"}}
+	}
+	return v1alpha1.OrganizationQuotaObservation{}
+}
+
+func spaceQuotaObservation(state quotaState) v1alpha1.SpaceQuotaObservation {
+	switch state {
+	case unapplied:
+		return v1alpha1.SpaceQuotaObservation{ID: internal.Ptr("quota-guid"), Name: internal.Ptr("small")}
+	case applied:
+		return v1alpha1.SpaceQuotaObservation{ID: internal.Ptr("quota-guid"), Name: internal.Ptr("small"), SpaceGuids: []string{"space-guid"}}
+	}
+	return v1alpha1.SpaceQuotaObservation{}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		cr    []QuotaModifier
+		stub  MaintainerMock
+		state quotaState
+	}
+
+	type want struct {
+		cr               []QuotaModifier
+		observed         bool
+		o                managed.ExternalObservation
+		err              string
+		CalledIdentifier string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"LookupError": {
+			args: args{
+				cr:   []QuotaModifier{withExternalName("quota-guid")},
+				stub: MaintainerMock{err: apiError},
+			},
+			want: want{
+				cr:               []QuotaModifier{withExternalName("quota-guid")},
+				err:              errGetQuota,
+				CalledIdentifier: "quota-guid",
+			},
+		},
+		"NeedsCreation": {
+			args: args{
+				cr: []QuotaModifier{withExternalName("my-quota")},
+			},
+			want: want{
+				cr:       []QuotaModifier{withExternalName("my-quota")},
+				observed: true,
+				o:        managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Adopted": {
+			args: args{
+				cr:    []QuotaModifier{withExternalName("my-quota")},
+				state: unapplied,
+			},
+			want: want{
+				cr:       []QuotaModifier{withExternalName("quota-guid"), withConditions(xpv1.Available())},
+				observed: true,
+				o:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"AdoptedReleasedOnDeletion": {
+			args: args{
+				cr:    []QuotaModifier{withExternalName("quota-guid"), withDeletionTimestamp()},
+				state: unapplied,
+			},
+			want: want{
+				cr:               []QuotaModifier{withExternalName("quota-guid"), withDeletionTimestamp()},
+				observed:         true,
+				o:                managed.ExternalObservation{ResourceExists: false},
+				CalledIdentifier: "quota-guid",
+			},
+		},
+		"CreatedNeedsUpdate": {
+			args: args{
+				cr:    []QuotaModifier{withExternalName("quota-guid"), withCreated()},
+				state: unapplied,
+			},
+			want: want{
+				cr:               []QuotaModifier{withExternalName("quota-guid"), withCreated(), withConditions(xpv1.Available())},
+				observed:         true,
+				o:                managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				CalledIdentifier: "quota-guid",
+			},
+		},
+		"CreatedDeleting": {
+			args: args{
+				cr:    []QuotaModifier{withExternalName("quota-guid"), withCreated(), withDeletionTimestamp()},
+				state: applied,
+			},
+			want: want{
+				cr:               []QuotaModifier{withExternalName("quota-guid"), withCreated(), withDeletionTimestamp(), withConditions(xpv1.Available())},
+				observed:         true,
+				o:                managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				CalledIdentifier: "quota-guid",
+			},
+		},
+		"Available": {
+			args: args{
+				cr:    []QuotaModifier{withExternalName("quota-guid")},
+				state: applied,
+			},
+			want: want{
+				cr:               []QuotaModifier{withExternalName("quota-guid"), withConditions(xpv1.Available())},
+				observed:         true,
+				o:                managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				CalledIdentifier: "quota-guid",
+			},
+		},
+	}
+
+	for kind, s := range scopes {
+		for name, tc := range cases {
+			t.Run(kind+"/"+name, func(t *testing.T) {
+				client, mock := s.client(tc.args.stub, tc.args.state)
+				e := external{scope: s.scope, client: client}
+				cr := s.cr("small", tc.args.cr...)
+				got, err := e.Observe(context.Background(), cr)
+				if diff := cmp.Diff(wantErr(tc.want.err, s.scope), err, test.EquateErrors()); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n", diff)
+				}
+				if diff := cmp.Diff(tc.want.CalledIdentifier, mock.CalledIdentifier); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want, +CalledIdentifier:\n", diff)
+				}
+				if diff := cmp.Diff(tc.want.o, got); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want, +got:\n", diff)
+				}
+				wantCr := tc.want.cr
+				if tc.want.observed {
+					wantCr = append([]QuotaModifier{s.observation(tc.args.state)}, wantCr...)
+				}
+				if diff := cmp.Diff(s.cr("small", wantCr...), cr); diff != "" {
+					t.Errorf("\ne.Observe(): expected cr after operation -want, +got:\n%s\n", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  []QuotaModifier
+		err string
+	}
+
+	cases := map[string]struct {
+		stub MaintainerMock
+		want want
+	}{
+		"ApiError": {
+			stub: MaintainerMock{err: apiError},
+			want: want{
+				cr:  []QuotaModifier{withExternalName("my-quota"), withConditions(xpv1.Creating())},
+				err: errCreateQuota,
+			},
+		},
+		"Successful": {
+			stub: MaintainerMock{createdID: "quota-guid"},
+			want: want{
+				cr: []QuotaModifier{withExternalName("quota-guid"), withConditions(xpv1.Creating())},
+			},
+		},
+	}
+
+	for kind, s := range scopes {
+		for name, tc := range cases {
+			t.Run(kind+"/"+name, func(t *testing.T) {
+				client, mock := s.client(tc.stub, missing)
+				e := external{scope: s.scope, client: client}
+				cr := s.cr("small", withExternalName("my-quota"))
+				_, err := e.Create(context.Background(), cr)
+				if diff := cmp.Diff(wantErr(tc.want.err, s.scope), err, test.EquateErrors()); diff != "" {
+					t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n", diff)
+				}
+				if diff := cmp.Diff("org-guid", mock.CalledIdentifier); diff != "" {
+					t.Errorf("\n%s\ne.Create(...): -want, +CalledIdentifier:\n", diff)
+				}
+				if diff := cmp.Diff(s.cr("small", tc.want.cr...), cr); diff != "" {
+					t.Errorf("\ne.Create(): expected cr after operation -want, +got:\n%s\n", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		cr               []QuotaModifier
+		CalledIdentifier string
+	}{
+		"AdoptedKept": {
+			cr: []QuotaModifier{withExternalName("quota-guid")},
+		},
+		"CreatedUpdated": {
+			cr:               []QuotaModifier{withExternalName("quota-guid"), withCreated()},
+			CalledIdentifier: "quota-guid",
+		},
+	}
+
+	for kind, s := range scopes {
+		for name, tc := range cases {
+			t.Run(kind+"/"+name, func(t *testing.T) {
+				client, mock := s.client(MaintainerMock{}, missing)
+				e := external{scope: s.scope, client: client}
+				if _, err := e.Update(context.Background(), s.cr("medium", tc.cr...)); err != nil {
+					t.Errorf("e.Update(...): %v", err)
+				}
+				if diff := cmp.Diff(tc.CalledIdentifier, mock.CalledIdentifier); diff != "" {
+					t.Errorf("\n%s\ne.Update(...): -want, +CalledIdentifier:\n", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		cr               []QuotaModifier
+		stub             MaintainerMock
+		err              string
+		CalledIdentifier string
+	}{
+		"AdoptedKept": {
+			cr: []QuotaModifier{withExternalName("other-guid")},
+		},
+		"CreatedDeleted": {
+			cr:               []QuotaModifier{withExternalName("other-guid"), withCreated()},
+			CalledIdentifier: "other-guid",
+		},
+		"ApiError": {
+			cr:               []QuotaModifier{withExternalName("other-guid"), withCreated()},
+			stub:             MaintainerMock{err: apiError},
+			err:              errDeleteQuota,
+			CalledIdentifier: "other-guid",
+		},
+	}
+
+	for kind, s := range scopes {
+		for name, tc := range cases {
+			t.Run(kind+"/"+name, func(t *testing.T) {
+				client, mock := s.client(tc.stub, missing)
+				e := external{scope: s.scope, client: client}
+				err := e.Delete(context.Background(), s.cr("medium", tc.cr...))
+				if diff := cmp.Diff(wantErr(tc.err, s.scope), err, test.EquateErrors()); diff != "" {
+					t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n", diff)
+				}
+				if diff := cmp.Diff(tc.CalledIdentifier, mock.CalledIdentifier); diff != "" {
+					t.Errorf("\n%s\ne.Delete(...): -want, +CalledIdentifier:\n", diff)
+				}
+			})
+		}
+	}
+}
+
+// wantErr forms the error expected for the given message of the scope, messages of the test cases wrap the api error
+func wantErr(msg string, scope Scope) error {
+	if msg == "" {
+		return nil
+	}
+	return errors.Wrapf(apiError, msg, scope.name)
+}
+
+type QuotaModifier func(quota resource.Managed)
+
+func modify(cr resource.Managed, m []QuotaModifier) resource.Managed {
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withConditions(c ...xpv1.Condition) QuotaModifier {
+	return func(r resource.Managed) { r.SetConditions(c...) }
+}
+
+func withExternalName(externalName string) QuotaModifier {
+	return func(r resource.Managed) { meta.SetExternalName(r, externalName) }
+}
+
+func withCreated() QuotaModifier {
+	return func(r resource.Managed) {
+		meta.SetExternalCreateSucceeded(r, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	}
+}
+
+func withDeletionTimestamp() QuotaModifier {
+	return func(r resource.Managed) {
+		deleted := metav1.NewTime(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+		r.SetDeletionTimestamp(&deleted)
+	}
+}
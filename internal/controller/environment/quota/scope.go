@@ -0,0 +1,195 @@
+package quota
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	cf "github.com/sap/crossplane-provider-btp/internal/clients/cloudfoundry"
+)
+
+// Scope adapts the quota reconciler to quotas applied to orgs or to spaces within an org
+type Scope struct {
+	// name of the org or space scope, used in messages
+	name string
+	// errNotQuota is returned for managed resources of another kind
+	errNotQuota string
+	asQuota     func(mg resource.Managed) (quota, bool)
+	newClientFn func(apiEndpoint string, serviceAccountSecretData []byte) (quotaClient, error)
+}
+
+// OrgScope reconciles OrganizationQuota resources
+var OrgScope = Scope{
+	name:        "org",
+	errNotQuota: "managed resource is not an OrganizationQuota custom resource",
+	asQuota: func(mg resource.Managed) (quota, bool) {
+		cr, ok := mg.(*v1alpha1.OrganizationQuota)
+		return orgQuota{cr}, ok
+	},
+	newClientFn: func(apiEndpoint string, serviceAccountSecretData []byte) (quotaClient, error) {
+		c, err := cf.NewClient(apiEndpoint, serviceAccountSecretData)
+		if err != nil {
+			return nil, err
+		}
+		return orgQuotaClient{cf.NewCfOrganizationQuotaMaintainer(c)}, nil
+	},
+}
+
+// SpaceScope reconciles SpaceQuota resources
+var SpaceScope = Scope{
+	name:        "space",
+	errNotQuota: "managed resource is not a SpaceQuota custom resource",
+	asQuota: func(mg resource.Managed) (quota, bool) {
+		cr, ok := mg.(*v1alpha1.SpaceQuota)
+		return spaceQuota{cr}, ok
+	},
+	newClientFn: func(apiEndpoint string, serviceAccountSecretData []byte) (quotaClient, error) {
+		c, err := cf.NewClient(apiEndpoint, serviceAccountSecretData)
+		if err != nil {
+			return nil, err
+		}
+		return spaceQuotaClient{cf.NewCfSpaceQuotaMaintainer(c)}, nil
+	},
+}
+
+// quota is the common view on OrganizationQuota and SpaceQuota
+type quota interface {
+	resource.Managed
+	// orgGuid returns the GUID of the org the quota is applied to or defined in
+	orgGuid() string
+	apiEndpoint() string
+}
+
+type orgQuota struct {
+	*v1alpha1.OrganizationQuota
+}
+
+func (q orgQuota) orgGuid() string {
+	return q.Spec.OrgGuid
+}
+
+func (q orgQuota) apiEndpoint() string {
+	return q.Spec.ApiEndpoint
+}
+
+type spaceQuota struct {
+	*v1alpha1.SpaceQuota
+}
+
+func (q spaceQuota) orgGuid() string {
+	return q.Spec.OrgGuid
+}
+
+func (q spaceQuota) apiEndpoint() string {
+	return q.Spec.ApiEndpoint
+}
+
+// quotaClient manages the quota definitions of one scope, it is only called with quotas of its scope
+type quotaClient interface {
+	// observe records the quota found for the given GUID or the name in the spec in the status and returns its GUID,
+	// the GUID is empty if the quota doesn't exist
+	observe(ctx context.Context, cr quota, guid string) (string, error)
+	isUpToDate(cr quota) bool
+	create(ctx context.Context, cr quota) (string, error)
+	update(ctx context.Context, cr quota, guid string) error
+	delete(ctx context.Context, cr quota, guid string) error
+}
+
+type OrganizationQuotaMaintainer interface {
+	GenerateObservation(ctx context.Context, guid string, name string) (v1alpha1.OrganizationQuotaObservation, error)
+
+	NeedsCreation(observation v1alpha1.OrganizationQuotaObservation) bool
+	IsUpToDate(params v1alpha1.OrganizationQuotaParameters, orgGuid string, observation v1alpha1.OrganizationQuotaObservation) bool
+
+	Create(ctx context.Context, orgGuid string, params v1alpha1.OrganizationQuotaParameters) (string, error)
+	Update(ctx context.Context, guid string, orgGuid string, params v1alpha1.OrganizationQuotaParameters) error
+	Delete(ctx context.Context, guid string, orgGuid string) error
+}
+
+var _ OrganizationQuotaMaintainer = &cf.CfOrganizationQuotaMaintainer{}
+
+type orgQuotaClient struct {
+	OrganizationQuotaMaintainer
+}
+
+func (c orgQuotaClient) observe(ctx context.Context, cr quota, guid string) (string, error) {
+	q := cr.(orgQuota)
+	obs, err := c.GenerateObservation(ctx, guid, q.Spec.ForProvider.Name)
+	if err != nil {
+		return "", err
+	}
+	q.Status.AtProvider = obs
+	if c.NeedsCreation(obs) {
+		return "", nil
+	}
+	return *obs.ID, nil
+}
+
+func (c orgQuotaClient) isUpToDate(cr quota) bool {
+	q := cr.(orgQuota)
+	return c.IsUpToDate(q.Spec.ForProvider, q.Spec.OrgGuid, q.Status.AtProvider)
+}
+
+func (c orgQuotaClient) create(ctx context.Context, cr quota) (string, error) {
+	q := cr.(orgQuota)
+	return c.Create(ctx, q.Spec.OrgGuid, q.Spec.ForProvider)
+}
+
+func (c orgQuotaClient) update(ctx context.Context, cr quota, guid string) error {
+	q := cr.(orgQuota)
+	return c.Update(ctx, guid, q.Spec.OrgGuid, q.Spec.ForProvider)
+}
+
+func (c orgQuotaClient) delete(ctx context.Context, cr quota, guid string) error {
+	return c.Delete(ctx, guid, cr.orgGuid())
+}
+
+type SpaceQuotaMaintainer interface {
+	GenerateObservation(ctx context.Context, guid string, orgGuid string, name string) (v1alpha1.SpaceQuotaObservation, error)
+
+	NeedsCreation(observation v1alpha1.SpaceQuotaObservation) bool
+	IsUpToDate(params v1alpha1.SpaceQuotaParameters, spaceGuids []string, observation v1alpha1.SpaceQuotaObservation) bool
+
+	Create(ctx context.Context, orgGuid string, params v1alpha1.SpaceQuotaParameters, spaceGuids []string) (string, error)
+	Update(ctx context.Context, guid string, params v1alpha1.SpaceQuotaParameters, spaceGuids []string) error
+	Delete(ctx context.Context, guid string) error
+}
+
+var _ SpaceQuotaMaintainer = &cf.CfSpaceQuotaMaintainer{}
+
+type spaceQuotaClient struct {
+	SpaceQuotaMaintainer
+}
+
+func (c spaceQuotaClient) observe(ctx context.Context, cr quota, guid string) (string, error) {
+	q := cr.(spaceQuota)
+	obs, err := c.GenerateObservation(ctx, guid, q.Spec.OrgGuid, q.Spec.ForProvider.Name)
+	if err != nil {
+		return "", err
+	}
+	q.Status.AtProvider = obs
+	if c.NeedsCreation(obs) {
+		return "", nil
+	}
+	return *obs.ID, nil
+}
+
+func (c spaceQuotaClient) isUpToDate(cr quota) bool {
+	q := cr.(spaceQuota)
+	return c.IsUpToDate(q.Spec.ForProvider, q.Spec.SpaceGuids, q.Status.AtProvider)
+}
+
+func (c spaceQuotaClient) create(ctx context.Context, cr quota) (string, error) {
+	q := cr.(spaceQuota)
+	return c.Create(ctx, q.Spec.OrgGuid, q.Spec.ForProvider, q.Spec.SpaceGuids)
+}
+
+func (c spaceQuotaClient) update(ctx context.Context, cr quota, guid string) error {
+	q := cr.(spaceQuota)
+	return c.Update(ctx, guid, q.Spec.ForProvider, q.Spec.SpaceGuids)
+}
+
+func (c spaceQuotaClient) delete(ctx context.Context, cr quota, guid string) error {
+	return c.Delete(ctx, guid)
+}
@@ -0,0 +1,36 @@
+package quota
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+// SetupOrganizationQuota adds a controller that reconciles OrganizationQuota managed resources.
+func SetupOrganizationQuota(mgr ctrl.Manager, o controller.Options) error {
+	return providerconfig.DefaultSetup(mgr, o, &v1alpha1.OrganizationQuota{}, v1alpha1.OrganizationQuotaGroupKind, v1alpha1.OrganizationQuotaGroupVersionKind, connectorFn(mgr, OrgScope))
+}
+
+// SetupSpaceQuota adds a controller that reconciles SpaceQuota managed resources.
+func SetupSpaceQuota(mgr ctrl.Manager, o controller.Options) error {
+	return providerconfig.DefaultSetup(mgr, o, &v1alpha1.SpaceQuota{}, v1alpha1.SpaceQuotaGroupKind, v1alpha1.SpaceQuotaGroupVersionKind, connectorFn(mgr, SpaceScope))
+}
+
+func connectorFn(mgr ctrl.Manager, scope Scope) func(kube client.Client, usage resource.Tracker, resourcetracker tracking.ReferenceResolverTracker, newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)) managed.ExternalConnecter {
+	return func(kube client.Client, usage resource.Tracker, resourcetracker tracking.ReferenceResolverTracker, newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)) managed.ExternalConnecter {
+		return &connector{
+			scope:           scope,
+			kube:            mgr.GetClient(),
+			usage:           resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
+			resourcetracker: resourcetracker,
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package role
+
+import (
+	"context"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+)
+
+// RoleMaintainerMock is a mock implementation of RoleMaintainer interface
+// returns stubed values and records called identifier to most methods
+type RoleMaintainerMock struct {
+	observation v1alpha1.RoleObservation
+	createdID   string
+	err         error
+	// for verification
+	CalledIdentifier string
+}
+
+var _ RoleMaintainer = &RoleMaintainerMock{}
+
+func (s *RoleMaintainerMock) ObserveOrgRole(ctx context.Context, guid string, orgGuid string, params v1alpha1.OrgRoleParameters) (v1alpha1.RoleObservation, error) {
+	s.CalledIdentifier = guid
+	return s.observation, s.err
+}
+
+func (s *RoleMaintainerMock) ObserveSpaceRole(ctx context.Context, guid string, spaceGuid string, params v1alpha1.SpaceRoleParameters) (v1alpha1.RoleObservation, error) {
+	s.CalledIdentifier = guid
+	return s.observation, s.err
+}
+
+func (s *RoleMaintainerMock) NeedsCreation(observation v1alpha1.RoleObservation) bool {
+	return observation.ID == nil
+}
+
+func (s *RoleMaintainerMock) CreateOrgRole(ctx context.Context, orgGuid string, params v1alpha1.OrgRoleParameters) (string, error) {
+	s.CalledIdentifier = orgGuid
+	return s.createdID, s.err
+}
+
+func (s *RoleMaintainerMock) CreateSpaceRole(ctx context.Context, spaceGuid string, params v1alpha1.SpaceRoleParameters) (string, error) {
+	s.CalledIdentifier = spaceGuid
+	return s.createdID, s.err
+}
+
+func (s *RoleMaintainerMock) Delete(ctx context.Context, guid string) error {
+	s.CalledIdentifier = guid
+	return s.err
+}
@@ -0,0 +1,188 @@
+package role
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	cf "github.com/sap/crossplane-provider-btp/internal/clients/cloudfoundry"
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errTrackRUsage  = "cannot track ResourceUsage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+	errNoScopeGuid  = "%sGuid is not set or not resolved yet"
+
+	errNewClient  = "cannot create new Service"
+	errGetRole    = "cannot get %s role"
+	errCreateRole = "cannot create %s role"
+	errDeleteRole = "cannot delete %s role"
+
+	errNotImplemented = "not implemented"
+)
+
+type RoleMaintainer interface {
+	ObserveOrgRole(ctx context.Context, guid string, orgGuid string, params v1alpha1.OrgRoleParameters) (v1alpha1.RoleObservation, error)
+	ObserveSpaceRole(ctx context.Context, guid string, spaceGuid string, params v1alpha1.SpaceRoleParameters) (v1alpha1.RoleObservation, error)
+
+	NeedsCreation(observation v1alpha1.RoleObservation) bool
+
+	CreateOrgRole(ctx context.Context, orgGuid string, params v1alpha1.OrgRoleParameters) (string, error)
+	CreateSpaceRole(ctx context.Context, spaceGuid string, params v1alpha1.SpaceRoleParameters) (string, error)
+	Delete(ctx context.Context, guid string) error
+}
+
+var _ RoleMaintainer = &cf.CfRoleMaintainer{}
+
+var configureRoleMaintainerFn = func(apiEndpoint string, serviceAccountSecretData []byte) (RoleMaintainer, error) {
+	c, err := cf.NewClient(apiEndpoint, serviceAccountSecretData)
+	if err != nil {
+		return nil, err
+	}
+	return cf.NewCfRoleMaintainer(c), nil
+}
+
+type connector struct {
+	scope           Scope
+	kube            client.Client
+	usage           resource.Tracker
+	resourcetracker tracking.ReferenceResolverTracker
+	newServiceFn    func(apiEndpoint string, serviceAccountSecretData []byte) (RoleMaintainer, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := c.scope.asRole(mg)
+	if !ok {
+		return nil, errors.New(c.scope.errNotRole)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+	if err := c.resourcetracker.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackRUsage)
+	}
+
+	if cr.scopeGuid() == "" {
+		return nil, errors.Errorf(errNoScopeGuid, c.scope.name)
+	}
+
+	pc, err := providerconfig.ResolveProviderConfig(ctx, mg, c.kube)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+	cd := pc.Spec.ServiceAccountSecret
+	serviceAccountSecretData, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(cr.apiEndpoint(), serviceAccountSecretData)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{scope: c.scope, client: svc}, nil
+}
+
+type external struct {
+	scope  Scope
+	client RoleMaintainer
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := c.scope.asRole(mg)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(c.scope.errNotRole)
+	}
+
+	obs, err := cr.observe(ctx, c.client, roleGuid(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrapf(err, errGetRole, c.scope.name)
+	}
+	cr.setObservation(obs)
+
+	if c.client.NeedsCreation(obs) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// an adopted role is left to the user on deletion, it is released by reporting it as gone
+	if meta.WasDeleted(cr) && !createdByProvider(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// a role the user already holds in the org or space is adopted
+	adopted := meta.GetExternalName(cr) != *obs.ID
+	if adopted {
+		meta.SetExternalName(cr, *obs.ID)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: adopted,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := c.scope.asRole(mg)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(c.scope.errNotRole)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	guid, err := cr.create(ctx, c.client)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrapf(err, errCreateRole, c.scope.name)
+	}
+	meta.SetExternalName(cr, guid)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// role assignments are immutable, enforced on schema level
+	return managed.ExternalUpdate{}, errors.New(errNotImplemented)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := c.scope.asRole(mg)
+	if !ok {
+		return errors.New(c.scope.errNotRole)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	if !createdByProvider(cr) {
+		return nil
+	}
+
+	return errors.Wrapf(c.client.Delete(ctx, meta.GetExternalName(cr)), errDeleteRole, c.scope.name)
+}
+
+// roleGuid returns the GUID stored as external name, empty as long as crossplane's default of the resource name is in place
+func roleGuid(cr resource.Managed) string {
+	if guid := meta.GetExternalName(cr); guid != cr.GetName() {
+		return guid
+	}
+	return ""
+}
+
+// createdByProvider tells roles assigned by this provider apart from adopted ones the user held before,
+// only the former are revoked. The managed reconciler records every successful Create.
+func createdByProvider(cr resource.Managed) bool {
+	return !meta.GetExternalCreateSucceeded(cr).IsZero()
+}
@@ -0,0 +1,291 @@
+package role
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+var (
+	apiError = errors.New("apiError")
+)
+
+// scopes lists every scope with a constructor for its role resources, each test case runs for all of them
+var scopes = map[string]struct {
+	scope Scope
+	guid  string
+	cr    func(m ...RoleModifier) resource.Managed
+}{
+	"OrgRole": {
+		scope: OrgScope,
+		guid:  "org-guid",
+		cr: func(m ...RoleModifier) resource.Managed {
+			cr := &v1alpha1.OrgRole{
+				ObjectMeta: metav1.ObjectMeta{Name: "manager-jane"},
+				Spec: v1alpha1.OrgRoleSpec{
+					ForProvider: v1alpha1.OrgRoleParameters{Type: v1alpha1.OrgRoleManager, Username: "jane@example.com"},
+					OrgGuid:     "org-guid",
+				},
+			}
+			return modify(cr, m)
+		},
+	},
+	"SpaceRole": {
+		scope: SpaceScope,
+		guid:  "space-guid",
+		cr: func(m ...RoleModifier) resource.Managed {
+			cr := &v1alpha1.SpaceRole{
+				ObjectMeta: metav1.ObjectMeta{Name: "manager-jane"},
+				Spec: v1alpha1.SpaceRoleSpec{
+					ForProvider: v1alpha1.SpaceRoleParameters{Type: v1alpha1.SpaceRoleManager, Username: "jane@example.com"},
+					SpaceGuid:   "space-guid",
+				},
+			}
+			return modify(cr, m)
+		},
+	},
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr               []RoleModifier
+		o                managed.ExternalObservation
+		err              string
+		CalledIdentifier string
+	}
+
+	existingRole := v1alpha1.RoleObservation{ID: internal.Ptr("role-guid"), UserID: internal.Ptr("user-guid")}
+
+	cases := map[string]struct {
+		cr     []RoleModifier
+		client *RoleMaintainerMock
+		want   want
+	}{
+		"LookupError": {
+			cr:     []RoleModifier{withExternalName("role-guid")},
+			client: &RoleMaintainerMock{err: apiError},
+			want: want{
+				cr:               []RoleModifier{withExternalName("role-guid")},
+				err:              errGetRole,
+				CalledIdentifier: "role-guid",
+			},
+		},
+		"NeedsCreation": {
+			cr:     []RoleModifier{withExternalName("manager-jane")},
+			client: &RoleMaintainerMock{},
+			want: want{
+				cr: []RoleModifier{withExternalName("manager-jane")},
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Adopted": {
+			cr:     []RoleModifier{withExternalName("manager-jane")},
+			client: &RoleMaintainerMock{observation: existingRole},
+			want: want{
+				cr: []RoleModifier{withExternalName("role-guid"), withObservation(existingRole), withConditions(xpv1.Available())},
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"Available": {
+			cr:     []RoleModifier{withExternalName("role-guid")},
+			client: &RoleMaintainerMock{observation: existingRole},
+			want: want{
+				cr:               []RoleModifier{withExternalName("role-guid"), withObservation(existingRole), withConditions(xpv1.Available())},
+				o:                managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				CalledIdentifier: "role-guid",
+			},
+		},
+		"AdoptedReleasedOnDeletion": {
+			cr:     []RoleModifier{withExternalName("role-guid"), withDeletionTimestamp()},
+			client: &RoleMaintainerMock{observation: existingRole},
+			want: want{
+				cr:               []RoleModifier{withExternalName("role-guid"), withDeletionTimestamp(), withObservation(existingRole)},
+				o:                managed.ExternalObservation{ResourceExists: false},
+				CalledIdentifier: "role-guid",
+			},
+		},
+		"CreatedDeleted": {
+			cr:     []RoleModifier{withExternalName("role-guid"), withCreated(), withDeletionTimestamp()},
+			client: &RoleMaintainerMock{observation: existingRole},
+			want: want{
+				cr:               []RoleModifier{withExternalName("role-guid"), withCreated(), withDeletionTimestamp(), withObservation(existingRole), withConditions(xpv1.Available())},
+				o:                managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				CalledIdentifier: "role-guid",
+			},
+		},
+	}
+
+	for kind, s := range scopes {
+		for name, tc := range cases {
+			t.Run(kind+"/"+name, func(t *testing.T) {
+				client := *tc.client
+				e := external{scope: s.scope, client: &client}
+				cr := s.cr(tc.cr...)
+				got, err := e.Observe(context.Background(), cr)
+				if diff := cmp.Diff(wantErr(tc.want.err, s.scope), err, test.EquateErrors()); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n", diff)
+				}
+				if diff := cmp.Diff(tc.want.CalledIdentifier, client.CalledIdentifier); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want, +CalledIdentifier:\n", diff)
+				}
+				if diff := cmp.Diff(tc.want.o, got); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want, +got:\n", diff)
+				}
+				if diff := cmp.Diff(s.cr(tc.want.cr...), cr); diff != "" {
+					t.Errorf("\ne.Observe(): expected cr after operation -want, +got:\n%s\n", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  []RoleModifier
+		err string
+	}
+
+	cases := map[string]struct {
+		client *RoleMaintainerMock
+		want   want
+	}{
+		"ApiError": {
+			client: &RoleMaintainerMock{err: apiError},
+			want: want{
+				cr:  []RoleModifier{withExternalName("manager-jane"), withConditions(xpv1.Creating())},
+				err: errCreateRole,
+			},
+		},
+		"Successful": {
+			client: &RoleMaintainerMock{createdID: "role-guid"},
+			want: want{
+				cr: []RoleModifier{withExternalName("role-guid"), withConditions(xpv1.Creating())},
+			},
+		},
+	}
+
+	for kind, s := range scopes {
+		for name, tc := range cases {
+			t.Run(kind+"/"+name, func(t *testing.T) {
+				client := *tc.client
+				e := external{scope: s.scope, client: &client}
+				cr := s.cr(withExternalName("manager-jane"))
+				_, err := e.Create(context.Background(), cr)
+				if diff := cmp.Diff(wantErr(tc.want.err, s.scope), err, test.EquateErrors()); diff != "" {
+					t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n", diff)
+				}
+				if diff := cmp.Diff(s.guid, client.CalledIdentifier); diff != "" {
+					t.Errorf("\n%s\ne.Create(...): -want, +CalledIdentifier:\n", diff)
+				}
+				if diff := cmp.Diff(s.cr(tc.want.cr...), cr); diff != "" {
+					t.Errorf("\ne.Create(): expected cr after operation -want, +got:\n%s\n", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err              string
+		CalledIdentifier string
+	}
+
+	cases := map[string]struct {
+		cr     []RoleModifier
+		client *RoleMaintainerMock
+		want   want
+	}{
+		"AdoptedKept": {
+			cr:     []RoleModifier{withExternalName("role-guid")},
+			client: &RoleMaintainerMock{},
+			want:   want{},
+		},
+		"CreatedRevoked": {
+			cr:     []RoleModifier{withExternalName("role-guid"), withCreated()},
+			client: &RoleMaintainerMock{},
+			want:   want{CalledIdentifier: "role-guid"},
+		},
+		"ApiError": {
+			cr:     []RoleModifier{withExternalName("role-guid"), withCreated()},
+			client: &RoleMaintainerMock{err: apiError},
+			want:   want{err: errDeleteRole, CalledIdentifier: "role-guid"},
+		},
+	}
+
+	for kind, s := range scopes {
+		for name, tc := range cases {
+			t.Run(kind+"/"+name, func(t *testing.T) {
+				client := *tc.client
+				e := external{scope: s.scope, client: &client}
+				err := e.Delete(context.Background(), s.cr(tc.cr...))
+				if diff := cmp.Diff(wantErr(tc.want.err, s.scope), err, test.EquateErrors()); diff != "" {
+					t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n", diff)
+				}
+				if diff := cmp.Diff(tc.want.CalledIdentifier, client.CalledIdentifier); diff != "" {
+					t.Errorf("\n%s\ne.Delete(...): -want, +CalledIdentifier:\n", diff)
+				}
+			})
+		}
+	}
+}
+
+// wantErr forms the error expected for the given message of the scope, messages of the test cases wrap the api error
+func wantErr(msg string, scope Scope) error {
+	if msg == "" {
+		return nil
+	}
+	return errors.Wrapf(apiError, msg, scope.name)
+}
+
+type RoleModifier func(role resource.Managed)
+
+func modify(cr resource.Managed, m []RoleModifier) resource.Managed {
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withConditions(c ...xpv1.Condition) RoleModifier {
+	return func(r resource.Managed) { r.SetConditions(c...) }
+}
+
+func withExternalName(externalName string) RoleModifier {
+	return func(r resource.Managed) { meta.SetExternalName(r, externalName) }
+}
+
+func withObservation(o v1alpha1.RoleObservation) RoleModifier {
+	return func(r resource.Managed) {
+		switch cr := r.(type) {
+		case *v1alpha1.OrgRole:
+			cr.Status.AtProvider = o
+		case *v1alpha1.SpaceRole:
+			cr.Status.AtProvider = o
+		}
+	}
+}
+
+func withCreated() RoleModifier {
+	return func(r resource.Managed) {
+		meta.SetExternalCreateSucceeded(r, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	}
+}
+
+func withDeletionTimestamp() RoleModifier {
+	return func(r resource.Managed) {
+		r.SetDeletionTimestamp(&metav1.Time{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)})
+	}
+}
@@ -0,0 +1,97 @@
+package role
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+)
+
+// Scope adapts the role reconciler to roles assigned within an org or within a space
+type Scope struct {
+	// name of the org or space scope, used in messages
+	name string
+	// errNotRole is returned for managed resources of another kind
+	errNotRole string
+	asRole     func(mg resource.Managed) (role, bool)
+}
+
+// OrgScope reconciles OrgRole resources
+var OrgScope = Scope{
+	name:       "org",
+	errNotRole: "managed resource is not an OrgRole custom resource",
+	asRole: func(mg resource.Managed) (role, bool) {
+		cr, ok := mg.(*v1alpha1.OrgRole)
+		return orgRole{cr}, ok
+	},
+}
+
+// SpaceScope reconciles SpaceRole resources
+var SpaceScope = Scope{
+	name:       "space",
+	errNotRole: "managed resource is not a SpaceRole custom resource",
+	asRole: func(mg resource.Managed) (role, bool) {
+		cr, ok := mg.(*v1alpha1.SpaceRole)
+		return spaceRole{cr}, ok
+	},
+}
+
+// role is the common view on OrgRole and SpaceRole
+type role interface {
+	resource.Managed
+	// scopeGuid returns the GUID of the org or space the role is assigned in
+	scopeGuid() string
+	apiEndpoint() string
+	setObservation(obs v1alpha1.RoleObservation)
+	observe(ctx context.Context, client RoleMaintainer, guid string) (v1alpha1.RoleObservation, error)
+	create(ctx context.Context, client RoleMaintainer) (string, error)
+}
+
+type orgRole struct {
+	*v1alpha1.OrgRole
+}
+
+func (r orgRole) scopeGuid() string {
+	return r.Spec.OrgGuid
+}
+
+func (r orgRole) apiEndpoint() string {
+	return r.Spec.ApiEndpoint
+}
+
+func (r orgRole) setObservation(obs v1alpha1.RoleObservation) {
+	r.Status.AtProvider = obs
+}
+
+func (r orgRole) observe(ctx context.Context, client RoleMaintainer, guid string) (v1alpha1.RoleObservation, error) {
+	return client.ObserveOrgRole(ctx, guid, r.Spec.OrgGuid, r.Spec.ForProvider)
+}
+
+func (r orgRole) create(ctx context.Context, client RoleMaintainer) (string, error) {
+	return client.CreateOrgRole(ctx, r.Spec.OrgGuid, r.Spec.ForProvider)
+}
+
+type spaceRole struct {
+	*v1alpha1.SpaceRole
+}
+
+func (r spaceRole) scopeGuid() string {
+	return r.Spec.SpaceGuid
+}
+
+func (r spaceRole) apiEndpoint() string {
+	return r.Spec.ApiEndpoint
+}
+
+func (r spaceRole) setObservation(obs v1alpha1.RoleObservation) {
+	r.Status.AtProvider = obs
+}
+
+func (r spaceRole) observe(ctx context.Context, client RoleMaintainer, guid string) (v1alpha1.RoleObservation, error) {
+	return client.ObserveSpaceRole(ctx, guid, r.Spec.SpaceGuid, r.Spec.ForProvider)
+}
+
+func (r spaceRole) create(ctx context.Context, client RoleMaintainer) (string, error) {
+	return client.CreateSpaceRole(ctx, r.Spec.SpaceGuid, r.Spec.ForProvider)
+}
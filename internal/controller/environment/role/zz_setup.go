@@ -0,0 +1,37 @@
+package role
+
+import (
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+// SetupOrgRole adds a controller that reconciles OrgRole managed resources.
+func SetupOrgRole(mgr ctrl.Manager, o controller.Options) error {
+	return providerconfig.DefaultSetup(mgr, o, &v1alpha1.OrgRole{}, v1alpha1.OrgRoleGroupKind, v1alpha1.OrgRoleGroupVersionKind, connectorFn(mgr, OrgScope))
+}
+
+// SetupSpaceRole adds a controller that reconciles SpaceRole managed resources.
+func SetupSpaceRole(mgr ctrl.Manager, o controller.Options) error {
+	return providerconfig.DefaultSetup(mgr, o, &v1alpha1.SpaceRole{}, v1alpha1.SpaceRoleGroupKind, v1alpha1.SpaceRoleGroupVersionKind, connectorFn(mgr, SpaceScope))
+}
+
+func connectorFn(mgr ctrl.Manager, scope Scope) func(kube client.Client, usage resource.Tracker, resourcetracker tracking.ReferenceResolverTracker, newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)) managed.ExternalConnecter {
+	return func(kube client.Client, usage resource.Tracker, resourcetracker tracking.ReferenceResolverTracker, newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error)) managed.ExternalConnecter {
+		return &connector{
+			scope:           scope,
+			kube:            mgr.GetClient(),
+			usage:           resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
+			newServiceFn:    configureRoleMaintainerFn,
+			resourcetracker: resourcetracker,
+		}
+	}
+}
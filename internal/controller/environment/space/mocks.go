@@ -0,0 +1,48 @@
+package space
+
+import (
+	"context"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+// SpaceMaintainerMock is a mock implementation of SpaceMaintainer interface
+// returns stubed values and records called identifier to most methods
+type SpaceMaintainerMock struct {
+	generateObservation v1alpha1.SpaceObservation
+	createdID           string
+	err                 error
+	// for verification
+	CalledIdentifier string
+}
+
+var _ SpaceMaintainer = &SpaceMaintainerMock{}
+
+func (s *SpaceMaintainerMock) GenerateObservation(ctx context.Context, guid string, orgGuid string, name string) (v1alpha1.SpaceObservation, error) {
+	s.CalledIdentifier = guid
+	return s.generateObservation, s.err
+}
+
+func (s *SpaceMaintainerMock) NeedsCreation(observation v1alpha1.SpaceObservation) bool {
+	return observation.ID == nil
+}
+
+func (s *SpaceMaintainerMock) IsUpToDate(params v1alpha1.SpaceParameters, observation v1alpha1.SpaceObservation) bool {
+	return internal.Val(observation.Name) == params.Name
+}
+
+func (s *SpaceMaintainerMock) Create(ctx context.Context, orgGuid string, params v1alpha1.SpaceParameters) (string, error) {
+	s.CalledIdentifier = orgGuid
+	return s.createdID, s.err
+}
+
+func (s *SpaceMaintainerMock) Update(ctx context.Context, guid string, params v1alpha1.SpaceParameters) error {
+	s.CalledIdentifier = guid
+	return s.err
+}
+
+func (s *SpaceMaintainerMock) Delete(ctx context.Context, guid string) error {
+	s.CalledIdentifier = guid
+	return s.err
+}
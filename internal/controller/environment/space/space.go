@@ -0,0 +1,191 @@
+package space
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	cf "github.com/sap/crossplane-provider-btp/internal/clients/cloudfoundry"
+	"github.com/sap/crossplane-provider-btp/internal/controller/providerconfig"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errNotSpace     = "managed resource is not a Space custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errTrackRUsage  = "cannot track ResourceUsage"
+	errGetPC        = "cannot get ProviderConfig"
+	errGetCreds     = "cannot get credentials"
+	errNoOrgGuid    = "orgGuid is not set or not resolved yet"
+
+	errNewClient   = "cannot create new Service"
+	errGetSpace    = "cannot get space"
+	errCreateSpace = "cannot create space"
+	errUpdateSpace = "cannot update space"
+	errDeleteSpace = "cannot delete space"
+)
+
+type SpaceMaintainer interface {
+	GenerateObservation(ctx context.Context, guid string, orgGuid string, name string) (v1alpha1.SpaceObservation, error)
+
+	NeedsCreation(observation v1alpha1.SpaceObservation) bool
+	IsUpToDate(params v1alpha1.SpaceParameters, observation v1alpha1.SpaceObservation) bool
+
+	Create(ctx context.Context, orgGuid string, params v1alpha1.SpaceParameters) (string, error)
+	Update(ctx context.Context, guid string, params v1alpha1.SpaceParameters) error
+	Delete(ctx context.Context, guid string) error
+}
+
+var _ SpaceMaintainer = &cf.CfSpaceMaintainer{}
+
+var configureSpaceMaintainerFn = func(apiEndpoint string, serviceAccountSecretData []byte) (SpaceMaintainer, error) {
+	c, err := cf.NewClient(apiEndpoint, serviceAccountSecretData)
+	if err != nil {
+		return nil, err
+	}
+	return cf.NewCfSpaceMaintainer(c), nil
+}
+
+type connector struct {
+	kube            client.Client
+	usage           resource.Tracker
+	resourcetracker tracking.ReferenceResolverTracker
+	newServiceFn    func(apiEndpoint string, serviceAccountSecretData []byte) (SpaceMaintainer, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Space)
+	if !ok {
+		return nil, errors.New(errNotSpace)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+	if err := c.resourcetracker.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackRUsage)
+	}
+
+	if cr.Spec.OrgGuid == "" {
+		return nil, errors.New(errNoOrgGuid)
+	}
+
+	pc, err := providerconfig.ResolveProviderConfig(ctx, mg, c.kube)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+	cd := pc.Spec.ServiceAccountSecret
+	serviceAccountSecretData, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	svc, err := c.newServiceFn(cr.Spec.ApiEndpoint, serviceAccountSecretData)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc}, nil
+}
+
+type external struct {
+	client SpaceMaintainer
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Space)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSpace)
+	}
+
+	obs, err := c.client.GenerateObservation(ctx, spaceGuid(cr), cr.Spec.OrgGuid, cr.Spec.ForProvider.Name)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSpace)
+	}
+	cr.Status.AtProvider = obs
+
+	if c.client.NeedsCreation(obs) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// an adopted space is left to the user on deletion, it is released by reporting it as gone
+	if meta.WasDeleted(cr) && !createdByProvider(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// a space with the same name that already exists in the org is adopted
+	adopted := meta.GetExternalName(cr) != *obs.ID
+	if adopted {
+		meta.SetExternalName(cr, *obs.ID)
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        c.client.IsUpToDate(cr.Spec.ForProvider, obs),
+		ResourceLateInitialized: adopted,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Space)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSpace)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	guid, err := c.client.Create(ctx, cr.Spec.OrgGuid, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSpace)
+	}
+	meta.SetExternalName(cr, guid)
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Space)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSpace)
+	}
+
+	err := c.client.Update(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSpace)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Space)
+	if !ok {
+		return errors.New(errNotSpace)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	if !createdByProvider(cr) {
+		return nil
+	}
+
+	return errors.Wrap(c.client.Delete(ctx, meta.GetExternalName(cr)), errDeleteSpace)
+}
+
+// spaceGuid returns the GUID stored as external name, empty as long as crossplane's default of the resource name is in place
+func spaceGuid(cr *v1alpha1.Space) string {
+	if guid := meta.GetExternalName(cr); guid != cr.GetName() {
+		return guid
+	}
+	return ""
+}
+
+// createdByProvider tells spaces created by this provider apart from adopted ones that existed before,
+// only the former are deleted. The managed reconciler records every successful Create.
+func createdByProvider(cr resource.Managed) bool {
+	return !meta.GetExternalCreateSucceeded(cr).IsZero()
+}
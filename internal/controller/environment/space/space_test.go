@@ -0,0 +1,278 @@
+package space
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/environment/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+var (
+	apiError = errors.New("apiError")
+)
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		cr     *v1alpha1.Space
+		client *SpaceMaintainerMock
+	}
+
+	type want struct {
+		cr               *v1alpha1.Space
+		o                managed.ExternalObservation
+		err              error
+		CalledIdentifier string
+	}
+
+	existingSpace := v1alpha1.SpaceObservation{
+		ID:   internal.Ptr("space-guid"),
+		Name: internal.Ptr("dev"),
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"LookupError": {
+			args: args{
+				cr:     cr("dev", withExternalName("space-guid")),
+				client: &SpaceMaintainerMock{err: apiError},
+			},
+			want: want{
+				cr:               cr("dev", withExternalName("space-guid")),
+				err:              errors.Wrap(apiError, errGetSpace),
+				CalledIdentifier: "space-guid",
+			},
+		},
+		"NeedsCreation": {
+			args: args{
+				cr:     cr("dev", withExternalName("my-space")),
+				client: &SpaceMaintainerMock{},
+			},
+			want: want{
+				cr: cr("dev", withExternalName("my-space")),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Adopted": {
+			args: args{
+				cr:     cr("dev", withExternalName("my-space")),
+				client: &SpaceMaintainerMock{generateObservation: existingSpace},
+			},
+			want: want{
+				cr: cr("dev", withExternalName("space-guid"), withObservation(existingSpace), withConditions(xpv1.Available())),
+				o:  managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+			},
+		},
+		"AdoptedReleasedOnDeletion": {
+			args: args{
+				cr:     cr("dev", withExternalName("space-guid"), withDeletionTimestamp()),
+				client: &SpaceMaintainerMock{generateObservation: existingSpace},
+			},
+			want: want{
+				cr:               cr("dev", withExternalName("space-guid"), withDeletionTimestamp(), withObservation(existingSpace)),
+				o:                managed.ExternalObservation{ResourceExists: false},
+				CalledIdentifier: "space-guid",
+			},
+		},
+		"CreatedObservedOnDeletion": {
+			args: args{
+				cr:     cr("dev", withExternalName("space-guid"), withCreated(), withDeletionTimestamp()),
+				client: &SpaceMaintainerMock{generateObservation: existingSpace},
+			},
+			want: want{
+				cr:               cr("dev", withExternalName("space-guid"), withCreated(), withDeletionTimestamp(), withObservation(existingSpace), withConditions(xpv1.Available())),
+				o:                managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				CalledIdentifier: "space-guid",
+			},
+		},
+		"Available": {
+			args: args{
+				cr:     cr("dev", withExternalName("space-guid")),
+				client: &SpaceMaintainerMock{generateObservation: existingSpace},
+			},
+			want: want{
+				cr:               cr("dev", withExternalName("space-guid"), withObservation(existingSpace), withConditions(xpv1.Available())),
+				o:                managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				CalledIdentifier: "space-guid",
+			},
+		},
+		"Renamed": {
+			args: args{
+				cr:     cr("test", withExternalName("space-guid")),
+				client: &SpaceMaintainerMock{generateObservation: existingSpace},
+			},
+			want: want{
+				cr:               cr("test", withExternalName("space-guid"), withObservation(existingSpace), withConditions(xpv1.Available())),
+				o:                managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				CalledIdentifier: "space-guid",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.args.client}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.CalledIdentifier, tc.args.client.CalledIdentifier); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +CalledIdentifier:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("\ne.Observe(): expected cr after operation -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr               *v1alpha1.Space
+		err              error
+		CalledIdentifier string
+	}
+
+	cases := map[string]struct {
+		client *SpaceMaintainerMock
+		want   want
+	}{
+		"ApiError": {
+			client: &SpaceMaintainerMock{err: apiError},
+			want: want{
+				cr:               cr("dev", withExternalName("my-space"), withConditions(xpv1.Creating())),
+				err:              errors.Wrap(apiError, errCreateSpace),
+				CalledIdentifier: "org-guid",
+			},
+		},
+		"Successful": {
+			client: &SpaceMaintainerMock{createdID: "space-guid"},
+			want: want{
+				cr:               cr("dev", withExternalName("space-guid"), withConditions(xpv1.Creating())),
+				CalledIdentifier: "org-guid",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			space := cr("dev", withExternalName("my-space"))
+			_, err := e.Create(context.Background(), space)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.CalledIdentifier, tc.client.CalledIdentifier); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +CalledIdentifier:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, space); diff != "" {
+				t.Errorf("\ne.Create(): expected cr after operation -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	client := &SpaceMaintainerMock{}
+	e := external{client: client}
+
+	if _, err := e.Update(context.Background(), cr("test", withExternalName("space-guid"))); err != nil || client.CalledIdentifier != "space-guid" {
+		t.Errorf("e.Update(...) = %v, called with %s", err, client.CalledIdentifier)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err              error
+		CalledIdentifier string
+	}
+
+	cases := map[string]struct {
+		cr     *v1alpha1.Space
+		client *SpaceMaintainerMock
+		want   want
+	}{
+		"AdoptedKept": {
+			cr:     cr("test", withExternalName("space-guid")),
+			client: &SpaceMaintainerMock{},
+			want:   want{},
+		},
+		"CreatedDeleted": {
+			cr:     cr("test", withExternalName("space-guid"), withCreated()),
+			client: &SpaceMaintainerMock{},
+			want:   want{CalledIdentifier: "space-guid"},
+		},
+		"ApiError": {
+			cr:     cr("test", withExternalName("other-guid"), withCreated()),
+			client: &SpaceMaintainerMock{err: apiError},
+			want:   want{err: errors.Wrap(apiError, errDeleteSpace), CalledIdentifier: "other-guid"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.CalledIdentifier, tc.client.CalledIdentifier); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want, +CalledIdentifier:\n", diff)
+			}
+		})
+	}
+}
+
+type SpaceModifier func(space *v1alpha1.Space)
+
+func cr(name string, m ...SpaceModifier) *v1alpha1.Space {
+	cr := &v1alpha1.Space{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-space"},
+		Spec: v1alpha1.SpaceSpec{
+			ForProvider: v1alpha1.SpaceParameters{Name: name},
+			OrgGuid:     "org-guid",
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withConditions(c ...xpv1.Condition) SpaceModifier {
+	return func(r *v1alpha1.Space) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(externalName string) SpaceModifier {
+	return func(r *v1alpha1.Space) { meta.SetExternalName(r, externalName) }
+}
+
+func withObservation(o v1alpha1.SpaceObservation) SpaceModifier {
+	return func(r *v1alpha1.Space) { r.Status.AtProvider = o }
+}
+
+func withCreated() SpaceModifier {
+	return func(r *v1alpha1.Space) {
+		meta.SetExternalCreateSucceeded(r, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	}
+}
+
+func withDeletionTimestamp() SpaceModifier {
+	return func(r *v1alpha1.Space) {
+		r.SetDeletionTimestamp(&metav1.Time{Time: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)})
+	}
+}
@@ -30,6 +30,9 @@ const (
 	errCisSecretEmpty     = "CIS Secret is empty or nil, please check config & secrets referenced in provider config"
 	errCisSecretCorrupted = "CIS Secret does not match expected format"
 	errCFSecretEmpty      = "CF Secret is empty or nil, please check config & secrets referenced in provider config"
+
+	errNoCloudManagementSecret  = "No Cloud Management Secret Found"
+	errGetCloudManagementSecret = "Could not get secret of local cloud management"
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
@@ -103,6 +106,58 @@ func CreateClient(
 	return svc, errors.Wrap(err, errNewClient)
 }
 
+// CreateCloudManagementClient creates a client from the binding of a CloudManagement instance stored in the given secret
+// instead of the CIS credentials of the ProviderConfig, as needed to manage the environments of a subaccount
+func CreateCloudManagementClient(
+	ctx context.Context,
+	mg resource.Managed,
+	kube client.Client,
+	track resource.Tracker,
+	resourcetracker tracking.ReferenceResolverTracker,
+	secretName string,
+	secretNamespace string,
+	newServiceFn func(cisSecretData []byte, serviceAccountSecretData []byte) (*btp.Client, error),
+) (*btp.Client, error) {
+	pc, err := ResolveProviderConfig(ctx, mg, kube)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	if err = track.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	if err = resourcetracker.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackRUsage)
+	}
+
+	if secretName == "" || secretNamespace == "" {
+		return nil, errors.New(errNoCloudManagementSecret)
+	}
+	secret := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: secretNamespace, Name: secretName}, secret); err != nil {
+		return nil, errors.Wrap(err, errGetCloudManagementSecret)
+	}
+	cisBinding := secret.Data[v1alpha1.RawBindingKey]
+	if cisBinding == nil {
+		return nil, errors.New(errGetCloudManagementSecret)
+	}
+
+	cd := pc.Spec.ServiceAccountSecret
+	ServiceAccountSecretData, err := resource.CommonCredentialExtractor(
+		ctx,
+		cd.Source,
+		kube,
+		cd.CommonCredentialSelectors,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCFCreds)
+	}
+
+	svc, err := newServiceFn(cisBinding, ServiceAccountSecretData)
+	return svc, errors.Wrap(err, errNewClient)
+}
+
 func ResolveProviderConfig(ctx context.Context, mg resource.Managed, kube client.Client) (*v1alpha1.ProviderConfig, error) {
 	pc := &v1alpha1.ProviderConfig{}
 	err := kube.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc)
@@ -0,0 +1,81 @@
+package providerconfig
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errResolveReferences = "cannot resolve references"
+	errPatchManaged      = "cannot patch the managed resource"
+)
+
+// CredentialsReferencer is implemented by resources reading their credentials from the connection secret of a referenced
+// ServiceManager or CloudManagement
+type CredentialsReferencer interface {
+	CredentialsReference() *xpv1.Reference
+}
+
+// CredentialsReferenceResolver resolves references like the managed.APISimpleReferenceResolver, but the credentials reference
+// of a CredentialsReferencer is resolved as if its resolve policy was Always unless a policy is set explicitly.
+// That way the credentials secret follows the referenced resource, instead of sticking to the secret resolved first.
+type CredentialsReferenceResolver struct {
+	client   client.Client
+	fallback managed.ReferenceResolver
+}
+
+// NewCredentialsReferenceResolver returns a CredentialsReferenceResolver
+func NewCredentialsReferenceResolver(c client.Client) *CredentialsReferenceResolver {
+	return &CredentialsReferenceResolver{client: c, fallback: managed.NewAPISimpleReferenceResolver(c)}
+}
+
+// ResolveReferences of the supplied managed resource and patches it in case the resolved values changed
+func (r *CredentialsReferenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(CredentialsReferencer)
+	if !ok {
+		return r.fallback.ResolveReferences(ctx, mg)
+	}
+	rr, ok := mg.(interface {
+		ResolveReferences(context.Context, client.Reader) error
+	})
+	if !ok {
+		return nil
+	}
+
+	existing := mg.DeepCopyObject().(client.Object)
+	restore := resolveAlways(cr.CredentialsReference())
+	err := rr.ResolveReferences(ctx, r.client)
+	// the policy is only applied while resolving, it must not end up in the spec
+	restore()
+	if err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+
+	if cmp.Equal(existing, mg, cmpopts.EquateEmpty()) {
+		return nil
+	}
+	return errors.Wrap(r.client.Patch(ctx, mg, client.MergeFrom(existing)), errPatchManaged)
+}
+
+// resolveAlways sets the resolve policy of the reference to Always, unless set explicitly, and returns a func restoring it
+func resolveAlways(ref *xpv1.Reference) func() {
+	if ref == nil || (ref.Policy != nil && ref.Policy.Resolve != nil) {
+		return func() {}
+	}
+	policy := ref.Policy
+	ref.Policy = &xpv1.Policy{Resolve: ptr.To(xpv1.ResolvePolicyAlways)}
+	if policy != nil {
+		ref.Policy.Resolution = policy.Resolution
+	}
+	return func() {
+		ref.Policy = policy
+	}
+}
@@ -0,0 +1,82 @@
+package providerconfig
+
+import (
+	"context"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/account/v1alpha1"
+)
+
+func TestCredentialsReferenceResolver(t *testing.T) {
+	serviceManager := func(secret string) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			sm := obj.(*v1alpha1.ServiceManager)
+			sm.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: secret, Namespace: "ns"}
+			return nil
+		}
+	}
+	instance := func(secret string, policy *xpv1.Policy) *v1alpha1.ServiceInstance {
+		cr := &v1alpha1.ServiceInstance{}
+		cr.Spec.ForProvider.ServiceManagerRef = &xpv1.Reference{Name: "sm", Policy: policy}
+		cr.Spec.ForProvider.ServiceManagerSecret = secret
+		cr.Spec.ForProvider.ServiceManagerSecretNamespace = "ns"
+		return cr
+	}
+	ifNotPresent := &xpv1.Policy{Resolve: ptr.To(xpv1.ResolvePolicy("IfNotPresent"))}
+
+	cases := map[string]struct {
+		reason      string
+		cr          *v1alpha1.ServiceInstance
+		kubeSecret  string
+		wantCr      *v1alpha1.ServiceInstance
+		wantPatched bool
+	}{
+		"FollowsReferencedSecret": {
+			reason:      "the secret resolved before is replaced by the current connection secret of the ServiceManager, without persisting a policy",
+			cr:          instance("old-secret", nil),
+			kubeSecret:  "new-secret",
+			wantCr:      instance("new-secret", nil),
+			wantPatched: true,
+		},
+		"Unchanged": {
+			reason:     "nothing is patched as long as the connection secret of the ServiceManager stays the same",
+			cr:         instance("secret", nil),
+			kubeSecret: "secret",
+			wantCr:     instance("secret", nil),
+		},
+		"ExplicitPolicy": {
+			reason:     "an explicitly set resolve policy is respected",
+			cr:         instance("old-secret", ifNotPresent),
+			kubeSecret: "new-secret",
+			wantCr:     instance("old-secret", ifNotPresent),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patched := false
+			kube := &test.MockClient{
+				MockGet: serviceManager(tc.kubeSecret),
+				MockPatch: func(_ context.Context, _ client.Object, _ client.Patch, _ ...client.PatchOption) error {
+					patched = true
+					return nil
+				},
+			}
+			r := NewCredentialsReferenceResolver(kube)
+			if err := r.ResolveReferences(context.Background(), tc.cr); err != nil {
+				t.Fatalf("\n%s\nResolveReferences(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.wantCr, tc.cr); diff != "" {
+				t.Errorf("\n%s\nResolveReferences(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if patched != tc.wantPatched {
+				t.Errorf("\n%s\nResolveReferences(...): want patched %v, got %v", tc.reason, tc.wantPatched, patched)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package group
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	service "github.com/sap/crossplane-provider-btp/internal/clients/security/group"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errNotGroup     = "managed resource is not a Group custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errTrackRCUsage = "cannot track ResourceUsage"
+
+	errGetSecret = "api credential secret not found"
+
+	errNewClient = "cannot create new Service"
+
+	errGetGroup    = "cannot get group"
+	errCreateGroup = "cannot create group"
+
+	errNotImplemented = "not implemented"
+)
+
+var (
+	errInvalidSecret = errors.New("api credential secret invalid")
+)
+
+type GroupMaintainer interface {
+	GenerateObservation(ctx context.Context, displayName string) (v1alpha1.GroupObservation, error)
+
+	NeedsCreation(observation v1alpha1.GroupObservation) bool
+
+	Create(ctx context.Context, params v1alpha1.GroupParameters) (string, error)
+}
+
+var _ GroupMaintainer = &service.XsuaaGroupMaintainer{}
+
+var configureGroupMaintainerFn = func(binding *v1alpha1.XsuaaBinding) (GroupMaintainer, error) {
+	if binding == nil {
+		return nil, errInvalidSecret
+	}
+
+	return service.NewXsuaaGroupMaintainer(btp.NewBackgroundContextWithDebugPrintHTTPClient(), binding.ClientId, binding.ClientSecret, binding.TokenURL, binding.ApiUrl), nil
+}
+
+type connector struct {
+	kube            client.Client
+	usage           resource.Tracker
+	resourcetracker tracking.ReferenceResolverTracker
+	newServiceFn    func(binding *v1alpha1.XsuaaBinding) (GroupMaintainer, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return nil, errors.New(errNotGroup)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	if err := c.resourcetracker.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackRCUsage)
+	}
+
+	binding, err := v1alpha1.CreateBindingFromSource(&cr.Spec.XSUAACredentialsReference, ctx, c.kube)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(binding)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc}, nil
+}
+
+type external struct {
+	client GroupMaintainer
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGroup)
+	}
+
+	// groups can't be deleted via api, so we consider them gone as soon as deletion has been requested
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	obs, err := c.client.GenerateObservation(ctx, cr.Spec.ForProvider.DisplayName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetGroup)
+	}
+
+	cr.Status.AtProvider = obs
+
+	if c.client.NeedsCreation(obs) {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	if meta.GetExternalName(cr) != *obs.ID {
+		meta.SetExternalName(cr, *obs.ID)
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGroup)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	id, err := c.client.Create(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateGroup)
+	}
+
+	meta.SetExternalName(cr, id)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// all fields are immutable, enforced on schema level
+	return managed.ExternalUpdate{}, errors.New(errNotImplemented)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Group)
+	if !ok {
+		return errors.New(errNotGroup)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// the xsuaa scim api does not offer deletion of groups, the group is left in place and only the CR is removed
+	return nil
+}
@@ -0,0 +1,221 @@
+package group
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+)
+
+var (
+	apiError = errors.New("apiError")
+)
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		cr     *v1alpha1.Group
+		client *GroupMaintainerMock
+	}
+
+	type want struct {
+		cr               *v1alpha1.Group
+		o                managed.ExternalObservation
+		err              error
+		CalledIdentifier string
+	}
+
+	existingGroup := v1alpha1.GroupObservation{
+		ID:          internal.Ptr("group-id"),
+		DisplayName: internal.Ptr("developers"),
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"LookupError": {
+			args: args{
+				cr: cr("developers"),
+				client: &GroupMaintainerMock{
+					err: apiError,
+				},
+			},
+			want: want{
+				cr:               cr("developers"),
+				o:                managed.ExternalObservation{},
+				err:              apiError,
+				CalledIdentifier: "developers",
+			},
+		},
+		"needs creation": {
+			args: args{
+				cr:     cr("developers"),
+				client: &GroupMaintainerMock{},
+			},
+			want: want{
+				cr:               cr("developers"),
+				o:                managed.ExternalObservation{ResourceExists: false},
+				CalledIdentifier: "developers",
+			},
+		},
+		"available": {
+			args: args{
+				cr: cr("developers"),
+				client: &GroupMaintainerMock{
+					generateObservation: existingGroup,
+				},
+			},
+			want: want{
+				cr: cr("developers", withExternalName("group-id"), withObservation(existingGroup), withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				CalledIdentifier: "developers",
+			},
+		},
+		"deletion requested": {
+			args: args{
+				cr: cr("developers", withDeletionTimestamp()),
+				client: &GroupMaintainerMock{
+					generateObservation: existingGroup,
+				},
+			},
+			want: want{
+				cr: cr("developers", withDeletionTimestamp()),
+				o:  managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.args.client}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			expectedErrorBehaviour(t, tc.want.err, err)
+			if diff := cmp.Diff(tc.want.CalledIdentifier, tc.args.client.CalledIdentifier); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +CalledIdentifier:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("\ne.Observe(): expected cr after operation -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		cr     *v1alpha1.Group
+		client *GroupMaintainerMock
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		cr  *v1alpha1.Group
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"api error": {
+			args: args{
+				cr: cr("developers"),
+				client: &GroupMaintainerMock{
+					err: apiError,
+				},
+			},
+			want: want{
+				cr:  cr("developers", withConditions(xpv1.Creating())),
+				o:   managed.ExternalCreation{},
+				err: apiError,
+			},
+		},
+		"create successful": {
+			args: args{
+				cr: cr("developers"),
+				client: &GroupMaintainerMock{
+					createdID: "group-id",
+				},
+			},
+			want: want{
+				cr: cr("developers", withExternalName("group-id"), withConditions(xpv1.Creating())),
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.args.client}
+			got, err := e.Create(context.Background(), tc.args.cr)
+
+			expectedErrorBehaviour(t, tc.want.err, err)
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("\ne.Create(): expected cr after operation -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func expectedErrorBehaviour(t *testing.T, expectedErr error, gotErr error) {
+	if gotErr != nil {
+		assert.Truef(t, errors.Is(gotErr, expectedErr), "expected error %v, got %v", expectedErr, gotErr)
+		return
+	}
+	if expectedErr != nil {
+		t.Errorf("expected error %v, got nil", expectedErr.Error())
+	}
+}
+
+type GroupModifier func(group *v1alpha1.Group)
+
+func cr(displayName string, m ...GroupModifier) *v1alpha1.Group {
+	cr := &v1alpha1.Group{
+		Spec: v1alpha1.GroupSpec{ForProvider: v1alpha1.GroupParameters{
+			DisplayName: displayName,
+		}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withConditions(c ...xpv1.Condition) GroupModifier {
+	return func(r *v1alpha1.Group) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(externalName string) GroupModifier {
+	return func(r *v1alpha1.Group) { meta.SetExternalName(r, externalName) }
+}
+
+func withObservation(o v1alpha1.GroupObservation) GroupModifier {
+	return func(r *v1alpha1.Group) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() GroupModifier {
+	return func(r *v1alpha1.Group) {
+		r.SetDeletionTimestamp(&metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	}
+}
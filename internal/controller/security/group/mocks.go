@@ -0,0 +1,63 @@
+package group
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	securityv1alpha1 "github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	v1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GroupMaintainerMock is a mock implementation of GroupMaintainer interface
+// returns stubed values and records called identifier to most methods
+type GroupMaintainerMock struct {
+	generateObservation securityv1alpha1.GroupObservation
+	createdID           string
+	err                 error
+	// for verification
+	CalledIdentifier string
+}
+
+var _ GroupMaintainer = &GroupMaintainerMock{}
+
+func (g *GroupMaintainerMock) GenerateObservation(ctx context.Context, displayName string) (securityv1alpha1.GroupObservation, error) {
+	g.CalledIdentifier = displayName
+	return g.generateObservation, g.err
+}
+
+func (g *GroupMaintainerMock) NeedsCreation(observation securityv1alpha1.GroupObservation) bool {
+	return observation.ID == nil
+}
+
+func (g *GroupMaintainerMock) Create(ctx context.Context, params securityv1alpha1.GroupParameters) (string, error) {
+	g.CalledIdentifier = params.DisplayName
+	return g.createdID, g.err
+}
+
+// ReferenceResolverTrackerMock is a mock implementation of ReferenceResolverTracker interface
+type ReferenceResolverTrackerMock struct{}
+
+func (r *ReferenceResolverTrackerMock) Track(ctx context.Context, mg resource.Managed) error {
+	return nil
+}
+
+func (r *ReferenceResolverTrackerMock) SetConditions(ctx context.Context, mg resource.Managed) {
+	// No-op for mock
+}
+func (r *ReferenceResolverTrackerMock) ResolveSource(
+	ctx context.Context,
+	ru v1alpha1.ResourceUsage,
+) (*metav1.PartialObjectMetadata, error) {
+	return &metav1.PartialObjectMetadata{}, nil
+}
+func (r *ReferenceResolverTrackerMock) ResolveTarget(
+	ctx context.Context,
+	ru v1alpha1.ResourceUsage,
+) (*metav1.PartialObjectMetadata, error) {
+	return &metav1.PartialObjectMetadata{}, nil
+}
+func (r *ReferenceResolverTrackerMock) DeleteShouldBeBlocked(mg resource.Managed) bool {
+	return false
+}
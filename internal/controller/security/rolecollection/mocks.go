@@ -3,11 +3,13 @@ package rolecollection
 import (
 	"context"
 
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	securityv1alpha1 "github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
 	v1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // RoleMaintainerMock is a mock implementation of RoleCollectionMaintainer interface
@@ -16,6 +18,7 @@ type RoleMaintainerMock struct {
 	generateObservation securityv1alpha1.RoleCollectionObservation
 	needsCreation       bool
 	needsUpdate         bool
+	drift               *securityv1alpha1.RoleCollectionDrift
 	err                 error
 	// for verification
 	CalledIdentifier string
@@ -36,6 +39,10 @@ func (r *RoleMaintainerMock) NeedsUpdate(params securityv1alpha1.RoleCollectionP
 	return r.needsUpdate
 }
 
+func (r *RoleMaintainerMock) DetectDrift(params securityv1alpha1.RoleCollectionParameters, observation securityv1alpha1.RoleCollectionObservation) *securityv1alpha1.RoleCollectionDrift {
+	return r.drift
+}
+
 func (r *RoleMaintainerMock) Create(ctx context.Context, params securityv1alpha1.RoleCollectionParameters) (string, error) {
 	return r.CalledIdentifier, r.err
 }
@@ -50,6 +57,21 @@ func (r *RoleMaintainerMock) Delete(ctx context.Context, roleCollectionName stri
 	return r.err
 }
 
+// RecorderMock is a mock implementation of event.Recorder, records all emitted events
+type RecorderMock struct {
+	Events []event.Event
+}
+
+var _ event.Recorder = &RecorderMock{}
+
+func (r *RecorderMock) Event(obj runtime.Object, e event.Event) {
+	r.Events = append(r.Events, e)
+}
+
+func (r *RecorderMock) WithAnnotations(keysAndValues ...string) event.Recorder {
+	return r
+}
+
 // ReferenceResolverTrackerMock is a mock implementation of ReferenceResolverTracker interface
 type ReferenceResolverTrackerMock struct{}
 
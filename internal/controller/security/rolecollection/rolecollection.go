@@ -2,10 +2,14 @@ package rolecollection
 
 import (
 	"context"
+	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/sap/crossplane-provider-btp/btp"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +35,8 @@ const (
 	errCreateRolecollection = "cannot create rolecollection"
 	errUpdateRolecollection = "cannot update rolecollection"
 	errDeleteRolecollection = "cannot delete rolecollection"
+
+	reasonDriftDetected event.Reason = "DriftDetected"
 )
 
 var (
@@ -42,6 +48,7 @@ type RoleCollectionMaintainer interface {
 
 	NeedsCreation(collection v1alpha1.RoleCollectionObservation) bool
 	NeedsUpdate(params v1alpha1.RoleCollectionParameters, observation v1alpha1.RoleCollectionObservation) bool
+	DetectDrift(params v1alpha1.RoleCollectionParameters, observation v1alpha1.RoleCollectionObservation) *v1alpha1.RoleCollectionDrift
 
 	Create(ctx context.Context, params v1alpha1.RoleCollectionParameters) (string, error)
 	Update(ctx context.Context, roleCollectionName string, params v1alpha1.RoleCollectionParameters, obs v1alpha1.RoleCollectionObservation) error
@@ -61,6 +68,7 @@ type connector struct {
 	kube            client.Client
 	usage           resource.Tracker
 	resourcetracker tracking.ReferenceResolverTracker
+	recorder        event.Recorder
 	newServiceFn    func(binding *v1alpha1.XsuaaBinding) (RoleCollectionMaintainer, error)
 }
 
@@ -89,11 +97,12 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{client: svc}, nil
+	return &external{client: svc, recorder: c.recorder}, nil
 }
 
 type external struct {
-	client RoleCollectionMaintainer
+	client   RoleCollectionMaintainer
+	recorder event.Recorder
 }
 
 func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -107,6 +116,8 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errGetRolecollection)
 	}
 
+	previous := getObservation(cr)
+	obs.ObservedGeneration = previous.ObservedGeneration
 	setObservation(cr, obs)
 
 	needsCreation := c.client.NeedsCreation(getObservation(cr))
@@ -118,18 +129,31 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(xpv1.Available())
 
+	// role collections observed before the generation was recorded (e.g. created by an older provider version) take
+	// their current spec as the observed one, otherwise the first observation would apply it regardless of the drift policy
+	if previous.ObservedGeneration == 0 {
+		cr.Status.AtProvider.ObservedGeneration = cr.GetGeneration()
+	}
+
 	needsUpdate := c.client.NeedsUpdate(getParams(cr), getObservation(cr))
-	if needsUpdate {
+	if !needsUpdate {
+		cr.Status.AtProvider.ObservedGeneration = cr.GetGeneration()
 		return managed.ExternalObservation{
-			ResourceExists:   true,
-			ResourceUpToDate: false,
+			ResourceExists:    true,
+			ResourceUpToDate:  true,
+			ConnectionDetails: managed.ConnectionDetails{},
 		}, nil
 	}
 
+	cr.Status.AtProvider.Drift = c.client.DetectDrift(getParams(cr), getObservation(cr))
+	if externalStateChanged(previous, obs) {
+		c.recorder.Event(cr, event.Warning(reasonDriftDetected, errors.New(describeDrift(cr.Status.AtProvider.Drift))))
+	}
+
+	// spec changes are always applied, ObserveAndAlert only leaves changes of the external state in place
 	return managed.ExternalObservation{
-		ResourceExists:    true,
-		ResourceUpToDate:  true,
-		ConnectionDetails: managed.ConnectionDetails{},
+		ResourceExists:   true,
+		ResourceUpToDate: !specChanged(cr) && cr.Spec.DriftPolicy == v1alpha1.DriftPolicyObserveAndAlert,
 	}, nil
 }
 
@@ -148,6 +172,7 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	meta.SetExternalName(cr, extName)
+	cr.Status.AtProvider.ObservedGeneration = cr.GetGeneration()
 
 	return managed.ExternalCreation{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -163,6 +188,7 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err := c.client.Update(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider, cr.Status.AtProvider); err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRolecollection)
 	}
+	cr.Status.AtProvider.ObservedGeneration = cr.GetGeneration()
 
 	return managed.ExternalUpdate{
 		ConnectionDetails: managed.ConnectionDetails{},
@@ -198,3 +224,39 @@ func getObservation(cr *v1alpha1.RoleCollection) v1alpha1.RoleCollectionObservat
 func getParams(cr *v1alpha1.RoleCollection) v1alpha1.RoleCollectionParameters {
 	return cr.Spec.ForProvider
 }
+
+// externalStateChanged checks whether the role collection has been changed in the external system since the last observation
+func externalStateChanged(previous, current v1alpha1.RoleCollectionObservation) bool {
+	if previous.Name == nil {
+		return false
+	}
+	return !reflect.DeepEqual(previous.Description, current.Description) || !reflect.DeepEqual(previous.RoleReferences, current.RoleReferences)
+}
+
+// specChanged checks whether the spec has been changed since it was last applied to the external system
+func specChanged(cr *v1alpha1.RoleCollection) bool {
+	return cr.GetGeneration() != cr.Status.AtProvider.ObservedGeneration
+}
+
+// describeDrift renders a drift as human readable event message
+func describeDrift(drift *v1alpha1.RoleCollectionDrift) string {
+	var changes []string
+	if len(drift.RolesAdded) > 0 {
+		changes = append(changes, fmt.Sprintf("roles added externally: %s", roleNames(drift.RolesAdded)))
+	}
+	if len(drift.RolesRemoved) > 0 {
+		changes = append(changes, fmt.Sprintf("roles removed externally: %s", roleNames(drift.RolesRemoved)))
+	}
+	if drift.DescriptionChanged {
+		changes = append(changes, "description changed externally")
+	}
+	return "role collection differs from spec, " + strings.Join(changes, ", ")
+}
+
+func roleNames(roles []v1alpha1.RoleReference) string {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	return strings.Join(names, ", ")
+}
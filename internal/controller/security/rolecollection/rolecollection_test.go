@@ -36,11 +36,18 @@ func TestObserve(t *testing.T) {
 		err error
 
 		CalledIdentifier string
+		events           int
 	}
 
 	generatedObservation := v1alpha1.RoleCollectionObservation{
 		Name: internal.Ptr("generated"),
 	}
+	drift := &v1alpha1.RoleCollectionDrift{DescriptionChanged: true}
+	driftedObservation := v1alpha1.RoleCollectionObservation{
+		Name:        internal.Ptr("generated"),
+		Description: internal.Ptr("changed externally"),
+		Drift:       drift,
+	}
 
 	cases := map[string]struct {
 		args args
@@ -81,11 +88,86 @@ func TestObserve(t *testing.T) {
 				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co")),
 				client: &RoleMaintainerMock{
 					needsUpdate:         true,
+					drift:               drift,
 					generateObservation: generatedObservation,
 				},
 			},
 			want: want{
-				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithObservation(generatedObservation), WithConditions(xpv1.Available())),
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithObservation(v1alpha1.RoleCollectionObservation{Name: internal.Ptr("generated"), Drift: drift}), WithConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				CalledIdentifier: "ext-subaccount-admin-co",
+			},
+		},
+		"external drift is reverted and alerted": {
+			args: args{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithObservation(generatedObservation)),
+				client: &RoleMaintainerMock{
+					needsUpdate:         true,
+					drift:               drift,
+					generateObservation: v1alpha1.RoleCollectionObservation{Name: internal.Ptr("generated"), Description: internal.Ptr("changed externally")},
+				},
+			},
+			want: want{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithObservation(driftedObservation), WithConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				CalledIdentifier: "ext-subaccount-admin-co",
+				events:           1,
+			},
+		},
+		"external drift is only alerted in observe and alert mode": {
+			args: args{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithObservation(generatedObservation), WithDriftPolicy(v1alpha1.DriftPolicyObserveAndAlert)),
+				client: &RoleMaintainerMock{
+					needsUpdate:         true,
+					drift:               drift,
+					generateObservation: v1alpha1.RoleCollectionObservation{Name: internal.Ptr("generated"), Description: internal.Ptr("changed externally")},
+				},
+			},
+			want: want{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithObservation(driftedObservation), WithDriftPolicy(v1alpha1.DriftPolicyObserveAndAlert), WithConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				CalledIdentifier: "ext-subaccount-admin-co",
+				events:           1,
+			},
+		},
+		"persisting drift is not alerted again": {
+			args: args{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithObservation(driftedObservation), WithDriftPolicy(v1alpha1.DriftPolicyObserveAndAlert)),
+				client: &RoleMaintainerMock{
+					needsUpdate:         true,
+					drift:               drift,
+					generateObservation: v1alpha1.RoleCollectionObservation{Name: internal.Ptr("generated"), Description: internal.Ptr("changed externally")},
+				},
+			},
+			want: want{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithObservation(driftedObservation), WithDriftPolicy(v1alpha1.DriftPolicyObserveAndAlert), WithConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				CalledIdentifier: "ext-subaccount-admin-co",
+			},
+		},
+		"spec change is applied in observe and alert mode": {
+			args: args{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithGeneration(2), WithObservation(v1alpha1.RoleCollectionObservation{Name: internal.Ptr("generated"), ObservedGeneration: 1}), WithDriftPolicy(v1alpha1.DriftPolicyObserveAndAlert)),
+				client: &RoleMaintainerMock{
+					needsUpdate:         true,
+					drift:               drift,
+					generateObservation: generatedObservation,
+				},
+			},
+			want: want{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithGeneration(2), WithObservation(v1alpha1.RoleCollectionObservation{Name: internal.Ptr("generated"), Drift: drift, ObservedGeneration: 1}), WithDriftPolicy(v1alpha1.DriftPolicyObserveAndAlert), WithConditions(xpv1.Available())),
 				o: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: false,
@@ -93,6 +175,41 @@ func TestObserve(t *testing.T) {
 				CalledIdentifier: "ext-subaccount-admin-co",
 			},
 		},
+		"missing observed generation is initialized without applying the spec": {
+			args: args{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithGeneration(4), WithObservation(v1alpha1.RoleCollectionObservation{Name: internal.Ptr("generated")}), WithDriftPolicy(v1alpha1.DriftPolicyObserveAndAlert)),
+				client: &RoleMaintainerMock{
+					needsUpdate:         true,
+					drift:               drift,
+					generateObservation: generatedObservation,
+				},
+			},
+			want: want{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithGeneration(4), WithObservation(v1alpha1.RoleCollectionObservation{Name: internal.Ptr("generated"), Drift: drift, ObservedGeneration: 4}), WithDriftPolicy(v1alpha1.DriftPolicyObserveAndAlert), WithConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				CalledIdentifier: "ext-subaccount-admin-co",
+			},
+		},
+		"in sync spec is recorded as observed": {
+			args: args{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithGeneration(3), WithObservation(v1alpha1.RoleCollectionObservation{Name: internal.Ptr("generated"), ObservedGeneration: 1})),
+				client: &RoleMaintainerMock{
+					generateObservation: generatedObservation,
+				},
+			},
+			want: want{
+				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithGeneration(3), WithObservation(v1alpha1.RoleCollectionObservation{Name: internal.Ptr("generated"), ObservedGeneration: 3}), WithConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				CalledIdentifier: "ext-subaccount-admin-co",
+			},
+		},
 		"available": {
 			args: args{
 				cr: cr("spec-subaccount-admin-co", WithExternalName("ext-subaccount-admin-co")),
@@ -115,9 +232,13 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := external{client: tc.args.client}
+			recorder := &RecorderMock{}
+			e := external{client: tc.args.client, recorder: recorder}
 			got, err := e.Observe(context.Background(), tc.args.cr)
 			expectedErrorBehaviour(t, tc.want.err, err)
+			if diff := cmp.Diff(tc.want.events, len(recorder.Events)); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +events:\n", diff)
+			}
 			if diff := cmp.Diff(tc.want.CalledIdentifier, tc.args.client.CalledIdentifier); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want, +CalledIdentifier:\n", diff)
 			}
@@ -226,13 +347,13 @@ func TestUpdate(t *testing.T) {
 		},
 		"create successful": {
 			args: args{
-				cr: cr("subaccount-admin-co", WithExternalName("ext-subaccount-admin-co")),
+				cr: cr("subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithGeneration(2)),
 				client: &RoleMaintainerMock{
 					err: nil,
 				},
 			},
 			want: want{
-				cr: cr("subaccount-admin-co", WithExternalName("ext-subaccount-admin-co")),
+				cr: cr("subaccount-admin-co", WithExternalName("ext-subaccount-admin-co"), WithGeneration(2), WithObservation(v1alpha1.RoleCollectionObservation{ObservedGeneration: 2})),
 				o: managed.ExternalUpdate{
 					ConnectionDetails: managed.ConnectionDetails{},
 				},
@@ -635,6 +756,14 @@ func WithExternalName(externalName string) RoleCollectionModifier {
 	return func(r *v1alpha1.RoleCollection) { meta.SetExternalName(r, externalName) }
 }
 
+func WithDriftPolicy(policy string) RoleCollectionModifier {
+	return func(r *v1alpha1.RoleCollection) { r.Spec.DriftPolicy = policy }
+}
+
+func WithGeneration(generation int64) RoleCollectionModifier {
+	return func(r *v1alpha1.RoleCollection) { r.SetGeneration(generation) }
+}
+
 func WithObservation(o v1alpha1.RoleCollectionObservation) RoleCollectionModifier {
 	return func(r *v1alpha1.RoleCollection) { r.Status.AtProvider = o }
 }
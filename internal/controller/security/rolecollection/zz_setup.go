@@ -2,6 +2,7 @@ package rolecollection
 
 import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -22,6 +23,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 			usage:           resource.NewProviderConfigUsageTracker(mgr.GetClient(), &providerv1alpha1.ProviderConfigUsage{}),
 			newServiceFn:    configureRoleCollectionMaintainerFn,
 			resourcetracker: resourcetracker,
+			recorder:        event.NewAPIRecorder(mgr.GetEventRecorderFor(managed.ControllerName(v1alpha1.RoleCollectionGroupKind))),
 		}
 	})
 }
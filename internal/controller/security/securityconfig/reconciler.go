@@ -0,0 +1,110 @@
+package securityconfig
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+const (
+	shortWait = 30 * time.Second
+	timeout   = 2 * time.Minute
+
+	errGetSecurityConfig = "cannot get SecurityConfig"
+	errListUsages        = "cannot list ResourceUsages of SecurityConfig"
+	errUpdate            = "cannot update SecurityConfig"
+)
+
+// Event reasons.
+const (
+	reasonAccount event.Reason = "UsageAccounting"
+)
+
+// A Reconciler keeps SecurityConfigs from being deleted while resources still reference them. The references are
+// tracked as ResourceUsages by the controllers of the referencing resources.
+type Reconciler struct {
+	client client.Client
+
+	log    logging.Logger
+	record event.Recorder
+}
+
+// A ReconcilerOption configures a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithLogger specifies how the Reconciler should log messages.
+func WithLogger(l logging.Logger) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.log = l
+	}
+}
+
+// WithRecorder specifies how the Reconciler should record events.
+func WithRecorder(er event.Recorder) ReconcilerOption {
+	return func(r *Reconciler) {
+		r.record = er
+	}
+}
+
+// NewReconciler returns a Reconciler of SecurityConfigs.
+func NewReconciler(m manager.Manager, o ...ReconcilerOption) *Reconciler {
+	r := &Reconciler{
+		client: m.GetClient(),
+		log:    logging.NewNopLogger(),
+		record: event.NewNopRecorder(),
+	}
+
+	for _, ro := range o {
+		ro(r)
+	}
+
+	return r
+}
+
+// Reconcile a SecurityConfig by adding a finalizer, which is only removed once no ResourceUsage references it anymore.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sc := &v1alpha1.SecurityConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, sc); err != nil {
+		// the SecurityConfig is gone already, nothing to do
+		log.Debug(errGetSecurityConfig, "error", err)
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetSecurityConfig)
+	}
+
+	if !meta.WasDeleted(sc) {
+		if meta.FinalizerExists(sc, providerv1alpha1.Finalizer) {
+			return reconcile.Result{}, nil
+		}
+		meta.AddFinalizer(sc, providerv1alpha1.Finalizer)
+		return reconcile.Result{}, errors.Wrap(r.client.Update(ctx, sc), errUpdate)
+	}
+
+	usages := &providerv1alpha1.ResourceUsageList{}
+	if err := r.client.List(ctx, usages, client.MatchingLabels{providerv1alpha1.LabelKeySourceUid: string(sc.GetUID())}); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errListUsages)
+	}
+	if len(usages.Items) > 0 {
+		r.record.Event(sc, event.Warning(reasonAccount, errors.New(providerv1alpha1.ErrResourceInUse)))
+		return reconcile.Result{RequeueAfter: shortWait}, nil
+	}
+
+	meta.RemoveFinalizer(sc, providerv1alpha1.Finalizer)
+	return reconcile.Result{}, errors.Wrap(r.client.Update(ctx, sc), errUpdate)
+}
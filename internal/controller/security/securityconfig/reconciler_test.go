@@ -0,0 +1,184 @@
+package securityconfig
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	providerv1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+)
+
+func TestReconciler(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		m manager.Manager
+	}
+
+	type want struct {
+		result reconcile.Result
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"GetError": {
+			reason: "Errors getting the SecurityConfig should be returned",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetSecurityConfig),
+			},
+		},
+		"NotFound": {
+			reason: "We should return without requeueing if the SecurityConfig no longer exists",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+					},
+				},
+			},
+			want: want{},
+		},
+		"AddFinalizer": {
+			reason: "We should add our finalizer to a SecurityConfig which is not deleted",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(nil, securityConfig()),
+						MockUpdate: test.NewMockUpdateFn(nil, expectFinalizer(true)),
+					},
+				},
+			},
+			want: want{},
+		},
+		"AddFinalizerError": {
+			reason: "Errors adding our finalizer should be returned",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(nil, securityConfig()),
+						MockUpdate: test.NewMockUpdateFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+		"BlockDeleteWhileInUse": {
+			reason: "We should keep our finalizer and requeue while the SecurityConfig is still referenced",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, securityConfig(withFinalizer, withDeletion)),
+						MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+							obj.(*providerv1alpha1.ResourceUsageList).Items = []providerv1alpha1.ResourceUsage{{}}
+							return nil
+						}),
+						MockUpdate: test.NewMockUpdateFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: shortWait},
+			},
+		},
+		"ListError": {
+			reason: "Errors listing the ResourceUsages should be returned",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:  test.NewMockGetFn(nil, securityConfig(withFinalizer, withDeletion)),
+						MockList: test.NewMockListFn(errBoom),
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errListUsages),
+			},
+		},
+		"RemoveFinalizer": {
+			reason: "We should remove our finalizer once the SecurityConfig is no longer referenced",
+			args: args{
+				m: &fake.Manager{
+					Client: &test.MockClient{
+						MockGet:    test.NewMockGetFn(nil, securityConfig(withFinalizer, withDeletion)),
+						MockList:   test.NewMockListFn(nil),
+						MockUpdate: test.NewMockUpdateFn(nil, expectFinalizer(false)),
+					},
+				},
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(
+			name, func(t *testing.T) {
+				r := NewReconciler(tc.args.m)
+				got, err := r.Reconcile(context.Background(), reconcile.Request{})
+
+				if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+					t.Errorf("\n%s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+				}
+				if diff := cmp.Diff(tc.want.result, got); diff != "" {
+					t.Errorf("\n%s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+				}
+			},
+		)
+	}
+}
+
+type securityConfigModifier func(*v1alpha1.SecurityConfig)
+
+func withFinalizer(sc *v1alpha1.SecurityConfig) {
+	meta.AddFinalizer(sc, providerv1alpha1.Finalizer)
+}
+
+func withDeletion(sc *v1alpha1.SecurityConfig) {
+	now := metav1.Now()
+	sc.SetDeletionTimestamp(&now)
+}
+
+func securityConfig(m ...securityConfigModifier) func(client.Object) error {
+	return func(obj client.Object) error {
+		sc := obj.(*v1alpha1.SecurityConfig)
+		sc.SetUID("some-uid")
+		for _, f := range m {
+			f(sc)
+		}
+		return nil
+	}
+}
+
+func expectFinalizer(exists bool) func(client.Object) error {
+	return func(obj client.Object) error {
+		if meta.FinalizerExists(obj, providerv1alpha1.Finalizer) != exists {
+			return errors.New("unexpected finalizers")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	securityv1alpha1 "github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	v1alpha1 "github.com/sap/crossplane-provider-btp/apis/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// UserMaintainerMock is a mock implementation of UserMaintainer interface
+// returns stubed values and records called identifier to most methods
+type UserMaintainerMock struct {
+	generateObservation securityv1alpha1.UserObservation
+	createdID           string
+	err                 error
+	// for verification
+	CalledIdentifier string
+}
+
+var _ UserMaintainer = &UserMaintainerMock{}
+
+func (u *UserMaintainerMock) GenerateObservation(ctx context.Context, origin, userName string) (securityv1alpha1.UserObservation, error) {
+	u.CalledIdentifier = userName
+	return u.generateObservation, u.err
+}
+
+func (u *UserMaintainerMock) NeedsCreation(observation securityv1alpha1.UserObservation) bool {
+	return observation.ID == nil
+}
+
+func (u *UserMaintainerMock) Create(ctx context.Context, params securityv1alpha1.UserParameters) (string, error) {
+	u.CalledIdentifier = params.UserName
+	return u.createdID, u.err
+}
+
+func (u *UserMaintainerMock) Delete(ctx context.Context, origin, userName string) error {
+	u.CalledIdentifier = userName
+	return u.err
+}
+
+// ReferenceResolverTrackerMock is a mock implementation of ReferenceResolverTracker interface
+type ReferenceResolverTrackerMock struct{}
+
+func (r *ReferenceResolverTrackerMock) Track(ctx context.Context, mg resource.Managed) error {
+	return nil
+}
+
+func (r *ReferenceResolverTrackerMock) SetConditions(ctx context.Context, mg resource.Managed) {
+	// No-op for mock
+}
+func (r *ReferenceResolverTrackerMock) ResolveSource(
+	ctx context.Context,
+	ru v1alpha1.ResourceUsage,
+) (*metav1.PartialObjectMetadata, error) {
+	return &metav1.PartialObjectMetadata{}, nil
+}
+func (r *ReferenceResolverTrackerMock) ResolveTarget(
+	ctx context.Context,
+	ru v1alpha1.ResourceUsage,
+) (*metav1.PartialObjectMetadata, error) {
+	return &metav1.PartialObjectMetadata{}, nil
+}
+func (r *ReferenceResolverTrackerMock) DeleteShouldBeBlocked(mg resource.Managed) bool {
+	return false
+}
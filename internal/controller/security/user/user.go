@@ -0,0 +1,184 @@
+package user
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/btp"
+	service "github.com/sap/crossplane-provider-btp/internal/clients/security/user"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+const (
+	errNotUser      = "managed resource is not a User custom resource"
+	errTrackPCUsage = "cannot track ProviderConfig usage"
+	errTrackRCUsage = "cannot track ResourceUsage"
+
+	errGetSecret = "api credential secret not found"
+
+	errNewClient = "cannot create new Service"
+
+	errGetUser    = "cannot get user"
+	errCreateUser = "cannot create user"
+	errDeleteUser = "cannot delete user"
+
+	errNotImplemented = "not implemented"
+)
+
+var (
+	errInvalidSecret = errors.New("api credential secret invalid")
+)
+
+type UserMaintainer interface {
+	GenerateObservation(ctx context.Context, origin, userName string) (v1alpha1.UserObservation, error)
+
+	NeedsCreation(observation v1alpha1.UserObservation) bool
+
+	Create(ctx context.Context, params v1alpha1.UserParameters) (string, error)
+	Delete(ctx context.Context, origin, userName string) error
+}
+
+var _ UserMaintainer = &service.XsuaaUserMaintainer{}
+
+var configureUserMaintainerFn = func(binding *v1alpha1.XsuaaBinding) (UserMaintainer, error) {
+	if binding == nil {
+		return nil, errInvalidSecret
+	}
+
+	return service.NewXsuaaUserMaintainer(btp.NewBackgroundContextWithDebugPrintHTTPClient(), binding.ClientId, binding.ClientSecret, binding.TokenURL, binding.ApiUrl), nil
+}
+
+type connector struct {
+	kube            client.Client
+	usage           resource.Tracker
+	resourcetracker tracking.ReferenceResolverTracker
+	newServiceFn    func(binding *v1alpha1.XsuaaBinding) (UserMaintainer, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return nil, errors.New(errNotUser)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	if err := c.resourcetracker.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackRCUsage)
+	}
+
+	binding, err := v1alpha1.CreateBindingFromSource(&cr.Spec.XSUAACredentialsReference, ctx, c.kube)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetSecret)
+	}
+
+	svc, err := c.newServiceFn(binding)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return &external{client: svc}, nil
+}
+
+type external struct {
+	client UserMaintainer
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotUser)
+	}
+
+	// shadow users are looked up by username and origin, this allows adopting users which have been created implicitly before
+	obs, err := c.client.GenerateObservation(ctx, cr.Spec.ForProvider.Origin, cr.Spec.ForProvider.UserName)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetUser)
+	}
+
+	cr.Status.AtProvider = obs
+
+	if c.client.NeedsCreation(obs) {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	// an adopted shadow user is left in place on deletion, it is released by reporting it as gone
+	if meta.WasDeleted(cr) && !createdByProvider(cr) {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	if meta.GetExternalName(cr) != *obs.ID {
+		meta.SetExternalName(cr, *obs.ID)
+	}
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotUser)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	id, err := c.client.Create(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUser)
+	}
+
+	meta.SetExternalName(cr, id)
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{},
+	}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// all fields are immutable, enforced on schema level
+	return managed.ExternalUpdate{}, errors.New(errNotImplemented)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.User)
+	if !ok {
+		return errors.New(errNotUser)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	if !createdByProvider(cr) {
+		return nil
+	}
+
+	if err := c.client.Delete(ctx, cr.Spec.ForProvider.Origin, cr.Spec.ForProvider.UserName); err != nil {
+		return errors.Wrap(err, errDeleteUser)
+	}
+
+	return nil
+}
+
+// createdByProvider tells shadow users created by this provider apart from adopted ones, which may hold role
+// collections granted outside of crossplane. The managed reconciler records every successful Create.
+func createdByProvider(cr *v1alpha1.User) bool {
+	return !meta.GetExternalCreateSucceeded(cr).IsZero()
+}
@@ -0,0 +1,465 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/sap/crossplane-provider-btp/apis/security/v1alpha1"
+	"github.com/sap/crossplane-provider-btp/internal"
+	"github.com/sap/crossplane-provider-btp/internal/tracking"
+)
+
+var (
+	apiError = errors.New("apiError")
+)
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		cr     *v1alpha1.User
+		client *UserMaintainerMock
+	}
+
+	type want struct {
+		cr               *v1alpha1.User
+		o                managed.ExternalObservation
+		err              error
+		CalledIdentifier string
+	}
+
+	existingUser := v1alpha1.UserObservation{
+		ID:       internal.Ptr("user-id"),
+		UserName: internal.Ptr("someone@sap.com"),
+		Origin:   internal.Ptr("sap.default"),
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"LookupError": {
+			args: args{
+				cr: cr("someone@sap.com"),
+				client: &UserMaintainerMock{
+					err: apiError,
+				},
+			},
+			want: want{
+				cr:               cr("someone@sap.com"),
+				o:                managed.ExternalObservation{},
+				err:              apiError,
+				CalledIdentifier: "someone@sap.com",
+			},
+		},
+		"needs creation": {
+			args: args{
+				cr:     cr("someone@sap.com"),
+				client: &UserMaintainerMock{},
+			},
+			want: want{
+				cr:               cr("someone@sap.com"),
+				o:                managed.ExternalObservation{ResourceExists: false},
+				CalledIdentifier: "someone@sap.com",
+			},
+		},
+		"available": {
+			args: args{
+				cr: cr("someone@sap.com", withExternalName("user-id")),
+				client: &UserMaintainerMock{
+					generateObservation: existingUser,
+				},
+			},
+			want: want{
+				cr: cr("someone@sap.com", withExternalName("user-id"), withObservation(existingUser), withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				CalledIdentifier: "someone@sap.com",
+			},
+		},
+		"adopt existing": {
+			args: args{
+				cr: cr("someone@sap.com", withExternalName("metadata-name")),
+				client: &UserMaintainerMock{
+					generateObservation: existingUser,
+				},
+			},
+			want: want{
+				cr: cr("someone@sap.com", withExternalName("user-id"), withObservation(existingUser), withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				CalledIdentifier: "someone@sap.com",
+			},
+		},
+		"adopted user released on deletion": {
+			args: args{
+				cr: cr("someone@sap.com", withExternalName("user-id"), withDeletionTimestamp()),
+				client: &UserMaintainerMock{
+					generateObservation: existingUser,
+				},
+			},
+			want: want{
+				cr:               cr("someone@sap.com", withExternalName("user-id"), withDeletionTimestamp(), withObservation(existingUser)),
+				o:                managed.ExternalObservation{ResourceExists: false},
+				CalledIdentifier: "someone@sap.com",
+			},
+		},
+		"created user deleted": {
+			args: args{
+				cr: cr("someone@sap.com", withExternalName("user-id"), withCreated(), withDeletionTimestamp()),
+				client: &UserMaintainerMock{
+					generateObservation: existingUser,
+				},
+			},
+			want: want{
+				cr: cr("someone@sap.com", withExternalName("user-id"), withCreated(), withDeletionTimestamp(), withObservation(existingUser), withConditions(xpv1.Available())),
+				o: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+				CalledIdentifier: "someone@sap.com",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.args.client}
+			got, err := e.Observe(context.Background(), tc.args.cr)
+			expectedErrorBehaviour(t, tc.want.err, err)
+			if diff := cmp.Diff(tc.want.CalledIdentifier, tc.args.client.CalledIdentifier); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +CalledIdentifier:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("\ne.Observe(): expected cr after operation -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		cr     *v1alpha1.User
+		client *UserMaintainerMock
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		cr  *v1alpha1.User
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"api error": {
+			args: args{
+				cr: cr("someone@sap.com"),
+				client: &UserMaintainerMock{
+					err: apiError,
+				},
+			},
+			want: want{
+				cr:  cr("someone@sap.com", withConditions(xpv1.Creating())),
+				o:   managed.ExternalCreation{},
+				err: apiError,
+			},
+		},
+		"create successful": {
+			args: args{
+				cr: cr("someone@sap.com"),
+				client: &UserMaintainerMock{
+					createdID: "user-id",
+				},
+			},
+			want: want{
+				cr: cr("someone@sap.com", withExternalName("user-id"), withConditions(xpv1.Creating())),
+				o: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.args.client}
+			got, err := e.Create(context.Background(), tc.args.cr)
+
+			expectedErrorBehaviour(t, tc.want.err, err)
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("\ne.Create(): expected cr after operation -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		cr     *v1alpha1.User
+		client *UserMaintainerMock
+	}
+
+	type want struct {
+		cr               *v1alpha1.User
+		err              error
+		CalledIdentifier string
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"api error": {
+			args: args{
+				cr: cr("someone@sap.com", withExternalName("user-id"), withCreated()),
+				client: &UserMaintainerMock{
+					err: apiError,
+				},
+			},
+			want: want{
+				cr:               cr("someone@sap.com", withExternalName("user-id"), withCreated(), withConditions(xpv1.Deleting())),
+				err:              apiError,
+				CalledIdentifier: "someone@sap.com",
+			},
+		},
+		"successfully deleted": {
+			args: args{
+				cr:     cr("someone@sap.com", withExternalName("user-id"), withCreated()),
+				client: &UserMaintainerMock{},
+			},
+			want: want{
+				cr:               cr("someone@sap.com", withExternalName("user-id"), withCreated(), withConditions(xpv1.Deleting())),
+				CalledIdentifier: "someone@sap.com",
+			},
+		},
+		"adopted user kept": {
+			args: args{
+				cr:     cr("someone@sap.com", withExternalName("user-id")),
+				client: &UserMaintainerMock{},
+			},
+			want: want{
+				cr: cr("someone@sap.com", withExternalName("user-id"), withConditions(xpv1.Deleting())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{client: tc.args.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.CalledIdentifier, tc.args.client.CalledIdentifier); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want, +CalledIdentifier:\n", diff)
+			}
+			expectedErrorBehaviour(t, tc.want.err, err)
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("\ne.Delete(): expected cr after operation -want, +got:\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestConnect(t *testing.T) {
+	trackErr := errors.New("trackError")
+
+	kubeStubCustom := func(err error, secretData map[string][]byte) client.Client {
+		return &test.MockClient{
+			MockGet: test.NewMockGetFn(err, func(obj client.Object) error {
+				secret := obj.(*corev1.Secret)
+				secret.Data = secretData
+				return nil
+			}),
+		}
+	}
+
+	type args struct {
+		cr           *v1alpha1.User
+		track        resource.Tracker
+		kube         client.Client
+		newServiceFn func(_ *v1alpha1.XsuaaBinding) (UserMaintainer, error)
+	}
+
+	type want struct {
+		err             error
+		externalCreated bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"TrackError": {
+			args: args{
+				cr:    cr("someone@sap.com"),
+				track: newTracker(trackErr),
+			},
+			want: want{
+				err: trackErr,
+			},
+		},
+		"Not found secret": {
+			args: args{
+				cr:    cr("someone@sap.com", withCredsCustom()),
+				track: newTracker(nil),
+				kube:  kubeStubCustom(v1alpha1.FailedToGetSecret, nil),
+			},
+			want: want{
+				err: v1alpha1.FailedToGetSecret,
+			},
+		},
+		"NewServiceFn err": {
+			args: args{
+				cr:    cr("someone@sap.com", withCredsCustom()),
+				track: newTracker(nil),
+				kube: kubeStubCustom(nil, map[string][]byte{
+					"credentials": []byte(`{"clientid": "clientid", "clientsecret": "clientsecret", "tokenurl": "tokenurl", "apiurl": "apiurl"}`),
+				}),
+				newServiceFn: newMaintainerStub(v1alpha1.InvalidXsuaaCredentials),
+			},
+			want: want{
+				err: v1alpha1.InvalidXsuaaCredentials,
+			},
+		},
+		"NewServiceFn success": {
+			args: args{
+				cr:    cr("someone@sap.com", withCredsCustom()),
+				track: newTracker(nil),
+				kube: kubeStubCustom(nil, map[string][]byte{
+					"credentials": []byte(`{"clientid": "clientid", "clientsecret": "clientsecret", "tokenurl": "tokenurl", "apiurl": "apiurl"}`),
+				}),
+				newServiceFn: newMaintainerStub(nil),
+			},
+			want: want{
+				externalCreated: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := connector{
+				usage:           tc.args.track,
+				kube:            tc.args.kube,
+				resourcetracker: newResourceTracker(),
+				newServiceFn:    tc.args.newServiceFn,
+			}
+			got, err := c.Connect(context.Background(), tc.args.cr)
+			expectedErrorBehaviour(t, tc.want.err, err)
+			if tc.want.externalCreated != (got != nil) {
+				t.Errorf("expected external to be created: %t, got %t", tc.want.externalCreated, got != nil)
+			}
+		})
+	}
+}
+
+func expectedErrorBehaviour(t *testing.T, expectedErr error, gotErr error) {
+	if gotErr != nil {
+		assert.Truef(t, errors.Is(gotErr, expectedErr), "expected error %v, got %v", expectedErr, gotErr)
+		return
+	}
+	if expectedErr != nil {
+		t.Errorf("expected error %v, got nil", expectedErr.Error())
+	}
+}
+
+type UserModifier func(user *v1alpha1.User)
+
+func cr(userName string, m ...UserModifier) *v1alpha1.User {
+	cr := &v1alpha1.User{
+		Spec: v1alpha1.UserSpec{ForProvider: v1alpha1.UserParameters{
+			Origin:   "sap.default",
+			UserName: userName,
+		}},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withCredsCustom() UserModifier {
+	return func(user *v1alpha1.User) {
+		user.Spec.APICredentials = v1alpha1.APICredentials{
+			Source: xpv1.CredentialsSourceSecret,
+			CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+				SecretRef: &xpv1.SecretKeySelector{
+					Key: "credentials",
+					SecretReference: xpv1.SecretReference{
+						Namespace: "default",
+						Name:      "xsuaa-secret",
+					},
+				},
+			},
+		}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) UserModifier {
+	return func(r *v1alpha1.User) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(externalName string) UserModifier {
+	return func(r *v1alpha1.User) { meta.SetExternalName(r, externalName) }
+}
+
+func withCreated() UserModifier {
+	return func(r *v1alpha1.User) { meta.SetExternalCreateSucceeded(r, time.Unix(1700000000, 0)) }
+}
+
+func withDeletionTimestamp() UserModifier {
+	return func(r *v1alpha1.User) { r.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(1700000000, 0)}) }
+}
+
+func withObservation(o v1alpha1.UserObservation) UserModifier {
+	return func(r *v1alpha1.User) { r.Status.AtProvider = o }
+}
+
+type tracker struct {
+	err error
+}
+
+func (t *tracker) Track(ctx context.Context, mg resource.Managed) error {
+	return t.err
+}
+
+func newTracker(err error) resource.Tracker {
+	return &tracker{err: err}
+}
+
+func newResourceTracker() tracking.ReferenceResolverTracker {
+	return &ReferenceResolverTrackerMock{}
+}
+
+func newMaintainerStub(err error) func(_ *v1alpha1.XsuaaBinding) (UserMaintainer, error) {
+	return func(_ *v1alpha1.XsuaaBinding) (UserMaintainer, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &UserMaintainerMock{}, nil
+	}
+}
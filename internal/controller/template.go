@@ -11,16 +11,26 @@ import (
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/entitlement"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/globalaccount"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/resourceusage"
+	"github.com/sap/crossplane-provider-btp/internal/controller/account/servicecatalog"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/servicemanager"
+	"github.com/sap/crossplane-provider-btp/internal/controller/account/servicemanagerplatform"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/subaccount"
 	"github.com/sap/crossplane-provider-btp/internal/controller/account/subscription"
+	"github.com/sap/crossplane-provider-btp/internal/controller/environment/availableenvironments"
 	"github.com/sap/crossplane-provider-btp/internal/controller/environment/cloudfoundry"
+	"github.com/sap/crossplane-provider-btp/internal/controller/environment/environmentinstance"
 	"github.com/sap/crossplane-provider-btp/internal/controller/environment/kyma"
+	"github.com/sap/crossplane-provider-btp/internal/controller/environment/quota"
+	"github.com/sap/crossplane-provider-btp/internal/controller/environment/role"
+	"github.com/sap/crossplane-provider-btp/internal/controller/environment/space"
 	"github.com/sap/crossplane-provider-btp/internal/controller/kymaenvironmentbinding"
 	"github.com/sap/crossplane-provider-btp/internal/controller/oidc/certbasedoidclogin"
 	"github.com/sap/crossplane-provider-btp/internal/controller/oidc/kubeconfiggenerator"
+	"github.com/sap/crossplane-provider-btp/internal/controller/security/group"
 	"github.com/sap/crossplane-provider-btp/internal/controller/security/rolecollection"
 	"github.com/sap/crossplane-provider-btp/internal/controller/security/rolecollectionassignment"
+	"github.com/sap/crossplane-provider-btp/internal/controller/security/securityconfig"
+	"github.com/sap/crossplane-provider-btp/internal/controller/security/user"
 )
 
 // CustomSetup creates all Template controllers with the supplied logger and adds them to
@@ -31,6 +41,13 @@ func CustomSetup(mgr ctrl.Manager, o controller.Options) error {
 		subaccount.Setup,
 		cloudfoundry.Setup,
 		kyma.Setup,
+		environmentinstance.Setup,
+		availableenvironments.Setup,
+		space.Setup,
+		role.SetupSpaceRole,
+		role.SetupOrgRole,
+		quota.SetupOrganizationQuota,
+		quota.SetupSpaceQuota,
 		entitlement.Setup,
 		cloudmanagement.Setup,
 		servicemanager.Setup,
@@ -41,8 +58,13 @@ func CustomSetup(mgr ctrl.Manager, o controller.Options) error {
 		subscription.Setup,
 		rolecollectionassignment.Setup,
 		rolecollection.Setup,
+		user.Setup,
+		group.Setup,
+		securityconfig.Setup,
 		serviceinstance.Setup,
 		servicebinding.Setup,
+		servicemanagerplatform.Setup,
+		servicecatalog.Setup,
 		kymaenvironmentbinding.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
@@ -21,6 +21,38 @@ func NewPlanIdResolverFn(ctx context.Context, secretData map[string][]byte) (ser
 	return servicemanager.NewServiceManagerClient(btp.NewBackgroundContextWithDebugPrintHTTPClient(), &binding)
 }
 
+func NewPlanUpdateResolverFn(ctx context.Context, secretData map[string][]byte) (servicemanager.PlanUpdateResolver, error) {
+	binding, err := servicemanager.NewCredsFromOperatorSecret(secretData)
+	if err != nil {
+		return nil, err
+	}
+	return servicemanager.NewServiceManagerClient(btp.NewBackgroundContextWithDebugPrintHTTPClient(), &binding)
+}
+
+func NewCatalogReaderFn(ctx context.Context, secretData map[string][]byte) (servicemanager.CatalogReader, error) {
+	binding, err := servicemanager.NewCredsFromOperatorSecret(secretData)
+	if err != nil {
+		return nil, err
+	}
+	return servicemanager.NewServiceManagerClient(btp.NewBackgroundContextWithDebugPrintHTTPClient(), &binding)
+}
+
+func NewNativeClientFn(ctx context.Context, secretData map[string][]byte) (servicemanager.NativeClientI, error) {
+	binding, err := servicemanager.NewCredsFromOperatorSecret(secretData)
+	if err != nil {
+		return nil, err
+	}
+	return servicemanager.NewNativeClient(btp.NewBackgroundContextWithDebugPrintHTTPClient(), &binding)
+}
+
+func NewPlatformClientFn(ctx context.Context, secretData map[string][]byte) (servicemanager.PlatformClientI, error) {
+	binding, err := servicemanager.NewCredsFromOperatorSecret(secretData)
+	if err != nil {
+		return nil, err
+	}
+	return servicemanager.NewPlatformClient(btp.NewBackgroundContextWithDebugPrintHTTPClient(), &binding)
+}
+
 func LoadSecretData(kube client.Client, ctx context.Context, secretName, secretNamespace string) (map[string][]byte, error) {
 	if secretName == "" || secretNamespace == "" {
 		return nil, fmt.Errorf("secret name and namespace must not be empty")
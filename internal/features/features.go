@@ -13,4 +13,9 @@ const (
 	// Management Policies. See the below design for more details.
 	// https://github.com/crossplane/crossplane/pull/3531
 	EnableBetaManagementPolicies feature.Flag = "EnableAlphaManagementPolicies"
+
+	// EnableNativeServiceManager manages ServiceInstances and ServiceBindings
+	// directly via the service manager API instead of a terraform workspace per
+	// resource, unless a ProviderConfig selects the backend explicitly.
+	EnableNativeServiceManager feature.Flag = "EnableNativeServiceManager"
 )
@@ -112,6 +112,36 @@ func CopyMaps[M1 ~map[K]V, M2 ~map[K]V, K comparable, V any](dst M1, src M2) {
 	}
 }
 
+// LabelsEqual compares BTP labels, the values of a label are compared as a set since BTP does not keep their order,
+// a label without values equals one with an empty list of values
+func LabelsEqual(a map[string][]string, b map[string][]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, va := range a {
+		vb, ok := b[k]
+		if !ok || !valueSetsEqual(va, vb) {
+			return false
+		}
+	}
+	return true
+}
+
+func valueSetsEqual(a []string, b []string) bool {
+	setA := make(map[string]struct{}, len(a))
+	for _, v := range a {
+		setA[v] = struct{}{}
+	}
+	setB := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		if _, ok := setA[v]; !ok {
+			return false
+		}
+		setB[v] = struct{}{}
+	}
+	return len(setA) == len(setB)
+}
+
 type kubeConfigYaml struct {
 	Clusters []namedClusterYaml `json:"clusters"`
 }
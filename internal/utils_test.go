@@ -34,3 +34,16 @@ func TestVal(t *testing.T) {
 	assert.Equal(t, false, Val(ptrBool))
 
 }
+
+func TestLabelsEqual(t *testing.T) {
+	assert.True(t, LabelsEqual(nil, map[string][]string{}))
+	assert.True(t, LabelsEqual(map[string][]string{"a": nil}, map[string][]string{"a": {}}))
+	assert.True(t, LabelsEqual(map[string][]string{"a": {"1", "2"}}, map[string][]string{"a": {"1", "2"}}))
+	assert.False(t, LabelsEqual(map[string][]string{"a": {"1"}}, map[string][]string{"b": {"1"}}))
+	assert.False(t, LabelsEqual(map[string][]string{"a": {"1"}}, map[string][]string{"a": {"2"}}))
+	assert.False(t, LabelsEqual(map[string][]string{"a": {"1"}}, nil))
+	assert.True(t, LabelsEqual(map[string][]string{"a": {"1", "2"}}, map[string][]string{"a": {"2", "1"}}))
+	assert.True(t, LabelsEqual(map[string][]string{"a": {"1", "1"}}, map[string][]string{"a": {"1"}}))
+	assert.False(t, LabelsEqual(map[string][]string{"a": {"1", "2"}}, map[string][]string{"a": {"1", "3"}}))
+	assert.False(t, LabelsEqual(map[string][]string{"a": {"1"}}, map[string][]string{"a": {}}))
+}